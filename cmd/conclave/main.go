@@ -1,28 +1,42 @@
 package main
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"text/tabwriter"
 	"time"
+	"unicode"
 
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 
+	"github.com/alienxp03/conclave/internal/batch"
+	"github.com/alienxp03/conclave/internal/coderef"
 	"github.com/alienxp03/conclave/internal/config"
+	"github.com/alienxp03/conclave/internal/consensus"
 	"github.com/alienxp03/conclave/internal/core"
 	"github.com/alienxp03/conclave/internal/council"
 	"github.com/alienxp03/conclave/internal/engine"
 	"github.com/alienxp03/conclave/internal/export"
 	"github.com/alienxp03/conclave/internal/persona"
 	"github.com/alienxp03/conclave/internal/provider"
+	"github.com/alienxp03/conclave/internal/run"
 	"github.com/alienxp03/conclave/internal/storage"
 	"github.com/alienxp03/conclave/internal/style"
+	"github.com/alienxp03/conclave/internal/tournament"
 	"github.com/alienxp03/conclave/internal/workspace"
 	"github.com/alienxp03/conclave/web/handlers"
 )
@@ -92,7 +106,22 @@ func init() {
 	rootCmd.AddCommand(showCmd)
 	rootCmd.AddCommand(deleteCmd)
 	rootCmd.AddCommand(exportCmd)
+	rootCmd.AddCommand(argumentsCmd)
 	rootCmd.AddCommand(lockCmd)
+	rootCmd.AddCommand(pauseCmd)
+	rootCmd.AddCommand(resumeCmd)
+	rootCmd.AddCommand(cancelCmd)
+	rootCmd.AddCommand(forkCmd)
+	rootCmd.AddCommand(forksCmd)
+	rootCmd.AddCommand(regenerateCmd)
+	rootCmd.AddCommand(versionsCmd)
+	rootCmd.AddCommand(rerunCmd)
+	rootCmd.AddCommand(swapSidesCmd)
+	rootCmd.AddCommand(sidesCmd)
+	rootCmd.AddCommand(councilCmd)
+	rootCmd.AddCommand(batchCmd)
+	rootCmd.AddCommand(tournamentCmd)
+	rootCmd.AddCommand(presetsCmd)
 	rootCmd.AddCommand(providersCmd)
 	rootCmd.AddCommand(personasCmd)
 	rootCmd.AddCommand(stylesCmd)
@@ -146,37 +175,115 @@ var newCmd = &cobra.Command{
   conclave new "Climate change" -a claude:optimist -b gemini:skeptic
   conclave new "Tech trends" -a claude/sonnet:analyst -b qwen:visionary
 
+3+ Agent Debate Examples (use --agents):
+  conclave new "Monolith or microservices?" --agents claude:pragmatist,gemini:skeptic,qwen:visionary
+  conclave new "Pricing model" --agents claude,gemini,codex --order moderator
+  conclave new "Tabs vs spaces" -a claude:optimist -b gemini:skeptic --judge codex:analyst
+  conclave new "Four-day work week" --style adversarial --turns 3 --moderator gemini:analyst
+  conclave new "Rewrite in Rust?" --agents claude,gemini,codex --max-tokens 50000 --max-time 10m
+
+Stance Examples (sides in seat order: FOR, AGAINST or any position):
+  conclave new "Nuclear power is the answer" --style adversarial --stance FOR --stance AGAINST
+  conclave new "Best backend language" --stance "Go" --stance "Rust"
+
+Human Participant Example (you argue one seat, prompted on your turns):
+  conclave new "Remote work beats the office" -a claude:skeptic -b human
+
 N-Agent Council Examples (use --models):
   conclave new "Should we adopt GraphQL?" --models claude,gemini
   conclave new "API design" --models claude:optimist,gemini:skeptic,qwen:pragmatist
-  conclave new "Tech decision" --models claude/opus,gemini/pro --chairman claude/opus`,
+  conclave new "Tech decision" --models claude/opus,gemini/pro --chairman claude/opus
+
+Preset Examples (flags given explicitly override the preset's settings):
+  conclave new --preset security-review "Is our token refresh flow safe?"
+  conclave new --preset security-review "Rotate keys monthly?" --max-tokens 20000`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: runNewDebate,
 }
 
 var (
-	agentAFlag   string
-	agentBFlag   string
-	styleFlag    string
-	turnsFlag    int
-	modelsFlag   string
-	chairmanFlag string
+	agentAFlag             string
+	agentBFlag             string
+	agentsFlag             string
+	orderFlag              string
+	judgeFlag              string
+	moderatorFlag          string
+	styleFlag              string
+	consensusFlag          string
+	consensusThresholdFlag float64
+	stalemateThresholdFlag float64
+	stalemateConfirmFlag   bool
+	noStalemateFlag        bool
+	steelmanFlag           bool
+	steelmanMinScoreFlag   int
+	steelmanRetriesFlag    int
+	turnsFlag              int
+	modelsFlag             string
+	chairmanFlag           string
+	stanceFlags            []string
+	voteOptionFlags        []string
+	extractOptionsFlag     bool
+	maxTokensFlag          int
+	maxCostFlag            float64
+	maxTimeFlag            time.Duration
+	presetFlag             string
 )
 
 func init() {
 	// 2-agent debate flags
 	newCmd.Flags().StringVarP(&agentAFlag, "agent-a", "a", "claude:pragmatist", "Agent A (provider[/model]:persona)")
-	newCmd.Flags().StringVarP(&agentBFlag, "agent-b", "b", "claude:skeptic", "Agent B (provider[/model]:persona)")
+	newCmd.Flags().StringVarP(&agentBFlag, "agent-b", "b", "claude:skeptic", "Agent B (provider[/model]:persona, or human to take the seat yourself)")
 	newCmd.Flags().StringVarP(&styleFlag, "style", "s", "collaborative", "Debate style")
 	newCmd.Flags().IntVarP(&turnsFlag, "turns", "t", 5, "Turns per agent")
+	newCmd.Flags().StringVar(&agentsFlag, "agents", "", "Debate agents, overrides -a/-b (comma-separated: provider[/model][:persona],...)")
+	newCmd.Flags().StringVar(&orderFlag, "order", "random", "Speaking order: random, round_robin, moderator")
+	newCmd.Flags().StringVar(&judgeFlag, "judge", "", "Independent judge that scores rounds and writes the conclusion (provider[/model][:persona])")
+	newCmd.Flags().StringVar(&moderatorFlag, "moderator", "", "Moderator that summarizes and poses a sharpened question after each rotation (provider[/model][:persona])")
+	newCmd.Flags().StringVar(&consensusFlag, "consensus", "", "Consensus detection: hybrid, keyword, llm, stance (defaults to the style's)")
+	newCmd.Flags().Float64Var(&consensusThresholdFlag, "consensus-threshold", 0, "Score (0-1) needed for early consensus (0 uses the method default)")
+	newCmd.Flags().Float64Var(&stalemateThresholdFlag, "stalemate-threshold", 0, "Novelty (0-1) a rotation needs to count as progress; two stale rotations in a row end the debate (0 uses the default)")
+	newCmd.Flags().BoolVar(&stalemateConfirmFlag, "stalemate-confirm", false, "Ask a model to confirm a stalemate before ending the debate")
+	newCmd.Flags().BoolVar(&noStalemateFlag, "no-stalemate", false, "Keep debating when agents repeat themselves")
+	newCmd.Flags().BoolVar(&steelmanFlag, "steelman", false, "Make each agent restate the strongest opposing argument before rebutting it, rated for fidelity by the judge or the opponent")
+	newCmd.Flags().IntVar(&steelmanMinScoreFlag, "steelman-min-score", 0, "Fidelity (1-10) a restatement needs before the turn is retried (0 uses the default of 7; implies --steelman)")
+	newCmd.Flags().IntVar(&steelmanRetriesFlag, "steelman-retries", 0, "Retries for a low-scoring restatement (0 uses the default of 1; implies --steelman)")
+	newCmd.Flags().StringArrayVar(&stanceFlags, "stance", nil, "Agent stance in seat order: FOR, AGAINST or a free-text position (repeatable; adversarial debates default to FOR/AGAINST)")
+	newCmd.Flags().StringArrayVar(&voteOptionFlags, "option", nil, "Option to vote on at the conclusion, with a confidence per vote, instead of AGREE/DISAGREE (repeatable)")
+	newCmd.Flags().BoolVar(&extractOptionsFlag, "extract-options", false, "Vote on options extracted from the debate instead of AGREE/DISAGREE")
 
 	// N-agent council flags
 	newCmd.Flags().StringVarP(&modelsFlag, "models", "m", "", "Council members (comma-separated: provider[/model][:persona],...)")
 	newCmd.Flags().StringVar(&chairmanFlag, "chairman", "", "Chairman (provider[/model], defaults to first member's provider with best model)")
+
+	// Preset flag: a saved set of members, chairman, style, turns, project and budget
+	newCmd.Flags().StringVar(&presetFlag, "preset", "", "Start from a saved preset (name or ID, see conclave preset list)")
+
+	// Budget flags (debates and councils; unset limits fall back to the project's)
+	newCmd.Flags().IntVar(&maxTokensFlag, "max-tokens", 0, "Stop once the session has used this many tokens")
+	newCmd.Flags().Float64Var(&maxCostFlag, "max-cost", 0, "Stop once the session has cost this many US dollars")
+	newCmd.Flags().DurationVar(&maxTimeFlag, "max-time", 0, "Stop once a run has taken this long (e.g. 10m)")
+}
+
+// budgetFromFlags builds the session budget from --max-tokens, --max-cost
+// and --max-time, or nil if none was given.
+func budgetFromFlags() *core.Budget {
+	budget := &core.Budget{
+		MaxTokens:     maxTokensFlag,
+		MaxCostUSD:    maxCostFlag,
+		MaxDurationMs: maxTimeFlag.Milliseconds(),
+	}
+	if budget.IsZero() {
+		return nil
+	}
+	return budget
 }
 
 // parseAgentConfig parses "provider[/model]:persona" format
 func parseAgentConfig(cfg string) (prov, model, pers string, err error) {
+	if cfg == core.ProviderHuman {
+		return core.ProviderHuman, "", "", nil
+	}
+
 	parts := strings.SplitN(cfg, ":", 2)
 	if len(parts) != 2 {
 		return "", "", "", fmt.Errorf("invalid agent config: %s (expected provider[/model]:persona)", cfg)
@@ -200,16 +307,31 @@ func parseAgentConfig(cfg string) (prov, model, pers string, err error) {
 func runNewDebate(cmd *cobra.Command, args []string) error {
 	topic := strings.Join(args, " ")
 
+	// Load the preset, whose mode applies unless the flags pick one
+	var preset *core.Preset
+	if presetFlag != "" {
+		store, err := getStorage()
+		if err != nil {
+			return fmt.Errorf("failed to initialize storage: %w", err)
+		}
+		preset, err = findPreset(store, presetFlag)
+		store.Close()
+		if err != nil {
+			return err
+		}
+	}
+	debateFlags := cmd.Flags().Changed("agents") || cmd.Flags().Changed("agent-a") || cmd.Flags().Changed("agent-b")
+
 	// Check if council mode (--models flag provided)
-	if modelsFlag != "" {
-		return runNewCouncil(cmd, topic)
+	if modelsFlag != "" || (preset != nil && preset.Mode == core.PresetCouncil && !debateFlags) {
+		return runNewCouncil(cmd, topic, preset)
 	}
 
-	// Standard 2-agent debate mode
-	return runNewTwoAgentDebate(cmd, topic)
+	// Standard debate mode
+	return runNewAgentDebate(cmd, topic, preset)
 }
 
-func runNewCouncil(cmd *cobra.Command, topic string) error {
+func runNewCouncil(cmd *cobra.Command, topic string, preset *core.Preset) error {
 	store, err := getStorage()
 	if err != nil {
 		return fmt.Errorf("failed to initialize storage: %w", err)
@@ -217,12 +339,15 @@ func runNewCouncil(cmd *cobra.Command, topic string) error {
 	defer store.Close()
 
 	registry := getRegistry()
-	councilEng := council.New(store, registry)
+	councilEng := council.New(store, registry, workspaces)
 
-	// Parse member specs
-	members, err := core.ParseMemberSpecs(modelsFlag)
-	if err != nil {
-		return fmt.Errorf("invalid --models: %w", err)
+	// Parse member specs; a preset may supply them instead
+	var members []core.MemberSpec
+	if modelsFlag != "" || preset == nil {
+		members, err = core.ParseMemberSpecs(modelsFlag)
+		if err != nil {
+			return fmt.Errorf("invalid --models: %w", err)
+		}
 	}
 
 	// Parse optional chairman
@@ -240,6 +365,13 @@ func runNewCouncil(cmd *cobra.Command, topic string) error {
 		Topic:    topic,
 		Members:  members,
 		Chairman: chairman,
+		Budget:   budgetFromFlags(),
+	}
+	if preset != nil {
+		preset.ApplyToCouncil(&config)
+		if len(config.Members) == 0 {
+			return fmt.Errorf("preset %s has no members (use --models)", preset.Name)
+		}
 	}
 
 	// Create council
@@ -263,7 +395,11 @@ func runNewCouncil(cmd *cobra.Command, topic string) error {
 	if c.Chairman.Model != "" {
 		chairModel = "/" + c.Chairman.Model
 	}
-	fmt.Printf("   Chairman: %s%s\n\n", c.Chairman.Provider, chairModel)
+	fmt.Printf("   Chairman: %s%s\n", c.Chairman.Provider, chairModel)
+	if c.Budget != nil {
+		fmt.Printf("   Budget: %s\n", c.Budget)
+	}
+	fmt.Println()
 	fmt.Println(strings.Repeat("─", 60))
 
 	// Set up callbacks for progress display
@@ -281,6 +417,9 @@ func runNewCouncil(cmd *cobra.Command, topic string) error {
 			} else {
 				fmt.Println(resp.Content)
 			}
+			if len(resp.References) > 0 {
+				fmt.Println(referencesLine(resp.References))
+			}
 			fmt.Println()
 		},
 		OnRankingCollected: func(ranking core.Ranking) {
@@ -304,21 +443,21 @@ func runNewCouncil(cmd *cobra.Command, topic string) error {
 	fmt.Println("\nRunning council...")
 	fmt.Println("[Stage 1/3] Collecting responses...")
 
-	ctx, cancel := context.WithCancel(cmd.Context())
-	defer cancel()
+	ctx, cancel := context.WithCancelCause(cmd.Context())
+	defer cancel(nil)
 
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
 	go func() {
 		<-sigCh
 		fmt.Println("\n\nInterrupted. Saving council state...")
-		cancel()
+		cancel(run.ErrPaused)
 	}()
 
 	err = councilEng.RunCouncilWithCallbacks(ctx, c, callbacks)
 	if err != nil {
 		if ctx.Err() != nil {
-			fmt.Println("\nCouncil paused.")
+			fmt.Println("\nCouncil paused. Resume with: conclave resume " + c.ID[:8])
 			return nil
 		}
 		return fmt.Errorf("council failed: %w", err)
@@ -335,7 +474,7 @@ func runNewCouncil(cmd *cobra.Command, topic string) error {
 	return nil
 }
 
-func runNewTwoAgentDebate(cmd *cobra.Command, topic string) error {
+func runNewAgentDebate(cmd *cobra.Command, topic string, preset *core.Preset) error {
 	store, err := getStorage()
 	if err != nil {
 		return fmt.Errorf("failed to initialize storage: %w", err)
@@ -355,6 +494,91 @@ func runNewTwoAgentDebate(cmd *cobra.Command, topic string) error {
 		return err
 	}
 
+	// Parse optional N-agent list
+	var agents []core.MemberSpec
+	if agentsFlag != "" {
+		agents, err = core.ParseMemberSpecs(agentsFlag)
+		if err != nil {
+			return fmt.Errorf("invalid --agents: %w", err)
+		}
+	}
+
+	// Parse optional judge
+	var judge *core.MemberSpec
+	if judgeFlag != "" {
+		spec, err := core.ParseMemberSpec(judgeFlag)
+		if err != nil {
+			return fmt.Errorf("invalid --judge: %w", err)
+		}
+		judge = &spec
+	}
+
+	// Parse optional moderator
+	var moderator *core.MemberSpec
+	if moderatorFlag != "" {
+		spec, err := core.ParseMemberSpec(moderatorFlag)
+		if err != nil {
+			return fmt.Errorf("invalid --moderator: %w", err)
+		}
+		moderator = &spec
+	}
+
+	// Parse optional consensus detection override
+	var consensusConfig *core.ConsensusConfig
+	if consensusFlag != "" || consensusThresholdFlag != 0 {
+		consensusConfig = &core.ConsensusConfig{
+			Method:    core.ConsensusMethod(consensusFlag),
+			Threshold: consensusThresholdFlag,
+		}
+	}
+
+	// Parse optional stalemate detection override
+	var stalemateConfig *core.StalemateConfig
+	if noStalemateFlag || stalemateThresholdFlag != 0 || stalemateConfirmFlag {
+		stalemateConfig = &core.StalemateConfig{
+			Disabled:  noStalemateFlag,
+			Threshold: stalemateThresholdFlag,
+			Confirm:   stalemateConfirmFlag,
+		}
+	}
+
+	// Parse optional steelman step
+	var steelmanConfig *core.SteelmanConfig
+	if steelmanFlag || steelmanMinScoreFlag != 0 || steelmanRetriesFlag != 0 {
+		steelmanConfig = &core.SteelmanConfig{
+			MinScore:   steelmanMinScoreFlag,
+			MaxRetries: steelmanRetriesFlag,
+		}
+	}
+
+	// Parse optional multi-option vote
+	var voteConfig *core.VoteConfig
+	if len(voteOptionFlags) > 0 && extractOptionsFlag {
+		return fmt.Errorf("use either --option or --extract-options, not both")
+	}
+	if len(voteOptionFlags) > 0 || extractOptionsFlag {
+		voteConfig = &core.VoteConfig{Options: voteOptionFlags}
+	}
+
+	// Assign stances in seat order
+	if agents != nil {
+		if len(stanceFlags) > len(agents) {
+			return fmt.Errorf("got %d stances for %d agents", len(stanceFlags), len(agents))
+		}
+		for i, stance := range stanceFlags {
+			agents[i].Stance = stance
+		}
+	} else if len(stanceFlags) > 2 {
+		return fmt.Errorf("got %d stances for 2 agents (use --agents for more)", len(stanceFlags))
+	}
+	var stanceA, stanceB string
+	if agents == nil && len(stanceFlags) > 0 {
+		stanceA = stanceFlags[0]
+	}
+	if agents == nil && len(stanceFlags) > 1 {
+		stanceB = stanceFlags[1]
+	}
+
 	// Create debate
 	debateConfig := core.NewDebateConfig{
 		Topic:          topic,
@@ -364,8 +588,22 @@ func runNewTwoAgentDebate(cmd *cobra.Command, topic string) error {
 		AgentBProvider: providerB,
 		AgentBModel:    modelB,
 		AgentBPersona:  personaB,
+		AgentAStance:   stanceA,
+		AgentBStance:   stanceB,
 		Style:          styleFlag,
 		MaxTurns:       turnsFlag,
+		Agents:         agents,
+		SpeakingOrder:  core.SpeakingOrder(orderFlag),
+		Judge:          judge,
+		Moderator:      moderator,
+		Consensus:      consensusConfig,
+		Stalemate:      stalemateConfig,
+		Steelman:       steelmanConfig,
+		Vote:           voteConfig,
+		Budget:         budgetFromFlags(),
+	}
+	if preset != nil {
+		applyPresetToDebate(cmd, preset, &debateConfig)
 	}
 
 	debate, err := eng.CreateDebate(cmd.Context(), debateConfig)
@@ -374,17 +612,23 @@ func runNewTwoAgentDebate(cmd *cobra.Command, topic string) error {
 	}
 
 	fmt.Printf("\n💬 Debate: %s\n", debate.Topic)
-	fmt.Printf("   Style: %s | Turns: %d per agent\n", debate.Style, debate.MaxTurns)
-	fmt.Printf("   Agent A: %s (%s", debate.AgentA.Name, debate.AgentA.Provider)
-	if debate.AgentA.Model != "" {
-		fmt.Printf("/%s", debate.AgentA.Model)
+	fmt.Printf("   Style: %s | Turns: %d per agent | Order: %s\n", debate.Style, debate.MaxTurns, orderFlag)
+	for i, agent := range debate.Participants() {
+		fmt.Printf("   Agent %c: %s (%s", 'A'+i, agent.Name, agent.Provider)
+		if agent.Model != "" {
+			fmt.Printf("/%s", agent.Model)
+		}
+		fmt.Printf(")%s\n", stanceSuffix(agent))
+	}
+	if debate.Judge != nil {
+		fmt.Printf("   %s\n", debate.Judge.Name)
+	}
+	if debate.Moderator != nil {
+		fmt.Printf("   %s\n", debate.Moderator.Name)
 	}
-	fmt.Println(")")
-	fmt.Printf("   Agent B: %s (%s", debate.AgentB.Name, debate.AgentB.Provider)
-	if debate.AgentB.Model != "" {
-		fmt.Printf("/%s", debate.AgentB.Model)
+	if debate.Budget != nil {
+		fmt.Printf("   Budget: %s\n", debate.Budget)
 	}
-	fmt.Println(")")
 	fmt.Printf("   ID: %s\n\n", debate.ID)
 	fmt.Println(strings.Repeat("─", 60))
 
@@ -393,42 +637,185 @@ func runNewTwoAgentDebate(cmd *cobra.Command, topic string) error {
 }
 
 func runDebate(ctx context.Context, eng *engine.Engine, debate *core.Debate) error {
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
 
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
 	go func() {
 		<-sigCh
 		fmt.Println("\n\nInterrupted. Saving debate state...")
-		cancel()
+		cancel(run.ErrPaused)
 	}()
 
-	err := eng.RunDebate(ctx, debate.ID, func(turn *core.Turn, d *core.Debate) {
-		agentName := getAgentName(d, turn.AgentID)
-		fmt.Printf("\n📢 Turn %d - %s\n", turn.Number, agentName)
-		fmt.Println(strings.Repeat("─", 40))
-		fmt.Println(turn.Content)
-		fmt.Println()
-	})
+	err := eng.RunDebate(ctx, debate.ID, printTurn)
+	for errors.Is(err, engine.ErrAwaitingInput) {
+		content, promptErr := promptHumanTurn(ctx, eng, debate.ID)
+		if promptErr != nil {
+			if ctx.Err() != nil {
+				fmt.Println("\nDebate is waiting for your turn. Continue with: conclave resume " + debate.ID[:8])
+				return nil
+			}
+			return promptErr
+		}
+		if _, err = eng.SubmitHumanTurn(debate.ID, content); err != nil {
+			return err
+		}
+		err = eng.RunDebate(ctx, debate.ID, printTurn)
+	}
 
 	if err != nil {
 		if ctx.Err() != nil {
-			fmt.Println("\nDebate paused. Resume with: conclave show " + debate.ID[:8])
+			fmt.Println("\nDebate paused. Resume with: conclave resume " + debate.ID[:8])
 			return nil
 		}
 		return fmt.Errorf("debate failed: %w", err)
 	}
 
+	showFinalConclusions(eng, debate.ID)
+	return nil
+}
+
+// applyPresetToDebate fills the settings not given as flags from a preset.
+// Flags keep their defaults for whatever the preset leaves unset.
+func applyPresetToDebate(cmd *cobra.Command, preset *core.Preset, config *core.NewDebateConfig) {
+	defaults := *config
+	if !cmd.Flags().Changed("agents") && !cmd.Flags().Changed("agent-a") && !cmd.Flags().Changed("agent-b") && len(preset.Members) > 0 {
+		config.AgentAProvider, config.AgentAModel, config.AgentAPersona = "", "", ""
+		config.AgentBProvider, config.AgentBModel, config.AgentBPersona = "", "", ""
+	}
+	if !cmd.Flags().Changed("style") {
+		config.Style = ""
+	}
+	if !cmd.Flags().Changed("turns") {
+		config.MaxTurns = 0
+	}
+
+	preset.ApplyToDebate(config)
+
+	// Preset stances come with its members; --stance still overrides them
+	if len(config.Agents) > 0 && len(stanceFlags) > 0 && config.AgentAStance != "" {
+		config.Agents[0].Stance = config.AgentAStance
+		if len(config.Agents) > 1 && config.AgentBStance != "" {
+			config.Agents[1].Stance = config.AgentBStance
+		}
+	}
+	if config.Style == "" {
+		config.Style = defaults.Style
+	}
+	if config.MaxTurns <= 0 {
+		config.MaxTurns = defaults.MaxTurns
+	}
+}
+
+// stanceSuffix formats an agent's stance for agent listings.
+func stanceSuffix(agent core.Agent) string {
+	if agent.Stance == "" {
+		return ""
+	}
+	return " — " + agent.Stance
+}
+
+// printTurn prints a completed debate turn.
+func printTurn(turn *core.Turn, d *core.Debate) {
+	agentName := getAgentName(d, turn.AgentID)
+	fmt.Printf("\n📢 Turn %d - %s\n", turn.Number, agentName)
+	fmt.Println(strings.Repeat("─", 40))
+	fmt.Println(turn.Content)
+	if turn.Steelman != nil {
+		fmt.Println(steelmanLine(d, turn.Steelman))
+	}
+	if len(turn.References) > 0 {
+		fmt.Println(referencesLine(turn.References))
+	}
+	fmt.Println()
+}
+
+// referencesLine summarizes a turn's checked code references in one line,
+// naming the ones that could not be verified.
+func referencesLine(refs []*core.CodeReference) string {
+	verified, unverified := coderef.Summary(refs)
+	line := fmt.Sprintf("🔎 Code references: %d verified, %d unverified", verified, unverified)
+	var failed []string
+	for _, ref := range refs {
+		if !ref.Verified {
+			failed = append(failed, fmt.Sprintf("%s (%s)", ref.Text, ref.Reason))
+		}
+	}
+	if len(failed) > 0 {
+		line += " — " + strings.Join(failed, "; ")
+	}
+	return line
+}
+
+// steelmanLine describes a turn's steelman rating in one line.
+func steelmanLine(d *core.Debate, score *core.SteelmanScore) string {
+	result := "below the minimum"
+	if score.Passed {
+		result = "passed"
+	}
+	line := fmt.Sprintf("🪞 Steelman of %s: %d/10, %s", getAgentName(d, score.TargetID), score.Score, result)
+	if score.Attempts > 1 {
+		line += fmt.Sprintf(" after %d attempts", score.Attempts)
+	}
+	if score.Rationale != "" {
+		line += " — " + score.Rationale
+	}
+	return line
+}
+
+// promptHumanTurn reads the human participant's turn from stdin. The turn
+// ends at a line containing only "." or at EOF.
+func promptHumanTurn(ctx context.Context, eng *engine.Engine, debateID string) (string, error) {
+	awaiting, err := eng.AwaitingInput(debateID)
+	if err != nil {
+		return "", err
+	}
+	if awaiting == nil {
+		return "", fmt.Errorf("debate is not awaiting input")
+	}
+
+	fmt.Printf("\n✍️  Your turn as %s (turn %d of %d, round %d)\n", awaiting.MaskedName, awaiting.TurnNumber, awaiting.TotalTurns, awaiting.Round)
+	fmt.Println("   Type your argument. Finish with a line containing only \".\"")
+	fmt.Println(strings.Repeat("─", 40))
+
+	type result struct {
+		content string
+		err     error
+	}
+	done := make(chan result, 1)
+	go func() {
+		var lines []string
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			if strings.TrimSpace(scanner.Text()) == "." {
+				break
+			}
+			lines = append(lines, scanner.Text())
+		}
+		done <- result{strings.TrimSpace(strings.Join(lines, "\n")), scanner.Err()}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case r := <-done:
+		if r.err == nil && r.content == "" {
+			r.err = fmt.Errorf("empty turn")
+		}
+		return r.content, r.err
+	}
+}
+
+// showFinalConclusions prints a finished debate's conclusions.
+func showFinalConclusions(eng *engine.Engine, debateID string) {
 	// Fetch updated debate to get conclusions
-	updated, _ := eng.GetDebate(debate.ID)
+	updated, _ := eng.GetDebate(debateID)
 	if updated != nil && len(updated.Conclusions) > 0 {
 		for _, c := range updated.Conclusions {
 			showConclusion(updated, c)
 		}
 	}
-
-	return nil
 }
 
 func showConclusion(debate *core.Debate, conclusion *core.Conclusion) {
@@ -437,21 +824,62 @@ func showConclusion(debate *core.Debate, conclusion *core.Conclusion) {
 	fmt.Println(strings.Repeat("═", 60))
 
 	// Show votes
-	if conclusion.AgentAVote != nil {
+	fmt.Println()
+	for _, agent := range debate.Participants() {
+		vote := conclusion.VoteFor(agent.ID)
+		if vote == nil {
+			continue
+		}
 		voteIcon := "❌"
-		if conclusion.AgentAVote.Agrees {
+		if vote.Agrees {
 			voteIcon = "✅"
 		}
-		fmt.Printf("\n%s %s votes: %s\n", voteIcon, debate.AgentA.Name,
-			map[bool]string{true: "AGREE", false: "DISAGREE"}[conclusion.AgentAVote.Agrees])
+		fmt.Printf("%s %s votes: %s\n", voteIcon, agent.Name, vote.Label())
+		if vote.Option != "" && vote.Reasoning != "" {
+			fmt.Printf("     %s\n", vote.Reasoning)
+		}
 	}
-	if conclusion.AgentBVote != nil {
-		voteIcon := "❌"
-		if conclusion.AgentBVote.Agrees {
-			voteIcon = "✅"
+
+	// Show the option tally
+	if tally := conclusion.Tally; tally != nil {
+		fmt.Println("\n🗳️  Tally:")
+		for _, o := range tally.Options {
+			marker := "  "
+			if o.Option == tally.Winner {
+				marker = "🏆"
+			}
+			fmt.Printf("%s %s: %d vote(s), confidence %.2f\n", marker, o.Option, o.Votes, o.Confidence)
+		}
+		if tally.Winner == "" {
+			fmt.Println("   No winning option (tie or no votes)")
+		}
+	}
+
+	// Show judge scores
+	if verdict := conclusion.Verdict; verdict != nil {
+		for _, agent := range debate.Participants() {
+			score := verdict.ScoreFor(agent.ID)
+			if score == nil {
+				continue
+			}
+			marker := "  "
+			if verdict.WinnerID == agent.ID {
+				marker = "🏆"
+			}
+			fmt.Printf("%s %s: %d points", marker, agent.Name, score.Total)
+			for _, criterion := range core.DefaultRubric {
+				if n, ok := score.Scores[criterion.ID]; ok {
+					fmt.Printf(" | %s %d", criterion.Name, n)
+				}
+			}
+			fmt.Println()
+			if score.Feedback != "" {
+				fmt.Printf("     %s\n", score.Feedback)
+			}
+		}
+		if verdict.Outcome == core.VerdictDraw {
+			fmt.Println("\n⚖️  Judge declared a draw")
 		}
-		fmt.Printf("%s %s votes: %s\n", voteIcon, debate.AgentB.Name,
-			map[bool]string{true: "AGREE", false: "DISAGREE"}[conclusion.AgentBVote.Agrees])
 	}
 
 	fmt.Println()
@@ -461,36 +889,1581 @@ func showConclusion(debate *core.Debate, conclusion *core.Conclusion) {
 		} else {
 			fmt.Println("🤝 Consensus Reached!")
 		}
+		if conclusion.ModelVotesOnly {
+			fmt.Println("   AI vote only: the human participant did not vote")
+		}
+	} else if conclusion.Type == core.ConclusionStalemate {
+		fmt.Println("🔁 Stalemate: the agents stopped adding new points")
 	} else {
 		fmt.Println("⚔️  No Consensus")
 	}
 
-	fmt.Printf("\n%s\n", conclusion.Summary)
-
-	if !conclusion.Agreed {
-		if conclusion.AgentASummary != "" {
-			fmt.Printf("\n📌 %s:\n%s\n", debate.AgentA.Name, conclusion.AgentASummary)
-		}
-		if conclusion.AgentBSummary != "" {
-			fmt.Printf("\n📌 %s:\n%s\n", debate.AgentB.Name, conclusion.AgentBSummary)
-		}
-	}
+	if result := conclusion.Consensus; result != nil {
+		fmt.Printf("   Consensus check (%s): %.2f / %.2f — %s\n", result.Method, result.Score, result.Threshold, result.Rationale)
+	}
+	if result := conclusion.Stalemate; result != nil {
+		confirmed := ""
+		if result.Confirmed {
+			confirmed = " (confirmed)"
+		}
+		fmt.Printf("   Stalemate check%s: %s\n", confirmed, result.Rationale)
+	}
+	for _, s := range conclusion.Steelman {
+		fmt.Printf("   Steelman fidelity, %s: %.1f/10 over %d restatement(s), %d passed", getAgentName(debate, s.AgentID), s.Average, s.Turns, s.Passed)
+		if s.Retries > 0 {
+			fmt.Printf(", %d retries", s.Retries)
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf("\n%s\n", conclusion.Summary)
+
+	if !conclusion.Agreed {
+		for _, agent := range debate.Participants() {
+			if position := conclusion.PositionOf(agent.ID); position != "" {
+				fmt.Printf("\n📌 %s:\n%s\n", agent.Name, position)
+			}
+		}
+	}
+}
+
+func getAgentName(debate *core.Debate, agentID string) string {
+	if agent, ok := debate.AgentByID(agentID); ok {
+		return agent.Name
+	}
+	if debate.IsJudge(agentID) {
+		return debate.Judge.Name
+	}
+	if debate.IsModerator(agentID) {
+		return debate.Moderator.Name
+	}
+	if agentID == "user" {
+		return "User"
+	}
+	return agentID
+}
+
+// ============================================================================
+// LIST COMMAND
+// ============================================================================
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all debates",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := getStorage()
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		eng := engine.New(store, getRegistry(), workspaces)
+		debates, err := eng.ListDebates(50, 0)
+		if err != nil {
+			return err
+		}
+
+		if len(debates) == 0 {
+			fmt.Println("No debates found. Start one with: conclave new \"Your topic\"")
+			return nil
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tTOPIC\tSTATUS\tTURNS\tLOCK\tCREATED")
+		fmt.Fprintln(w, "──\t─────\t──────\t─────\t────\t───────")
+
+		for _, d := range debates {
+			shortID := d.ID[:8]
+			shortTopic := d.Topic
+			if len(shortTopic) > 35 {
+				shortTopic = shortTopic[:32] + "..."
+			}
+			lock := ""
+			if d.ReadOnly {
+				lock = "🔒"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\n",
+				shortID,
+				shortTopic,
+				d.Status,
+				d.TurnCount,
+				lock,
+				d.CreatedAt.Format("2006-01-02 15:04"),
+			)
+		}
+		w.Flush()
+
+		return nil
+	},
+}
+
+// ============================================================================
+// SHOW COMMAND
+// ============================================================================
+
+var showCmd = &cobra.Command{
+	Use:   "show [id]",
+	Short: "Show debate details",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := getStorage()
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		eng := engine.New(store, getRegistry(), workspaces)
+		debateID, err := findDebateByPrefix(eng, args[0])
+		if err != nil {
+			return err
+		}
+
+		debate, turns, err := eng.GetDebateWithTurns(debateID)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("\n💬 Debate: %s\n", debate.Topic)
+		fmt.Printf("   ID: %s\n", debate.ID)
+		fmt.Printf("   Status: %s\n", debate.Status)
+		fmt.Printf("   Style: %s\n", debate.Style)
+		if debate.ReadOnly {
+			fmt.Println("   🔒 Read-only")
+		}
+		for i, agent := range debate.Participants() {
+			fmt.Printf("   Agent %c: %s (%s)%s\n", 'A'+i, agent.Name, agent.Provider, stanceSuffix(agent))
+		}
+		if debate.Judge != nil {
+			fmt.Printf("   %s\n", debate.Judge.Name)
+		}
+		if debate.Moderator != nil {
+			fmt.Printf("   %s\n", debate.Moderator.Name)
+		}
+		if debate.Budget != nil {
+			fmt.Printf("   Budget: %s\n", debate.Budget)
+		}
+		if debate.StopReason != "" {
+			fmt.Printf("   Stopped early: %s\n", debate.StopReason)
+		}
+		fmt.Printf("   Created: %s\n", debate.CreatedAt.Format(time.RFC3339))
+		fmt.Println()
+
+		if len(turns) > 0 {
+			fmt.Println(strings.Repeat("─", 60))
+			for _, turn := range turns {
+				agentName := getAgentName(debate, turn.AgentID)
+				fmt.Printf("\n📢 Turn %d (Round %d) - %s", turn.Number, turn.Round, agentName)
+				if len(turn.Versions) > 1 {
+					fmt.Printf(" [version %d of %d]", turn.SelectedVersion+1, len(turn.Versions))
+				}
+				fmt.Println()
+				fmt.Println(strings.Repeat("─", 40))
+				fmt.Println(turn.Content)
+				if turn.Steelman != nil {
+					fmt.Println(steelmanLine(debate, turn.Steelman))
+				}
+				if len(turn.References) > 0 {
+					fmt.Println(referencesLine(turn.References))
+				}
+			}
+		}
+
+		if len(debate.Conclusions) > 0 {
+			for _, c := range debate.Conclusions {
+				showConclusion(debate, c)
+			}
+		}
+
+		return nil
+	},
+}
+
+// ============================================================================
+// DELETE COMMAND
+// ============================================================================
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete [id]",
+	Short: "Delete a debate",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := getStorage()
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		eng := engine.New(store, getRegistry(), workspaces)
+		debateID, err := findDebateByPrefix(eng, args[0])
+		if err != nil {
+			return err
+		}
+
+		if err := eng.DeleteDebate(debateID); err != nil {
+			return err
+		}
+
+		fmt.Printf("Deleted debate: %s\n", debateID)
+		return nil
+	},
+}
+
+// ============================================================================
+// EXPORT COMMAND
+// ============================================================================
+
+var exportCmd = &cobra.Command{
+	Use:   "export [id] [format]",
+	Short: "Export debate to file",
+	Long: `Export a debate to markdown, PDF, or JSON.
+
+Examples:
+  conclave export abc123 markdown
+  conclave export abc123 pdf
+  conclave export abc123 json -o debate.json`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := getStorage()
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		eng := engine.New(store, getRegistry(), workspaces)
+		debateID, err := findDebateByPrefix(eng, args[0])
+		if err != nil {
+			return err
+		}
+
+		debate, turns, err := eng.GetDebateWithTurns(debateID)
+		if err != nil {
+			return err
+		}
+
+		format := export.Format(strings.ToLower(args[1]))
+		exporter, err := export.GetExporter(format)
+		if err != nil {
+			return err
+		}
+
+		outputPath, _ := cmd.Flags().GetString("output")
+		if outputPath == "" {
+			outputPath = export.GenerateFilename(debate, exporter.FileExtension())
+		}
+
+		file, err := os.Create(outputPath)
+		if err != nil {
+			return fmt.Errorf("failed to create file: %w", err)
+		}
+		defer file.Close()
+
+		if err := exporter.Export(debate, turns, file); err != nil {
+			return fmt.Errorf("failed to export: %w", err)
+		}
+
+		fmt.Printf("Exported to: %s\n", outputPath)
+		return nil
+	},
+}
+
+func init() {
+	exportCmd.Flags().StringP("output", "o", "", "Output file path")
+}
+
+// ============================================================================
+// ARGUMENTS COMMAND
+// ============================================================================
+
+var argumentsCmd = &cobra.Command{
+	Use:   "arguments [id]",
+	Short: "Show a debate's argument graph",
+	Long: `Extract the claims, evidence and rebuttals of a debate's turns and show
+them as an argument graph. Claims are extracted once and stored; only new
+turns are processed on later runs.
+
+Examples:
+  conclave arguments abc123
+  conclave arguments abc123 --format dot -o arguments.dot
+  conclave arguments abc123 --format mermaid`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, _ := cmd.Flags().GetString("format")
+		switch export.Format(format) {
+		case "text", export.FormatJSON, export.FormatDOT, export.FormatMermaid:
+		default:
+			return fmt.Errorf("invalid --format: %s (use text, json, dot, or mermaid)", format)
+		}
+
+		store, err := getStorage()
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		eng := engine.New(store, getRegistry(), workspaces)
+		debateID, err := findDebateByPrefix(eng, args[0])
+		if err != nil {
+			return err
+		}
+		debate, err := eng.GetDebate(debateID)
+		if err != nil {
+			return err
+		}
+
+		graph, err := eng.Arguments(cmd.Context(), debateID)
+		if graph == nil {
+			return err
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  %v (%d turns not extracted)\n", err, graph.Pending)
+		}
+
+		out := io.Writer(os.Stdout)
+		if outputPath, _ := cmd.Flags().GetString("output"); outputPath != "" {
+			file, err := os.Create(outputPath)
+			if err != nil {
+				return fmt.Errorf("failed to create file: %w", err)
+			}
+			defer file.Close()
+			out = file
+		}
+
+		switch export.Format(format) {
+		case export.FormatJSON:
+			enc := json.NewEncoder(out)
+			enc.SetIndent("", "  ")
+			return enc.Encode(graph)
+		case export.FormatDOT, export.FormatMermaid:
+			return export.ExportArguments(debate, graph, export.Format(format), out)
+		}
+		printArguments(out, debate, graph)
+		return nil
+	},
+}
+
+func init() {
+	argumentsCmd.Flags().String("format", "text", "Output format: text, json, dot, mermaid")
+	argumentsCmd.Flags().StringP("output", "o", "", "Output file path (default: stdout)")
+}
+
+// printArguments lists a debate's claims by turn and then the claims no
+// other agent answered.
+func printArguments(w io.Writer, debate *core.Debate, graph *core.ArgumentGraph) {
+	if len(graph.Claims) == 0 {
+		fmt.Fprintln(w, "No claims extracted.")
+		return
+	}
+
+	turn := 0
+	for _, c := range graph.Claims {
+		if c.TurnNumber != turn {
+			turn = c.TurnNumber
+			fmt.Fprintf(w, "\n📢 Turn %d - %s\n", turn, getAgentName(debate, c.AgentID))
+		}
+		fmt.Fprintf(w, "  [%s] %s\n", c.ID, c.Text)
+		for _, ev := range c.Evidence {
+			fmt.Fprintf(w, "         • %s\n", ev)
+		}
+		if len(c.Rebuts) > 0 {
+			fmt.Fprintf(w, "         ↩ rebuts %s\n", strings.Join(c.Rebuts, ", "))
+		}
+	}
+
+	unanswered := graph.Unanswered()
+	fmt.Fprintf(w, "\n❓ Unanswered claims (%d):\n", len(unanswered))
+	for _, c := range unanswered {
+		fmt.Fprintf(w, "  [%s] %s: %s\n", c.ID, getAgentName(debate, c.AgentID), c.Text)
+	}
+}
+
+// ============================================================================
+// LOCK COMMAND
+// ============================================================================
+
+var lockCmd = &cobra.Command{
+	Use:   "lock [id]",
+	Short: "Toggle read-only lock on a debate",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := getStorage()
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		sqlStore, ok := store.(*storage.SQLiteStorage)
+		if !ok {
+			return fmt.Errorf("lock not supported for this storage type")
+		}
+
+		eng := engine.New(store, getRegistry(), workspaces)
+		debateID, err := findDebateByPrefix(eng, args[0])
+		if err != nil {
+			return err
+		}
+
+		debate, err := eng.GetDebate(debateID)
+		if err != nil {
+			return err
+		}
+
+		newState := !debate.ReadOnly
+		if err := sqlStore.SetReadOnly(debateID, newState); err != nil {
+			return err
+		}
+
+		if newState {
+			fmt.Printf("🔒 Locked debate: %s\n", debateID[:8])
+		} else {
+			fmt.Printf("🔓 Unlocked debate: %s\n", debateID[:8])
+		}
+		return nil
+	},
+}
+
+// ============================================================================
+// RUN CONTROL COMMANDS
+// ============================================================================
+
+var pauseCmd = &cobra.Command{
+	Use:   "pause [id]",
+	Short: "Pause a running debate or council",
+	Long: `Pause a running debate or council. A session running in another process
+(such as conclave serve) stops before its next turn or stage.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withSession(args[0], func(store storage.Storage, eng *engine.Engine, councilEng *council.Engine, kind, id string) error {
+			var err error
+			if kind == "council" {
+				err = councilEng.PauseCouncil(id)
+			} else {
+				err = eng.PauseDebate(id)
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Printf("⏸️  Pause requested for %s %s\n", kind, id[:8])
+			return nil
+		})
+	},
+}
+
+var resumeCmd = &cobra.Command{
+	Use:   "resume [id]",
+	Short: "Resume a paused debate or council",
+	Long: `Resume a paused or interrupted debate or council from its next turn or stage
+and wait for it to finish. A debate waiting for your turn prompts for it first.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withSession(args[0], func(store storage.Storage, eng *engine.Engine, councilEng *council.Engine, kind, id string) error {
+			if kind == "debate" {
+				debate, err := eng.GetDebate(id)
+				if err != nil {
+					return err
+				}
+				if debate.Status == core.StatusAwaitingInput {
+					// Prompt for the pending human turn and run in the foreground
+					return runDebate(cmd.Context(), eng, debate)
+				}
+			}
+
+			sigCh := make(chan os.Signal, 1)
+			signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+			defer signal.Stop(sigCh)
+
+			if kind == "council" {
+				if err := councilEng.ResumeCouncil(id); err != nil {
+					return err
+				}
+				fmt.Printf("▶️  Resuming council %s...\n", id[:8])
+				go func() {
+					<-sigCh
+					fmt.Println("\n\nInterrupted. Saving council state...")
+					councilEng.PauseCouncil(id)
+				}()
+				councilEng.WaitCouncil(cmd.Context(), id)
+
+				c, err := store.GetCouncil(id)
+				if err != nil {
+					return err
+				}
+				if c.Status != core.StatusCompleted {
+					fmt.Printf("\nCouncil %s.\n", c.Status)
+					return nil
+				}
+				fmt.Println(strings.Repeat("═", 60))
+				fmt.Println("🏁 FINAL SYNTHESIS")
+				fmt.Println(strings.Repeat("═", 60))
+				if len(c.Syntheses) > 0 {
+					fmt.Println(c.Syntheses[len(c.Syntheses)-1].Content)
+				}
+				return nil
+			}
+
+			if err := eng.ResumeDebate(id, printTurn); err != nil {
+				return err
+			}
+			fmt.Printf("▶️  Resuming debate %s...\n", id[:8])
+			go func() {
+				<-sigCh
+				fmt.Println("\n\nInterrupted. Saving debate state...")
+				eng.PauseDebate(id)
+			}()
+			eng.WaitDebate(cmd.Context(), id)
+
+			debate, err := eng.GetDebate(id)
+			if err != nil {
+				return err
+			}
+			if debate.Status != core.StatusCompleted {
+				fmt.Printf("\nDebate %s.\n", debate.Status)
+				return nil
+			}
+			showFinalConclusions(eng, id)
+			return nil
+		})
+	},
+}
+
+var cancelCmd = &cobra.Command{
+	Use:   "cancel [id]",
+	Short: "Cancel a debate or council",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withSession(args[0], func(store storage.Storage, eng *engine.Engine, councilEng *council.Engine, kind, id string) error {
+			var err error
+			if kind == "council" {
+				err = councilEng.CancelCouncil(id)
+			} else {
+				err = eng.CancelDebate(id)
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Printf("⏹️  Cancelled %s %s\n", kind, id[:8])
+			return nil
+		})
+	},
+}
+
+var forkCmd = &cobra.Command{
+	Use:   "fork [id]",
+	Short: "Fork a debate or council from a turn or round",
+	Long: `Copy a debate up to a turn, or a council up to a round, into a new session
+linked to the original. Agents can be swapped in the fork, and a debate fork
+can rewrite the content of its last turn or switch style. An unfinished fork
+is left paused; continue it with conclave resume.`,
+	Example: `  conclave fork 3f2a --at 4 --swap B=gemini:skeptic
+  conclave fork 3f2a --at 3 --content "What if we dropped the cache entirely?"
+  conclave fork 9c1e --at 1 --swap 2=codex`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		at, _ := cmd.Flags().GetInt("at")
+		swapFlags, _ := cmd.Flags().GetStringArray("swap")
+		cfg := core.ForkConfig{At: at}
+		cfg.Content, _ = cmd.Flags().GetString("content")
+		cfg.Style, _ = cmd.Flags().GetString("style")
+		for _, f := range swapFlags {
+			swap, err := parseSwap(f)
+			if err != nil {
+				return err
+			}
+			cfg.Swaps = append(cfg.Swaps, swap)
+		}
+
+		return withSession(args[0], func(store storage.Storage, eng *engine.Engine, councilEng *council.Engine, kind, id string) error {
+			var forkID string
+			var status core.DebateStatus
+			if kind == "council" {
+				fork, err := councilEng.ForkCouncil(id, cfg)
+				if err != nil {
+					return err
+				}
+				forkID, status = fork.ID, fork.Status
+			} else {
+				fork, err := eng.ForkDebate(id, cfg)
+				if err != nil {
+					return err
+				}
+				forkID, status = fork.ID, fork.Status
+			}
+
+			fmt.Printf("🍴 Forked %s %s into %s (%s)\n", kind, id[:8], forkID[:8], status)
+			if status == core.StatusPaused {
+				fmt.Printf("Continue it with: conclave resume %s\n", forkID[:8])
+			}
+			return nil
+		})
+	},
+}
+
+// parseSwap parses "seat=provider[/model][:persona]" where seat is a debate
+// agent letter (A, B, ...) or a 1-based council member number. Any part of
+// the value may be empty to keep the original, e.g. "B=:skeptic".
+func parseSwap(s string) (core.AgentSwap, error) {
+	seat, spec, ok := strings.Cut(s, "=")
+	if !ok || seat == "" {
+		return core.AgentSwap{}, fmt.Errorf("invalid swap: %s (expected seat=provider[/model][:persona])", s)
+	}
+
+	var swap core.AgentSwap
+	if n, err := strconv.Atoi(seat); err == nil {
+		swap.Seat = n - 1
+	} else if len(seat) == 1 && unicode.IsLetter(rune(seat[0])) {
+		swap.Seat = int(unicode.ToUpper(rune(seat[0])) - 'A')
+	} else {
+		return core.AgentSwap{}, fmt.Errorf("invalid swap seat: %s (expected a letter or number)", seat)
+	}
+	if swap.Seat < 0 {
+		return core.AgentSwap{}, fmt.Errorf("invalid swap seat: %s", seat)
+	}
+
+	providerPart, pers, _ := strings.Cut(spec, ":")
+	swap.Provider, swap.Model, _ = strings.Cut(providerPart, "/")
+	swap.Persona = pers
+	if swap.Provider == "" && swap.Model == "" && swap.Persona == "" {
+		return core.AgentSwap{}, fmt.Errorf("invalid swap: %s (nothing to change)", s)
+	}
+	return swap, nil
+}
+
+var forksCmd = &cobra.Command{
+	Use:   "forks [id]",
+	Short: "Show the fork tree of a debate or council",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withSession(args[0], func(store storage.Storage, eng *engine.Engine, councilEng *council.Engine, kind, id string) error {
+			var tree *core.ForkNode
+			var err error
+			point := "turn"
+			if kind == "council" {
+				tree, err = councilEng.CouncilForkTree(id)
+				point = "round"
+			} else {
+				tree, err = eng.DebateForkTree(id)
+			}
+			if err != nil {
+				return err
+			}
+			printForkTree(tree, id, point, "", true, true)
+			return nil
+		})
+	},
+}
+
+// printForkTree prints a fork tree with box-drawing branches, marking the
+// session that was asked for.
+func printForkTree(n *core.ForkNode, current, point, prefix string, root, last bool) {
+	branch, next := "", ""
+	if !root {
+		branch, next = "├── ", "│   "
+		if last {
+			branch, next = "└── ", "    "
+		}
+	}
+
+	line := fmt.Sprintf("%s%s%s  %s  [%s]", prefix, branch, n.ID[:8], n.Title, n.Status)
+	if !root {
+		line += fmt.Sprintf("  (from %s %d)", point, n.ForkPoint)
+	}
+	if n.ID == current {
+		line += "  ◀"
+	}
+	fmt.Println(line)
+
+	for i, f := range n.Forks {
+		printForkTree(f, current, point, prefix+next, false, i == len(n.Forks)-1)
+	}
+}
+
+func init() {
+	forkCmd.Flags().Int("at", 0, "Last turn (debates) or round (councils) to copy (default: all)")
+	forkCmd.Flags().StringArray("swap", nil, "Swap an agent: seat=provider[/model][:persona], seat is A, B, ... or a member number (repeatable)")
+	forkCmd.Flags().String("content", "", "Debates only: replace the content of the fork point turn")
+	forkCmd.Flags().String("style", "", "Debates only: switch the debate style")
+}
+
+var regenerateCmd = &cobra.Command{
+	Use:   "regenerate [id] [turn|member]",
+	Short: "Regenerate a debate turn or council response",
+	Long: `Regenerate a debate turn (by turn number) or a council member's Stage 1
+response (by member number, in the latest round unless --round is given) with
+the same prompt context. The new version is selected and earlier versions are
+kept; see them with conclave versions. Run conclave rerun afterwards to redo
+the round's conclusion, or rankings and synthesis, with the new version.`,
+	Example: `  conclave regenerate 3f2a 4
+  conclave regenerate 9c1e 2 --round 1`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		round, _ := cmd.Flags().GetInt("round")
+		return withSession(args[0], func(store storage.Storage, eng *engine.Engine, councilEng *council.Engine, kind, id string) error {
+			fmt.Printf("🔁 Regenerating %s %s...\n", kind, args[1])
+			var content string
+			var count int
+			if kind == "council" {
+				response, err := findCouncilResponse(store, id, args[1], round)
+				if err != nil {
+					return err
+				}
+				regenerated, err := councilEng.RegenerateResponse(cmd.Context(), id, response.ID)
+				if err != nil {
+					return err
+				}
+				content, count = regenerated.Content, len(regenerated.Versions)
+			} else {
+				turn, err := findDebateTurn(store, id, args[1])
+				if err != nil {
+					return err
+				}
+				regenerated, err := eng.RegenerateTurn(cmd.Context(), id, turn.ID)
+				if err != nil {
+					return err
+				}
+				content, count = regenerated.Content, len(regenerated.Versions)
+			}
+
+			fmt.Println(strings.Repeat("─", 40))
+			fmt.Println(content)
+			fmt.Println(strings.Repeat("─", 40))
+			fmt.Printf("Selected version %d of %d\n", count, count)
+			return nil
+		})
+	},
+}
+
+var versionsCmd = &cobra.Command{
+	Use:   "versions [id] [turn|member]",
+	Short: "List or select versions of a regenerated turn or response",
+	Example: `  conclave versions 3f2a 4
+  conclave versions 3f2a 4 --select 1`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		round, _ := cmd.Flags().GetInt("round")
+		sel, _ := cmd.Flags().GetInt("select")
+		return withSession(args[0], func(store storage.Storage, eng *engine.Engine, councilEng *council.Engine, kind, id string) error {
+			var versions []*core.Version
+			var selected int
+			if kind == "council" {
+				response, err := findCouncilResponse(store, id, args[1], round)
+				if err != nil {
+					return err
+				}
+				if sel > 0 {
+					if response, err = councilEng.SelectResponseVersion(id, response.ID, sel-1); err != nil {
+						return err
+					}
+				}
+				versions, selected = response.Versions, response.SelectedVersion
+				if len(versions) == 0 {
+					versions = []*core.Version{response.CurrentVersion()}
+				}
+			} else {
+				turn, err := findDebateTurn(store, id, args[1])
+				if err != nil {
+					return err
+				}
+				if sel > 0 {
+					if turn, err = eng.SelectTurnVersion(id, turn.ID, sel-1); err != nil {
+						return err
+					}
+				}
+				versions, selected = turn.Versions, turn.SelectedVersion
+				if len(versions) == 0 {
+					versions = []*core.Version{turn.CurrentVersion()}
+				}
+			}
+
+			for i, v := range versions {
+				marker := " "
+				if i == selected {
+					marker = "●"
+				}
+				preview := strings.Join(strings.Fields(v.Content), " ")
+				if v.Status == "failed" {
+					preview = "(failed) " + v.Error
+				}
+				if len(preview) > 70 {
+					preview = preview[:67] + "..."
+				}
+				fmt.Printf("%s %d  %s  %s\n", marker, i+1, v.CreatedAt.Format("2006-01-02 15:04"), preview)
+			}
+			return nil
+		})
+	},
+}
+
+var rerunCmd = &cobra.Command{
+	Use:   "rerun [id]",
+	Short: "Rerun the latest round's conclusion, or rankings and synthesis",
+	Long: `Discard the latest round's conclusion (debates) or rankings and synthesis
+(councils) and generate them again from the selected turn or response versions.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withSession(args[0], func(store storage.Storage, eng *engine.Engine, councilEng *council.Engine, kind, id string) error {
+			if kind == "council" {
+				if err := councilEng.RerunStages(id); err != nil {
+					return err
+				}
+				fmt.Printf("🔁 Rerunning rankings and synthesis for council %s...\n", id[:8])
+				councilEng.WaitCouncil(cmd.Context(), id)
+
+				c, err := store.GetCouncil(id)
+				if err != nil {
+					return err
+				}
+				if c.Status != core.StatusCompleted || len(c.Syntheses) == 0 {
+					fmt.Printf("\nCouncil %s.\n", c.Status)
+					return nil
+				}
+				fmt.Println(c.Syntheses[len(c.Syntheses)-1].Content)
+				return nil
+			}
+
+			if err := eng.RerunConclusion(id, printTurn); err != nil {
+				return err
+			}
+			fmt.Printf("🔁 Rerunning conclusion for debate %s...\n", id[:8])
+			eng.WaitDebate(cmd.Context(), id)
+
+			debate, err := eng.GetDebate(id)
+			if err != nil {
+				return err
+			}
+			if debate.Status != core.StatusCompleted {
+				fmt.Printf("\nDebate %s.\n", debate.Status)
+				return nil
+			}
+			showFinalConclusions(eng, id)
+			return nil
+		})
+	},
+}
+
+var swapSidesCmd = &cobra.Command{
+	Use:   "swap-sides [id]",
+	Short: "Rerun a debate with the agents' stances swapped",
+	Long: `Create and run a copy of a debate where each agent argues the next seat's
+stance (the two sides are swapped in a two-agent debate). Providers, models
+and personas keep their seats. The two outcomes are compared afterwards to show
+whether the result depends on the side or on the agent arguing it; a judge
+(--judge on conclave new) is needed to pick winners.`,
+	Example: `  conclave swap-sides 3f2a`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := getStorage()
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		eng := engine.New(store, getRegistry(), workspaces)
+		debateID, err := findDebateByPrefix(eng, args[0])
+		if err != nil {
+			return err
+		}
+
+		swapped, err := eng.SwapSides(debateID)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("\n🔄 Swapped sides of %s into %s\n", debateID[:8], swapped.ID[:8])
+		for i, agent := range swapped.Participants() {
+			fmt.Printf("   Agent %c: %s%s\n", 'A'+i, agent.Name, stanceSuffix(agent))
+		}
+		fmt.Println(strings.Repeat("─", 60))
+
+		if err := runDebate(cmd.Context(), eng, swapped); err != nil {
+			return err
+		}
+
+		cmp, err := eng.CompareSides(swapped.ID)
+		if err != nil {
+			return err
+		}
+		printSideComparison(cmp)
+		return nil
+	},
+}
+
+var sidesCmd = &cobra.Command{
+	Use:   "sides [id]",
+	Short: "Compare a debate with its swap-sides rerun",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := getStorage()
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		eng := engine.New(store, getRegistry(), workspaces)
+		debateID, err := findDebateByPrefix(eng, args[0])
+		if err != nil {
+			return err
+		}
+
+		cmp, err := eng.CompareSides(debateID)
+		if err != nil {
+			return err
+		}
+		printSideComparison(cmp)
+		return nil
+	},
+}
+
+// printSideComparison prints both runs of a swap-sides comparison and what
+// the outcome depended on.
+func printSideComparison(cmp *core.SideComparison) {
+	fmt.Println()
+	fmt.Println(strings.Repeat("═", 60))
+	fmt.Println("⚖️  SIDE COMPARISON")
+	fmt.Println(strings.Repeat("═", 60))
+	for _, r := range []struct {
+		label  string
+		result *core.SideResult
+	}{{"Original", cmp.Original}, {"Swapped ", cmp.Swapped}} {
+		outcome := "disagreed"
+		switch {
+		case r.result.Status != core.StatusCompleted:
+			outcome = string(r.result.Status)
+		case r.result.Outcome == core.VerdictWinner:
+			outcome = fmt.Sprintf("won by %s (%s)", r.result.Winner, r.result.WinnerStance)
+		case r.result.Outcome != "":
+			outcome = string(r.result.Outcome)
+		case r.result.Agreed:
+			outcome = "agreed"
+		}
+		fmt.Printf("   %s %s: %s\n", r.label, r.result.DebateID[:8], outcome)
+	}
+
+	switch cmp.DependsOn {
+	case core.DependsOnSide:
+		fmt.Println("\nThe same side won both runs: the outcome follows the side, not the agent.")
+	case core.DependsOnProvider:
+		fmt.Println("\nThe same agent won both runs: the outcome follows the agent, not the side.")
+	case core.DependsOnNeither:
+		fmt.Println("\nBoth runs ended the same way regardless of sides.")
+	default:
+		fmt.Println("\nInconclusive: both runs need to finish, with a judge to pick winners.")
+	}
+}
+
+func init() {
+	regenerateCmd.Flags().Int("round", 0, "Councils only: round of the response (default: latest)")
+	versionsCmd.Flags().Int("round", 0, "Councils only: round of the response (default: latest)")
+	versionsCmd.Flags().Int("select", 0, "Select this version (1-based) for later steps")
+}
+
+// findDebateTurn resolves a turn number to the debate's turn.
+func findDebateTurn(store storage.Storage, debateID, number string) (*core.Turn, error) {
+	n, err := strconv.Atoi(number)
+	if err != nil {
+		return nil, fmt.Errorf("invalid turn number: %s", number)
+	}
+	turns, err := store.GetTurns(debateID)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range turns {
+		if t.Number == n {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("turn %d not found", n)
+}
+
+// findCouncilResponse resolves a 1-based member number to that member's
+// Stage 1 response in round, or in the latest round when round is 0.
+func findCouncilResponse(store storage.Storage, councilID, member string, round int) (*core.Response, error) {
+	n, err := strconv.Atoi(member)
+	if err != nil {
+		return nil, fmt.Errorf("invalid member number: %s", member)
+	}
+	c, err := store.GetCouncil(councilID)
+	if err != nil {
+		return nil, err
+	}
+	if n < 1 || n > len(c.Members) {
+		return nil, fmt.Errorf("member %d not found (council has %d members)", n, len(c.Members))
+	}
+	responses, err := store.GetResponses(councilID)
+	if err != nil {
+		return nil, err
+	}
+	if round == 0 && len(responses) > 0 {
+		round = responses[len(responses)-1].Round
+	}
+	for _, r := range responses {
+		if r.Round == round && r.MemberID == c.Members[n-1].ID {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("member %d has no response in round %d", n, round)
+}
+
+// withSession resolves a debate or council ID prefix and calls fn with
+// the storage connection and engines built on it. kind is "debate" or "council".
+func withSession(prefix string, fn func(store storage.Storage, eng *engine.Engine, councilEng *council.Engine, kind, id string) error) error {
+	store, err := getStorage()
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	registry := getRegistry()
+	eng := engine.New(store, registry, workspaces)
+	councilEng := council.New(store, registry, workspaces)
+
+	if id, err := findDebateByPrefix(eng, prefix); err == nil {
+		return fn(store, eng, councilEng, "debate", id)
+	}
+
+	councils, _ := store.ListCouncils(100, 0)
+	for _, c := range councils {
+		if strings.HasPrefix(c.ID, prefix) {
+			return fn(store, eng, councilEng, "council", c.ID)
+		}
+	}
+	return fmt.Errorf("debate or council not found: %s", prefix)
+}
+
+// ============================================================================
+// COUNCIL COMMAND
+// ============================================================================
+
+var councilCmd = &cobra.Command{
+	Use:     "council",
+	Short:   "Work with completed councils",
+	Aliases: []string{"councils"},
+}
+
+var councilAskCmd = &cobra.Command{
+	Use:   "ask [id] [question...]",
+	Short: "Ask a completed council's chairman a question",
+	Long: `Ask the chairman of a completed council a clarifying question. The chairman
+answers from the council's responses, rankings and syntheses without the
+members running again, and the answer is saved to the council's timeline.
+
+Examples:
+  conclave council ask 3f2a "Which option did the skeptic rank highest?"
+  conclave council ask 3f2a What would change the conclusion?`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withSession(args[0], func(store storage.Storage, eng *engine.Engine, councilEng *council.Engine, kind, id string) error {
+			if kind != "council" {
+				return fmt.Errorf("%s is a debate; only councils have a chairman", args[0])
+			}
+
+			fmt.Printf("🏛️  Asking the chairman of council %s...\n", id[:8])
+			answer, err := councilEng.AskChairman(cmd.Context(), id, strings.Join(args[1:], " "))
+			if err != nil {
+				return err
+			}
+			fmt.Println(strings.Repeat("─", 60))
+			fmt.Println(answer.Content)
+			if len(answer.References) > 0 {
+				fmt.Println(referencesLine(answer.References))
+			}
+			return nil
+		})
+	},
+}
+
+func init() {
+	councilCmd.AddCommand(councilAskCmd)
+}
+
+// ============================================================================
+// BATCH COMMAND
+// ============================================================================
+
+var batchCmd = &cobra.Command{
+	Use:   "batch [file]",
+	Short: "Run a debate or council for every row of a YAML or CSV file",
+	Long: `Run a debate or council for every row of a topic file, a few at a time,
+and write a JSONL report with each row's status, tokens, duration and an
+excerpt of its conclusion or synthesis.
+
+Rerunning the same file skips the rows the report records as completed and
+resumes the ones that were interrupted. Rows are keyed by their id, or by a
+hash of their fields when they have none. Ctrl+C pauses the running sessions.
+
+YAML rows (a list of mappings):
+  - id: graphql
+    topic: Should we adopt GraphQL?
+    mode: council
+    members: [claude:pragmatist, gemini:skeptic]
+  - topic: Monolith or microservices?
+    style: adversarial
+    project: Platform
+
+CSV rows (a header names the columns; separate members with ; or quote them):
+  id,topic,mode,members,chairman,style,turns,project
+  graphql,Should we adopt GraphQL?,council,claude:pragmatist;gemini:skeptic,,,,
+  ,Monolith or microservices?,,,,adversarial,3,Platform
+
+Flags give the defaults for fields a row leaves empty.
+
+Examples:
+  conclave batch questions.yaml
+  conclave batch questions.csv --mode council --members claude,gemini,qwen --workers 4
+  conclave batch questions.yaml --report results.jsonl --max-tokens 50000`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBatch,
+}
+
+var (
+	batchModeFlag     string
+	batchMembersFlag  string
+	batchChairmanFlag string
+	batchStyleFlag    string
+	batchTurnsFlag    int
+	batchProjectFlag  string
+	batchWorkersFlag  int
+	batchReportFlag   string
+)
+
+func init() {
+	batchCmd.Flags().StringVar(&batchModeFlag, "mode", string(batch.ModeDebate), "Default mode: debate, council")
+	batchCmd.Flags().StringVarP(&batchMembersFlag, "members", "m", "", "Default debate agents or council members (comma-separated: provider[/model][:persona],...)")
+	batchCmd.Flags().StringVar(&batchChairmanFlag, "chairman", "", "Default council chairman (provider[/model])")
+	batchCmd.Flags().StringVarP(&batchStyleFlag, "style", "s", batch.DefaultStyle, "Default debate style")
+	batchCmd.Flags().IntVarP(&batchTurnsFlag, "turns", "t", batch.DefaultMaxTurns, "Default turns per agent")
+	batchCmd.Flags().StringVar(&batchProjectFlag, "project", "", "Default project (ID or name)")
+	batchCmd.Flags().IntVarP(&batchWorkersFlag, "workers", "w", batch.DefaultWorkers, "Sessions to run at once")
+	batchCmd.Flags().StringVarP(&batchReportFlag, "report", "r", "", "JSONL report path (default: <file>.report.jsonl)")
+
+	// Budget flags apply to each session
+	batchCmd.Flags().IntVar(&maxTokensFlag, "max-tokens", 0, "Stop each session once it has used this many tokens")
+	batchCmd.Flags().Float64Var(&maxCostFlag, "max-cost", 0, "Stop each session once it has cost this many US dollars")
+	batchCmd.Flags().DurationVar(&maxTimeFlag, "max-time", 0, "Stop each session once a run has taken this long (e.g. 10m)")
+}
+
+func runBatch(cmd *cobra.Command, args []string) error {
+	path := args[0]
+	rows, err := batch.Load(path)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("no rows in %s", path)
+	}
+
+	opts := batch.Options{
+		Defaults: batch.Row{
+			Mode:    batch.Mode(batchModeFlag),
+			Style:   batchStyleFlag,
+			Turns:   batchTurnsFlag,
+			Project: batchProjectFlag,
+		},
+		Workers: batchWorkersFlag,
+		Report:  batchReportFlag,
+		Budget:  budgetFromFlags(),
+	}
+	if opts.Defaults.Mode != batch.ModeDebate && opts.Defaults.Mode != batch.ModeCouncil {
+		return fmt.Errorf("invalid --mode: %s (expected debate or council)", batchModeFlag)
+	}
+	if batchMembersFlag != "" {
+		if opts.Defaults.Members, err = core.ParseMemberSpecs(batchMembersFlag); err != nil {
+			return fmt.Errorf("invalid --members: %w", err)
+		}
+	}
+	if batchChairmanFlag != "" {
+		chairman, err := core.ParseMemberSpec(batchChairmanFlag)
+		if err != nil {
+			return fmt.Errorf("invalid --chairman: %w", err)
+		}
+		opts.Defaults.Chairman = &chairman
+	}
+	if opts.Report == "" {
+		opts.Report = strings.TrimSuffix(path, filepath.Ext(path)) + ".report.jsonl"
+	}
+
+	store, err := getStorage()
+	if err != nil {
+		return fmt.Errorf("failed to initialize storage: %w", err)
+	}
+	defer store.Close()
+
+	registry := getRegistry()
+	runner := batch.New(store, engine.New(store, registry, workspaces), council.New(store, registry, workspaces))
+
+	ctx, cancel := context.WithCancelCause(cmd.Context())
+	defer cancel(nil)
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+	go func() {
+		<-sigCh
+		fmt.Println("\n\nInterrupted. Pausing running sessions...")
+		cancel(run.ErrPaused)
+	}()
+
+	fmt.Printf("\n📋 Batch: %s (%d rows, %d workers)\n", path, len(rows), batchWorkersFlag)
+	fmt.Printf("   Report: %s\n\n", opts.Report)
+
+	var mu sync.Mutex
+	done := 0
+	progress := func(icon, id, detail string) {
+		mu.Lock()
+		defer mu.Unlock()
+		done++
+		fmt.Printf("  [%d/%d] %s %s %s\n", done, len(rows), icon, id, detail)
+	}
+	callbacks := &batch.Callbacks{
+		OnRowSkipped: func(row *batch.Row, previous *batch.Result) {
+			progress("⏭️ ", row.ID, "already completed")
+		},
+		OnRowStart: func(row *batch.Row) {
+			topic := row.Topic
+			if len(topic) > 60 {
+				topic = topic[:57] + "..."
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			fmt.Printf("  ▶️  %s %s\n", row.ID, topic)
+		},
+		OnRowComplete: func(row *batch.Row, result *batch.Result) {
+			icon := "✅"
+			switch result.Status {
+			case core.StatusFailed:
+				icon = "❌"
+			case core.StatusPaused:
+				icon = "⏸️ "
+			}
+			detail := fmt.Sprintf("%s in %s, %d tokens", result.Status, (time.Duration(result.DurationMs) * time.Millisecond).Round(time.Second), result.Tokens)
+			if result.Error != "" {
+				detail += ": " + result.Error
+			}
+			progress(icon, row.ID, detail)
+		},
+	}
+
+	results, err := runner.Run(ctx, rows, opts, callbacks)
+	if results != nil {
+		printBatchSummary(results)
+	}
+	if ctx.Err() != nil {
+		fmt.Printf("\nBatch paused. Resume with: conclave batch %s\n", path)
+		return nil
+	}
+	return err
+}
+
+func printBatchSummary(results []*batch.Result) {
+	fmt.Println("\nSummary:")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tMODE\tSTATUS\tTOKENS\tDURATION\tSESSION")
+	fmt.Fprintln(w, "──\t────\t──────\t──────\t────────\t───────")
+	counts := make(map[core.DebateStatus]int)
+	for _, r := range results {
+		if r == nil {
+			counts[core.StatusPending]++
+			continue
+		}
+		counts[r.Status]++
+		session := r.SessionID
+		if len(session) > 8 {
+			session = session[:8]
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\n",
+			r.ID, r.Mode, r.Status, r.Tokens, (time.Duration(r.DurationMs) * time.Millisecond).Round(time.Second), session)
+	}
+	w.Flush()
+	fmt.Printf("\n%d completed, %d failed, %d paused, %d not started\n",
+		counts[core.StatusCompleted], counts[core.StatusFailed], counts[core.StatusPaused], counts[core.StatusPending])
+}
+
+// ============================================================================
+// TOURNAMENT COMMAND
+// ============================================================================
+
+var tournamentCmd = &cobra.Command{
+	Use:     "tournament",
+	Short:   "Run judged debate tournaments and show the Elo leaderboard",
+	Aliases: []string{"tournaments"},
+}
+
+var tournamentRunCmd = &cobra.Command{
+	Use:   "run [topic...]",
+	Short: "Run a tournament on one or more topics",
+	Long: `Pair every entrant against the others in judged two-agent debates and
+update the persistent Elo leaderboard with each verdict.
+
+Examples:
+  conclave tournament run "Tabs or spaces?" -e claude:skeptic,gemini:skeptic,codex:skeptic --judge claude/opus:analyst
+  conclave tournament run "Monorepo?" "Microservices?" -e claude,gemini,qwen,codex --judge claude --format bracket --workers 4`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entrants, err := core.ParseMemberSpecs(tournamentEntrantsFlag)
+		if err != nil {
+			return fmt.Errorf("invalid --entrants: %w", err)
+		}
+		judge, err := core.ParseMemberSpec(tournamentJudgeFlag)
+		if err != nil {
+			return fmt.Errorf("invalid --judge: %w", err)
+		}
+
+		return withTournamentRunner(func(runner *tournament.Runner) error {
+			t, err := runner.CreateTournament(core.NewTournamentConfig{
+				Name:     tournamentNameFlag,
+				Topics:   args,
+				Entrants: entrants,
+				Judge:    judge,
+				Format:   core.TournamentFormat(tournamentFormatFlag),
+				Style:    tournamentStyleFlag,
+				MaxTurns: tournamentTurnsFlag,
+				Workers:  tournamentWorkersFlag,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create tournament: %w", err)
+			}
+
+			fmt.Printf("\n🏆 Tournament: %s\n", t.Name)
+			fmt.Printf("   Format: %s | Style: %s | Turns: %d per agent | Workers: %d\n", t.Format, t.Style, t.MaxTurns, t.Workers)
+			fmt.Printf("   Entrants: %d | Judge: %s\n", len(t.Entrants), t.Judge.String())
+			fmt.Printf("   ID: %s\n\n", t.ID)
+			return runTournament(cmd.Context(), runner, t)
+		})
+	},
+}
+
+var (
+	tournamentNameFlag     string
+	tournamentEntrantsFlag string
+	tournamentJudgeFlag    string
+	tournamentFormatFlag   string
+	tournamentStyleFlag    string
+	tournamentTurnsFlag    int
+	tournamentWorkersFlag  int
+)
+
+var tournamentResumeCmd = &cobra.Command{
+	Use:   "resume [id]",
+	Short: "Retry the unfinished matches of a paused or failed tournament",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withTournamentRunner(func(runner *tournament.Runner) error {
+			t, err := findTournament(runner, args[0])
+			if err != nil {
+				return err
+			}
+			if t.Status == core.StatusCompleted {
+				return fmt.Errorf("tournament is already completed")
+			}
+			return runTournament(cmd.Context(), runner, t)
+		})
+	},
+}
+
+var tournamentListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List tournaments",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withTournamentRunner(func(runner *tournament.Runner) error {
+			tournaments, err := runner.ListTournaments(50, 0)
+			if err != nil {
+				return err
+			}
+			if len(tournaments) == 0 {
+				fmt.Println("No tournaments found. Start one with: conclave tournament run \"Your topic\" -e claude,gemini --judge claude")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tNAME\tFORMAT\tENTRANTS\tSTATUS\tCREATED")
+			fmt.Fprintln(w, "──\t────\t──────\t────────\t──────\t───────")
+			for _, t := range tournaments {
+				name := t.Name
+				if len(name) > 35 {
+					name = name[:32] + "..."
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\n",
+					t.ID[:8], name, t.Format, len(t.Entrants), t.Status, t.CreatedAt.Format("2006-01-02 15:04"))
+			}
+			w.Flush()
+			return nil
+		})
+	},
+}
+
+var tournamentShowCmd = &cobra.Command{
+	Use:   "show [id]",
+	Short: "Show a tournament's matches and standings",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withTournamentRunner(func(runner *tournament.Runner) error {
+			t, err := findTournament(runner, args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("\n🏆 Tournament: %s\n", t.Name)
+			fmt.Printf("   ID: %s\n", t.ID)
+			fmt.Printf("   Status: %s\n", t.Status)
+			fmt.Printf("   Format: %s | Style: %s | Judge: %s\n", t.Format, t.Style, t.Judge.String())
+			fmt.Printf("   Topics: %s\n\n", strings.Join(t.Topics, " | "))
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ROUND\tMATCH\tRESULT\tDEBATE")
+			fmt.Fprintln(w, "─────\t─────\t──────\t──────")
+			for _, m := range t.Matches {
+				debateID := m.DebateID
+				if len(debateID) > 8 {
+					debateID = debateID[:8]
+				}
+				fmt.Fprintf(w, "%d\t%s vs %s\t%s\t%s\n", m.Round, m.EntrantA, m.EntrantB, matchResultLabel(m), debateID)
+			}
+			w.Flush()
+
+			printStandings(t)
+			return nil
+		})
+	},
+}
+
+var tournamentLeaderboardCmd = &cobra.Command{
+	Use:   "leaderboard",
+	Short: "Show Elo ratings from every judged tournament match",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withTournamentRunner(func(runner *tournament.Runner) error {
+			ratings, err := runner.Leaderboard()
+			if err != nil {
+				return err
+			}
+			if len(ratings) == 0 {
+				fmt.Println("No rated matches yet. Run a tournament with: conclave tournament run")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "#\tENTRANT\tRATING\tMATCHES\tW-L-D")
+			fmt.Fprintln(w, "─\t───────\t──────\t───────\t─────")
+			for i, r := range ratings {
+				fmt.Fprintf(w, "%d\t%s\t%.0f\t%d\t%d-%d-%d\n", i+1, r.Entrant, r.Rating, r.Matches, r.Wins, r.Losses, r.Draws)
+			}
+			w.Flush()
+			return nil
+		})
+	},
+}
+
+func init() {
+	tournamentRunCmd.Flags().StringVar(&tournamentNameFlag, "name", "", "Tournament name (defaults to the first topic)")
+	tournamentRunCmd.Flags().StringVarP(&tournamentEntrantsFlag, "entrants", "e", "", "Entrants (comma-separated: provider[/model][:persona],...)")
+	tournamentRunCmd.Flags().StringVar(&tournamentJudgeFlag, "judge", "", "Judge that scores every match (provider[/model][:persona])")
+	tournamentRunCmd.Flags().StringVar(&tournamentFormatFlag, "format", string(core.TournamentRoundRobin), "Pairing format: round_robin, bracket")
+	tournamentRunCmd.Flags().StringVarP(&tournamentStyleFlag, "style", "s", tournament.DefaultStyle, "Debate style")
+	tournamentRunCmd.Flags().IntVarP(&tournamentTurnsFlag, "turns", "t", tournament.DefaultMaxTurns, "Turns per agent in each match")
+	tournamentRunCmd.Flags().IntVarP(&tournamentWorkersFlag, "workers", "w", tournament.DefaultWorkers, "Matches to run at once")
+	tournamentRunCmd.MarkFlagRequired("entrants")
+	tournamentRunCmd.MarkFlagRequired("judge")
+
+	tournamentCmd.AddCommand(tournamentRunCmd)
+	tournamentCmd.AddCommand(tournamentResumeCmd)
+	tournamentCmd.AddCommand(tournamentListCmd)
+	tournamentCmd.AddCommand(tournamentShowCmd)
+	tournamentCmd.AddCommand(tournamentLeaderboardCmd)
+}
+
+// runTournament runs a tournament in the foreground, printing each match
+// as it finishes. Ctrl+C pauses it; resume retries the unfinished matches.
+func runTournament(ctx context.Context, runner *tournament.Runner, t *core.Tournament) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+	go func() {
+		<-sigCh
+		fmt.Println("\n\nInterrupted. Finishing running matches...")
+		cancel(run.ErrPaused)
+	}()
+
+	callbacks := &tournament.Callbacks{
+		OnMatchComplete: func(m *core.TournamentMatch) {
+			fmt.Printf("  [Round %d] %s vs %s: %s\n", m.Round, m.EntrantA, m.EntrantB, matchResultLabel(m))
+		},
+	}
+	err := runner.Run(ctx, t, callbacks)
+	if ctx.Err() != nil {
+		fmt.Println("\nTournament paused. Resume with: conclave tournament resume " + t.ID[:8])
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("tournament failed: %w (retry with: conclave tournament resume %s)", err, t.ID[:8])
+	}
+
+	printStandings(t)
+	if winner := tournament.Winner(t); winner != "" {
+		fmt.Printf("\n🏆 Winner: %s\n", winner)
+	}
+	fmt.Println("\nSee the overall ratings with: conclave tournament leaderboard")
+	return nil
+}
+
+func printStandings(t *core.Tournament) {
+	fmt.Println("\nStandings:")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ENTRANT\tPOINTS\tW-L-D")
+	for _, s := range tournament.Standings(t) {
+		fmt.Fprintf(w, "%s\t%.1f\t%d-%d-%d\n", s.Entrant, s.Points, s.Wins, s.Losses, s.Draws)
+	}
+	w.Flush()
+}
+
+func matchResultLabel(m *core.TournamentMatch) string {
+	switch {
+	case m.Status == core.StatusFailed:
+		return "failed: " + m.Error
+	case m.Status != core.StatusCompleted:
+		return string(m.Status)
+	case m.Winner != "":
+		return m.Winner + " wins"
+	default:
+		return string(m.Outcome)
+	}
+}
+
+func withTournamentRunner(fn func(runner *tournament.Runner) error) error {
+	store, err := getStorage()
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	return fn(tournament.New(store, engine.New(store, getRegistry(), workspaces)))
 }
 
-func getAgentName(debate *core.Debate, agentID string) string {
-	if agentID == debate.AgentA.ID {
-		return debate.AgentA.Name
+func findTournament(runner *tournament.Runner, prefix string) (*core.Tournament, error) {
+	tournaments, _ := runner.ListTournaments(100, 0)
+	for _, t := range tournaments {
+		if strings.HasPrefix(t.ID, prefix) {
+			return runner.GetTournament(t.ID)
+		}
 	}
-	return debate.AgentB.Name
+	return nil, fmt.Errorf("tournament not found: %s", prefix)
 }
 
 // ============================================================================
-// LIST COMMAND
+// PRESETS COMMAND
 // ============================================================================
 
-var listCmd = &cobra.Command{
+var presetsCmd = &cobra.Command{
+	Use:     "preset",
+	Short:   "Manage saved session presets",
+	Aliases: []string{"presets"},
+	Long: `Presets save the members, chairman, style, turns, project and budget of a
+debate or council under a name. Start a session from one with:
+
+  conclave new --preset security-review "Is our token refresh flow safe?"`,
+}
+
+var presetListCmd = &cobra.Command{
 	Use:   "list",
-	Short: "List all debates",
+	Short: "List presets",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		store, err := getStorage()
 		if err != nil {
@@ -498,53 +2471,29 @@ var listCmd = &cobra.Command{
 		}
 		defer store.Close()
 
-		eng := engine.New(store, getRegistry(), workspaces)
-		debates, err := eng.ListDebates(50, 0)
+		presets, err := store.ListPresets()
 		if err != nil {
 			return err
 		}
-
-		if len(debates) == 0 {
-			fmt.Println("No debates found. Start one with: conclave new \"Your topic\"")
+		if len(presets) == 0 {
+			fmt.Println("No presets found. Create one with: conclave preset create security-review --members claude/opus:skeptic,gemini:optimist")
 			return nil
 		}
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "ID\tTOPIC\tSTATUS\tTURNS\tLOCK\tCREATED")
-		fmt.Fprintln(w, "──\t─────\t──────\t─────\t────\t───────")
-
-		for _, d := range debates {
-			shortID := d.ID[:8]
-			shortTopic := d.Topic
-			if len(shortTopic) > 35 {
-				shortTopic = shortTopic[:32] + "..."
-			}
-			lock := ""
-			if d.ReadOnly {
-				lock = "🔒"
-			}
-			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\n",
-				shortID,
-				shortTopic,
-				d.Status,
-				d.TurnCount,
-				lock,
-				d.CreatedAt.Format("2006-01-02 15:04"),
-			)
+		fmt.Fprintln(w, "NAME\tMODE\tMEMBERS\tDESCRIPTION")
+		fmt.Fprintln(w, "────\t────\t───────\t───────────")
+		for _, p := range presets {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.Name, p.Mode, memberList(p.Members), p.Description)
 		}
 		w.Flush()
-
 		return nil
 	},
 }
 
-// ============================================================================
-// SHOW COMMAND
-// ============================================================================
-
-var showCmd = &cobra.Command{
-	Use:   "show [id]",
-	Short: "Show debate details",
+var presetShowCmd = &cobra.Command{
+	Use:   "show [name]",
+	Short: "Show a preset's settings",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		store, err := getStorage()
@@ -553,57 +2502,49 @@ var showCmd = &cobra.Command{
 		}
 		defer store.Close()
 
-		eng := engine.New(store, getRegistry(), workspaces)
-		debateID, err := findDebateByPrefix(eng, args[0])
+		p, err := findPreset(store, args[0])
 		if err != nil {
 			return err
 		}
 
-		debate, turns, err := eng.GetDebateWithTurns(debateID)
-		if err != nil {
-			return err
+		fmt.Printf("\nPreset: %s (%s)\n", p.Name, p.ID)
+		if p.Description != "" {
+			fmt.Printf("Description: %s\n", p.Description)
 		}
-
-		fmt.Printf("\n💬 Debate: %s\n", debate.Topic)
-		fmt.Printf("   ID: %s\n", debate.ID)
-		fmt.Printf("   Status: %s\n", debate.Status)
-		fmt.Printf("   Style: %s\n", debate.Style)
-		if debate.ReadOnly {
-			fmt.Println("   🔒 Read-only")
+		fmt.Printf("Mode: %s\n", p.Mode)
+		fmt.Printf("Members: %s\n", memberList(p.Members))
+		if p.Chairman != nil {
+			fmt.Printf("Chairman: %s\n", p.Chairman.String())
 		}
-		fmt.Printf("   Agent A: %s (%s)\n", debate.AgentA.Name, debate.AgentA.Provider)
-		fmt.Printf("   Agent B: %s (%s)\n", debate.AgentB.Name, debate.AgentB.Provider)
-		fmt.Printf("   Created: %s\n", debate.CreatedAt.Format(time.RFC3339))
-		fmt.Println()
-
-		if len(turns) > 0 {
-			fmt.Println(strings.Repeat("─", 60))
-			for _, turn := range turns {
-				agentName := getAgentName(debate, turn.AgentID)
-				fmt.Printf("\n📢 Turn %d (Round %d) - %s\n", turn.Number, turn.Round, agentName)
-				fmt.Println(strings.Repeat("─", 40))
-				fmt.Println(turn.Content)
-			}
+		if p.Style != "" {
+			fmt.Printf("Style: %s\n", p.Style)
 		}
-
-		if len(debate.Conclusions) > 0 {
-			for _, c := range debate.Conclusions {
-				showConclusion(debate, c)
+		if p.MaxTurns > 0 {
+			fmt.Printf("Turns: %d per agent\n", p.MaxTurns)
+		}
+		if p.ProjectID != "" {
+			name := p.ProjectID
+			if project, _ := store.GetProject(p.ProjectID); project != nil {
+				name = fmt.Sprintf("%s (%s)", project.Name, project.ID)
 			}
+			fmt.Printf("Project: %s\n", name)
+		}
+		if p.Budget != nil {
+			fmt.Printf("Budget: %s\n", p.Budget)
 		}
-
 		return nil
 	},
 }
 
-// ============================================================================
-// DELETE COMMAND
-// ============================================================================
+var presetCreateCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "Create a preset",
+	Long: `Create a preset from the given settings.
 
-var deleteCmd = &cobra.Command{
-	Use:   "delete [id]",
-	Short: "Delete a debate",
-	Args:  cobra.ExactArgs(1),
+Examples:
+  conclave preset create security-review --members claude/opus:skeptic,gemini:optimist --chairman claude/opus
+  conclave preset create quick-debate --mode debate --members claude:optimist,gemini:skeptic --style adversarial --turns 2 --max-tokens 20000`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		store, err := getStorage()
 		if err != nil {
@@ -611,35 +2552,32 @@ var deleteCmd = &cobra.Command{
 		}
 		defer store.Close()
 
-		eng := engine.New(store, getRegistry(), workspaces)
-		debateID, err := findDebateByPrefix(eng, args[0])
-		if err != nil {
+		if existing, err := store.GetPresetByName(strings.TrimSpace(args[0])); err != nil {
 			return err
+		} else if existing != nil {
+			return fmt.Errorf("a preset named %q already exists (use conclave preset update)", existing.Name)
 		}
 
-		if err := eng.DeleteDebate(debateID); err != nil {
+		now := time.Now()
+		p := &core.Preset{ID: core.GenerateID(), Name: args[0], CreatedAt: now, UpdatedAt: now}
+		if err := applyPresetFlags(cmd, store, p); err != nil {
+			return err
+		}
+		if err := store.CreatePreset(p); err != nil {
 			return err
 		}
 
-		fmt.Printf("Deleted debate: %s\n", debateID)
+		fmt.Printf("Created preset: %s\n", p.Name)
 		return nil
 	},
 }
 
-// ============================================================================
-// EXPORT COMMAND
-// ============================================================================
-
-var exportCmd = &cobra.Command{
-	Use:   "export [id] [format]",
-	Short: "Export debate to file",
-	Long: `Export a debate to markdown, PDF, or JSON.
-
-Examples:
-  conclave export abc123 markdown
-  conclave export abc123 pdf
-  conclave export abc123 json -o debate.json`,
-	Args: cobra.ExactArgs(2),
+var presetUpdateCmd = &cobra.Command{
+	Use:   "update [name]",
+	Short: "Change a preset's settings",
+	Long: `Change the settings given as flags; the others are kept. Pass an empty
+value (e.g. --chairman "") to clear a setting.`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		store, err := getStorage()
 		if err != nil {
@@ -647,54 +2585,34 @@ Examples:
 		}
 		defer store.Close()
 
-		eng := engine.New(store, getRegistry(), workspaces)
-		debateID, err := findDebateByPrefix(eng, args[0])
+		p, err := findPreset(store, args[0])
 		if err != nil {
 			return err
 		}
-
-		debate, turns, err := eng.GetDebateWithTurns(debateID)
-		if err != nil {
-			return err
+		if name, _ := cmd.Flags().GetString("name"); cmd.Flags().Changed("name") {
+			if existing, err := store.GetPresetByName(strings.TrimSpace(name)); err != nil {
+				return err
+			} else if existing != nil && existing.ID != p.ID {
+				return fmt.Errorf("a preset named %q already exists", existing.Name)
+			}
+			p.Name = name
 		}
-
-		format := export.Format(strings.ToLower(args[1]))
-		exporter, err := export.GetExporter(format)
-		if err != nil {
+		if err := applyPresetFlags(cmd, store, p); err != nil {
 			return err
 		}
-
-		outputPath, _ := cmd.Flags().GetString("output")
-		if outputPath == "" {
-			outputPath = export.GenerateFilename(debate, exporter.FileExtension())
-		}
-
-		file, err := os.Create(outputPath)
-		if err != nil {
-			return fmt.Errorf("failed to create file: %w", err)
-		}
-		defer file.Close()
-
-		if err := exporter.Export(debate, turns, file); err != nil {
-			return fmt.Errorf("failed to export: %w", err)
+		p.UpdatedAt = time.Now()
+		if err := store.UpdatePreset(p); err != nil {
+			return err
 		}
 
-		fmt.Printf("Exported to: %s\n", outputPath)
+		fmt.Printf("Updated preset: %s\n", p.Name)
 		return nil
 	},
 }
 
-func init() {
-	exportCmd.Flags().StringP("output", "o", "", "Output file path")
-}
-
-// ============================================================================
-// LOCK COMMAND
-// ============================================================================
-
-var lockCmd = &cobra.Command{
-	Use:   "lock [id]",
-	Short: "Toggle read-only lock on a debate",
+var presetDeleteCmd = &cobra.Command{
+	Use:   "delete [name]",
+	Short: "Delete a preset",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		store, err := getStorage()
@@ -703,34 +2621,159 @@ var lockCmd = &cobra.Command{
 		}
 		defer store.Close()
 
-		sqlStore, ok := store.(*storage.SQLiteStorage)
-		if !ok {
-			return fmt.Errorf("lock not supported for this storage type")
-		}
-
-		eng := engine.New(store, getRegistry(), workspaces)
-		debateID, err := findDebateByPrefix(eng, args[0])
+		p, err := findPreset(store, args[0])
 		if err != nil {
 			return err
 		}
-
-		debate, err := eng.GetDebate(debateID)
-		if err != nil {
+		if err := store.DeletePreset(p.ID); err != nil {
 			return err
 		}
 
-		newState := !debate.ReadOnly
-		if err := sqlStore.SetReadOnly(debateID, newState); err != nil {
-			return err
+		fmt.Printf("Deleted preset: %s\n", p.Name)
+		return nil
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{presetCreateCmd, presetUpdateCmd} {
+		cmd.Flags().String("description", "", "Preset description")
+		cmd.Flags().String("mode", "", "Session mode for conclave new --preset: council, debate (default council)")
+		cmd.Flags().StringP("members", "m", "", "Council members or debate agents (comma-separated: provider[/model][:persona],...)")
+		cmd.Flags().String("chairman", "", "Council chairman (provider[/model])")
+		cmd.Flags().StringP("style", "s", "", "Debate style")
+		cmd.Flags().IntP("turns", "t", 0, "Debate turns per agent")
+		cmd.Flags().String("project", "", "Project (ID or name)")
+		cmd.Flags().Int("max-tokens", 0, "Stop sessions once they have used this many tokens")
+		cmd.Flags().Float64("max-cost", 0, "Stop sessions once they have cost this many US dollars")
+		cmd.Flags().Duration("max-time", 0, "Stop sessions once a run has taken this long (e.g. 10m)")
+	}
+	presetUpdateCmd.Flags().String("name", "", "New preset name")
+
+	presetsCmd.AddCommand(presetListCmd)
+	presetsCmd.AddCommand(presetShowCmd)
+	presetsCmd.AddCommand(presetCreateCmd)
+	presetsCmd.AddCommand(presetUpdateCmd)
+	presetsCmd.AddCommand(presetDeleteCmd)
+}
+
+// applyPresetFlags sets the preset settings given as flags and validates
+// the result.
+func applyPresetFlags(cmd *cobra.Command, store storage.Storage, p *core.Preset) error {
+	flags := cmd.Flags()
+	if flags.Changed("description") {
+		p.Description, _ = flags.GetString("description")
+	}
+	if flags.Changed("mode") {
+		mode, _ := flags.GetString("mode")
+		p.Mode = core.PresetMode(mode)
+	}
+	if flags.Changed("members") {
+		members, _ := flags.GetString("members")
+		p.Members = nil
+		if members != "" {
+			specs, err := core.ParseMemberSpecs(members)
+			if err != nil {
+				return fmt.Errorf("invalid --members: %w", err)
+			}
+			p.Members = specs
+		}
+	}
+	if flags.Changed("chairman") {
+		chairman, _ := flags.GetString("chairman")
+		p.Chairman = nil
+		if chairman != "" {
+			spec, err := core.ParseMemberSpec(chairman)
+			if err != nil {
+				return fmt.Errorf("invalid --chairman: %w", err)
+			}
+			p.Chairman = &spec
+		}
+	}
+	if flags.Changed("style") {
+		p.Style, _ = flags.GetString("style")
+	}
+	if flags.Changed("turns") {
+		p.MaxTurns, _ = flags.GetInt("turns")
+	}
+	if flags.Changed("project") {
+		project, _ := flags.GetString("project")
+		p.ProjectID = ""
+		if project != "" {
+			id, err := findProjectID(store, project)
+			if err != nil {
+				return err
+			}
+			p.ProjectID = id
 		}
+	}
 
-		if newState {
-			fmt.Printf("🔒 Locked debate: %s\n", debateID[:8])
-		} else {
-			fmt.Printf("🔓 Unlocked debate: %s\n", debateID[:8])
+	budget := &core.Budget{}
+	if p.Budget != nil {
+		budget = p.Budget
+	}
+	if flags.Changed("max-tokens") {
+		budget.MaxTokens, _ = flags.GetInt("max-tokens")
+	}
+	if flags.Changed("max-cost") {
+		budget.MaxCostUSD, _ = flags.GetFloat64("max-cost")
+	}
+	if flags.Changed("max-time") {
+		d, _ := flags.GetDuration("max-time")
+		budget.MaxDurationMs = d.Milliseconds()
+	}
+	p.Budget = budget.WithDefaults(nil)
+
+	return p.Validate()
+}
+
+// findPreset looks a preset up by name, ID or ID prefix.
+func findPreset(store storage.Storage, ref string) (*core.Preset, error) {
+	p, err := store.GetPresetByName(strings.TrimSpace(ref))
+	if err != nil || p != nil {
+		return p, err
+	}
+	presets, err := store.ListPresets()
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range presets {
+		if strings.HasPrefix(p.ID, ref) {
+			return p, nil
 		}
-		return nil
-	},
+	}
+	return nil, fmt.Errorf("preset not found: %s", ref)
+}
+
+// findProjectID looks a project up by ID or case-insensitive name.
+func findProjectID(store storage.Storage, ref string) (string, error) {
+	project, err := store.GetProject(ref)
+	if err != nil {
+		return "", err
+	}
+	if project != nil {
+		return project.ID, nil
+	}
+	projects, err := store.ListProjects(-1, 0)
+	if err != nil {
+		return "", err
+	}
+	for _, p := range projects {
+		if strings.EqualFold(p.Name, ref) {
+			return p.ID, nil
+		}
+	}
+	return "", fmt.Errorf("project not found: %s", ref)
+}
+
+func memberList(members []core.MemberSpec) string {
+	if len(members) == 0 {
+		return "-"
+	}
+	specs := make([]string, len(members))
+	for i, m := range members {
+		specs[i] = m.String()
+	}
+	return strings.Join(specs, ",")
 }
 
 // ============================================================================
@@ -1052,15 +3095,7 @@ var styleShowCmd = &cobra.Command{
 		if s := style.Get(id); s != nil {
 			fmt.Printf("\nStyle: %s (%s) [builtin]\n", s.Name, s.ID)
 			fmt.Printf("Description: %s\n", s.Description)
-			fmt.Println("\nOpening Prompt:")
-			fmt.Println(strings.Repeat("─", 40))
-			fmt.Println(s.OpeningPrompt)
-			fmt.Println("\nResponse Prompt:")
-			fmt.Println(strings.Repeat("─", 40))
-			fmt.Println(s.ResponsePrompt)
-			fmt.Println("\nConclusion Prompt:")
-			fmt.Println(strings.Repeat("─", 40))
-			fmt.Println(s.ConclusionPrompt)
+			printStyleTemplates(s)
 			return nil
 		}
 
@@ -1083,6 +3118,23 @@ var styleShowCmd = &cobra.Command{
 		fmt.Printf("\nStyle: %s (%s)\n", s.Name, s.ID)
 		fmt.Printf("Description: %s\n", s.Description)
 		fmt.Printf("Created: %s\n", s.CreatedAt.Format("2006-01-02 15:04"))
+		if s.ConsensusMethod != "" {
+			fmt.Printf("Consensus: %s (threshold %.2f)\n", s.ConsensusMethod, s.ConsensusThreshold)
+		}
+		printStyleTemplates(&style.Style{
+			OpeningPrompt:    s.OpeningPrompt,
+			ResponsePrompt:   s.ResponsePrompt,
+			ConclusionPrompt: s.ConclusionPrompt,
+			Phases:           s.Phases,
+		})
+		return nil
+	},
+}
+
+// printStyleTemplates prints a style's phases, or its three prompts if it
+// has none.
+func printStyleTemplates(s *style.Style) {
+	if len(s.Phases) == 0 {
 		fmt.Println("\nOpening Prompt:")
 		fmt.Println(strings.Repeat("─", 40))
 		fmt.Println(s.OpeningPrompt)
@@ -1092,13 +3144,79 @@ var styleShowCmd = &cobra.Command{
 		fmt.Println("\nConclusion Prompt:")
 		fmt.Println(strings.Repeat("─", 40))
 		fmt.Println(s.ConclusionPrompt)
-		return nil
-	},
+		return
+	}
+
+	for i, p := range s.Phases {
+		turns := "flexible turns"
+		if p.Turns > 0 {
+			turns = fmt.Sprintf("%d turn(s) per agent", p.Turns)
+		}
+		fmt.Printf("\nPhase %d: %s (%s, %s)\n", i+1, p.Name, p.ID, turns)
+		fmt.Println(strings.Repeat("─", 40))
+		if p.Prompt != "" {
+			fmt.Println(p.Prompt)
+		}
+		for _, r := range p.Roles {
+			fmt.Printf("\n  Role: %s\n", r.Name)
+			if r.Prompt != "" {
+				fmt.Println(r.Prompt)
+			}
+		}
+	}
+}
+
+// readPhasesFile loads style phases from a YAML or JSON file.
+func readPhasesFile(path string) ([]core.Phase, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read phases: %w", err)
+	}
+	var phases []core.Phase
+	if err := yaml.Unmarshal(data, &phases); err != nil {
+		return nil, fmt.Errorf("invalid phases file: %w", err)
+	}
+	return phases, nil
+}
+
+// validateConsensusFlags checks a style's consensus method and threshold.
+func validateConsensusFlags(method string, threshold float64) error {
+	if !consensus.ValidMethod(core.ConsensusMethod(method)) {
+		return fmt.Errorf("invalid --consensus: %s (use hybrid, keyword, llm, or stance)", method)
+	}
+	if threshold < 0 || threshold > 1 {
+		return fmt.Errorf("--consensus-threshold must be between 0 and 1")
+	}
+	return nil
 }
 
 var styleCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new style",
+	Long: `Create a custom debate style from three prompt templates (opening, response,
+conclusion) or from a file of phases. Each phase runs in order within a round
+with its own prompt, turns per agent (0 = share the leftover turns) and roles,
+assigned by speaking position. Templates can use {{.Topic}}, {{.Stance}},
+{{.PreviousArgument}}, {{.DebateHistory}}, {{.Phase}}, {{.Role}} and more.
+
+Example phases file (YAML or JSON):
+  - id: opening
+    name: Opening Statements
+    turns: 1
+    prompt: "Open the debate on {{.Topic}}. You argue {{.Stance}}."
+  - id: cross
+    name: Cross-Examination
+    turns: 1
+    roles:
+      - name: Examiner
+        prompt: "Question {{.OtherAgentName}} on: {{.PreviousArgument}}"
+      - name: Witness
+        prompt: "Answer the examiner's questions: {{.PreviousArgument}}"
+  - id: closing
+    name: Closing
+    turns: 1
+    prompt: "Close your case. Debate so far: {{.DebateHistory}}"`,
+	Example: `  conclave styles create --id oxford --name Oxford --phases oxford.yaml`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		id, _ := cmd.Flags().GetString("id")
 		name, _ := cmd.Flags().GetString("name")
@@ -1106,12 +3224,28 @@ var styleCreateCmd = &cobra.Command{
 		opening, _ := cmd.Flags().GetString("opening")
 		response, _ := cmd.Flags().GetString("response")
 		conclusion, _ := cmd.Flags().GetString("conclusion")
+		phasesPath, _ := cmd.Flags().GetString("phases")
+		consensusMethod, _ := cmd.Flags().GetString("consensus")
+		consensusThreshold, _ := cmd.Flags().GetFloat64("consensus-threshold")
 
 		if id == "" || name == "" {
 			return fmt.Errorf("--id and --name are required")
 		}
-		if opening == "" || response == "" || conclusion == "" {
-			return fmt.Errorf("--opening, --response, and --conclusion prompts are required")
+		var phases []core.Phase
+		if phasesPath != "" {
+			var err error
+			if phases, err = readPhasesFile(phasesPath); err != nil {
+				return err
+			}
+		} else if opening == "" || response == "" || conclusion == "" {
+			return fmt.Errorf("--opening, --response, and --conclusion prompts are required (or --phases)")
+		}
+		candidate := style.Style{OpeningPrompt: opening, ResponsePrompt: response, ConclusionPrompt: conclusion, Phases: phases}
+		if err := candidate.Validate(); err != nil {
+			return err
+		}
+		if err := validateConsensusFlags(consensusMethod, consensusThreshold); err != nil {
+			return err
 		}
 
 		// Check for conflict with builtin
@@ -1128,12 +3262,15 @@ var styleCreateCmd = &cobra.Command{
 		sqlStore := store.(*storage.SQLiteStorage)
 
 		s := &storage.Style{
-			ID:               id,
-			Name:             name,
-			Description:      desc,
-			OpeningPrompt:    opening,
-			ResponsePrompt:   response,
-			ConclusionPrompt: conclusion,
+			ID:                 id,
+			Name:               name,
+			Description:        desc,
+			OpeningPrompt:      opening,
+			ResponsePrompt:     response,
+			ConclusionPrompt:   conclusion,
+			Phases:             phases,
+			ConsensusMethod:    core.ConsensusMethod(consensusMethod),
+			ConsensusThreshold: consensusThreshold,
 		}
 
 		if err := sqlStore.CreateStyle(s); err != nil {
@@ -1208,6 +3345,12 @@ var styleUpdateCmd = &cobra.Command{
 		opening, _ := cmd.Flags().GetString("opening")
 		response, _ := cmd.Flags().GetString("response")
 		conclusion, _ := cmd.Flags().GetString("conclusion")
+		phasesPath, _ := cmd.Flags().GetString("phases")
+		consensusMethod, _ := cmd.Flags().GetString("consensus")
+		consensusThreshold, _ := cmd.Flags().GetFloat64("consensus-threshold")
+		if err := validateConsensusFlags(consensusMethod, consensusThreshold); err != nil {
+			return err
+		}
 
 		if name != "" {
 			existing.Name = name
@@ -1224,6 +3367,27 @@ var styleUpdateCmd = &cobra.Command{
 		if conclusion != "" {
 			existing.ConclusionPrompt = conclusion
 		}
+		if phasesPath != "" {
+			if existing.Phases, err = readPhasesFile(phasesPath); err != nil {
+				return err
+			}
+		}
+		if consensusMethod != "" {
+			existing.ConsensusMethod = core.ConsensusMethod(consensusMethod)
+		}
+		if cmd.Flags().Changed("consensus-threshold") {
+			existing.ConsensusThreshold = consensusThreshold
+		}
+
+		candidate := style.Style{
+			OpeningPrompt:    existing.OpeningPrompt,
+			ResponsePrompt:   existing.ResponsePrompt,
+			ConclusionPrompt: existing.ConclusionPrompt,
+			Phases:           existing.Phases,
+		}
+		if err := candidate.Validate(); err != nil {
+			return err
+		}
 
 		if err := sqlStore.UpdateStyle(existing); err != nil {
 			return err
@@ -1241,12 +3405,18 @@ func init() {
 	styleCreateCmd.Flags().String("opening", "", "Opening prompt template (required)")
 	styleCreateCmd.Flags().String("response", "", "Response prompt template (required)")
 	styleCreateCmd.Flags().String("conclusion", "", "Conclusion prompt template (required)")
+	styleCreateCmd.Flags().String("phases", "", "YAML or JSON file with the style's phases (replaces the three prompts)")
+	styleCreateCmd.Flags().String("consensus", "", "Consensus detection: hybrid, keyword, llm, stance")
+	styleCreateCmd.Flags().Float64("consensus-threshold", 0, "Score (0-1) needed for early consensus (0 uses the method default)")
 
 	styleUpdateCmd.Flags().String("name", "", "New style name")
 	styleUpdateCmd.Flags().String("description", "", "New description")
 	styleUpdateCmd.Flags().String("opening", "", "New opening prompt template")
 	styleUpdateCmd.Flags().String("response", "", "New response prompt template")
 	styleUpdateCmd.Flags().String("conclusion", "", "New conclusion prompt template")
+	styleUpdateCmd.Flags().String("phases", "", "YAML or JSON file with new phases")
+	styleUpdateCmd.Flags().String("consensus", "", "New consensus detection method")
+	styleUpdateCmd.Flags().Float64("consensus-threshold", 0, "New consensus threshold (0-1)")
 
 	stylesCmd.AddCommand(styleListCmd)
 	stylesCmd.AddCommand(styleShowCmd)
@@ -1357,6 +3527,16 @@ func init() {
 func startWebServer(store storage.Storage, registry *provider.Registry, port int) error {
 	h := handlers.New(store, registry, workspaces)
 
+	recovery := core.RecoveryResume
+	if appConfig != nil && appConfig.Server.Recovery != "" {
+		recovery = appConfig.Server.Recovery
+	}
+	h.Recover(recovery)
+
+	ctx, stopScheduler := context.WithCancel(context.Background())
+	defer stopScheduler()
+	h.StartScheduler(ctx)
+
 	mux := http.NewServeMux()
 	h.RegisterRoutes(mux)
 
@@ -1371,12 +3551,15 @@ func startWebServer(store storage.Storage, registry *provider.Registry, port int
 		signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
 		<-sigCh
 		fmt.Println("\nShutting down...")
+		stopScheduler()
 		server.Close()
 	}()
 
 	if err := server.ListenAndServe(); err != http.ErrServerClosed {
 		return fmt.Errorf("server error: %w", err)
 	}
+	// Pause background tournaments so they can be resumed after a restart
+	h.Close(30 * time.Second)
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log/slog"
@@ -8,6 +9,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/alienxp03/conclave/internal/config"
 	"github.com/alienxp03/conclave/internal/storage"
@@ -66,6 +68,12 @@ func main() {
 
 	// Create handler
 	h := handlers.New(store, registry, workspaces)
+	h.Recover(cfg.Server.Recovery)
+
+	// Start scheduled councils; stopped on shutdown
+	ctx, stopScheduler := context.WithCancel(context.Background())
+	defer stopScheduler()
+	h.StartScheduler(ctx)
 
 	// Setup routes
 	mux := http.NewServeMux()
@@ -84,6 +92,7 @@ func main() {
 		signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
 		<-sigCh
 		slog.Info("Shutting down...")
+		stopScheduler()
 		server.Close()
 	}()
 
@@ -92,4 +101,7 @@ func main() {
 		slog.Error("Server error", "error", err)
 		os.Exit(1)
 	}
+
+	// Pause background tournaments so they can be resumed after a restart
+	h.Close(30 * time.Second)
 }
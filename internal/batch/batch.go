@@ -0,0 +1,234 @@
+// Package batch runs debates and councils for every row of a topic file and
+// records the results in a JSONL report, so a rerun picks up where the last
+// one stopped.
+package batch
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// Mode is what a row runs.
+type Mode string
+
+const (
+	ModeDebate  Mode = "debate"
+	ModeCouncil Mode = "council"
+)
+
+// Row is one session to run. Empty fields take the batch defaults.
+type Row struct {
+	ID       string            `json:"id"`
+	Topic    string            `json:"topic"`
+	Mode     Mode              `json:"mode,omitempty"`
+	Members  []core.MemberSpec `json:"members,omitempty"`  // Debate agents or council members
+	Chairman *core.MemberSpec  `json:"chairman,omitempty"` // Councils only
+	Style    string            `json:"style,omitempty"`    // Debates only
+	Turns    int               `json:"turns,omitempty"`    // Debates only
+	Project  string            `json:"project,omitempty"`  // Project ID or name
+}
+
+// fileRow is a row as written in a YAML file. Members may be a list or a
+// comma-separated string.
+type fileRow struct {
+	ID       string     `yaml:"id"`
+	Topic    string     `yaml:"topic"`
+	Mode     string     `yaml:"mode"`
+	Members  memberList `yaml:"members"`
+	Chairman string     `yaml:"chairman"`
+	Style    string     `yaml:"style"`
+	Turns    int        `yaml:"turns"`
+	Project  string     `yaml:"project"`
+}
+
+type memberList []string
+
+func (m *memberList) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*m = splitMembers(node.Value)
+		return nil
+	}
+	var list []string
+	if err := node.Decode(&list); err != nil {
+		return err
+	}
+	*m = list
+	return nil
+}
+
+// csvColumns are the columns a CSV header may name, in any order. Only
+// topic is required.
+var csvColumns = []string{"id", "topic", "mode", "members", "chairman", "style", "turns", "project"}
+
+// Load reads rows from a .yaml, .yml or .csv file.
+func Load(path string) ([]*Row, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return ParseYAML(f)
+	case ".csv":
+		return ParseCSV(f)
+	default:
+		return nil, fmt.Errorf("unsupported batch file %s (expected .yaml, .yml or .csv)", path)
+	}
+}
+
+// ParseYAML reads rows from a YAML list of mappings with the keys id,
+// topic, mode, members, chairman, style, turns and project.
+func ParseYAML(r io.Reader) ([]*Row, error) {
+	var raw []fileRow
+	if err := yaml.NewDecoder(r).Decode(&raw); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	return buildRows(raw)
+}
+
+// ParseCSV reads rows from CSV with a header naming its columns (see
+// csvColumns). Members are separated by commas or semicolons.
+func ParseCSV(r io.Reader) ([]*Row, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !contains(csvColumns, name) {
+			return nil, fmt.Errorf("unknown CSV column %q (expected %s)", name, strings.Join(csvColumns, ", "))
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["topic"]; !ok {
+		return nil, fmt.Errorf("CSV header has no topic column")
+	}
+
+	raw := make([]fileRow, 0, len(records)-1)
+	for line, record := range records[1:] {
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		row := fileRow{
+			ID:       field("id"),
+			Topic:    field("topic"),
+			Mode:     field("mode"),
+			Members:  splitMembers(field("members")),
+			Chairman: field("chairman"),
+			Style:    field("style"),
+			Project:  field("project"),
+		}
+		if turns := field("turns"); turns != "" {
+			if row.Turns, err = strconv.Atoi(turns); err != nil {
+				return nil, fmt.Errorf("line %d: invalid turns %q", line+2, turns)
+			}
+		}
+		raw = append(raw, row)
+	}
+	return buildRows(raw)
+}
+
+// buildRows validates file rows and gives each a stable ID: its own, or a
+// hash of its fields so an unchanged row keeps its ID between runs.
+func buildRows(raw []fileRow) ([]*Row, error) {
+	rows := make([]*Row, 0, len(raw))
+	seen := make(map[string]bool, len(raw))
+	for i, fr := range raw {
+		n := i + 1
+		row := &Row{
+			ID:      strings.TrimSpace(fr.ID),
+			Topic:   strings.TrimSpace(fr.Topic),
+			Mode:    Mode(strings.ToLower(strings.TrimSpace(fr.Mode))),
+			Style:   strings.TrimSpace(fr.Style),
+			Turns:   fr.Turns,
+			Project: strings.TrimSpace(fr.Project),
+		}
+		if row.Topic == "" {
+			return nil, fmt.Errorf("row %d: topic is required", n)
+		}
+		switch row.Mode {
+		case "", ModeDebate, ModeCouncil:
+		default:
+			return nil, fmt.Errorf("row %d: invalid mode %q (expected debate or council)", n, row.Mode)
+		}
+		if row.Turns < 0 {
+			return nil, fmt.Errorf("row %d: turns must be positive", n)
+		}
+
+		for _, m := range fr.Members {
+			spec, err := core.ParseMemberSpec(m)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %w", n, err)
+			}
+			row.Members = append(row.Members, spec)
+		}
+		if chairman := strings.TrimSpace(fr.Chairman); chairman != "" {
+			spec, err := core.ParseMemberSpec(chairman)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: invalid chairman: %w", n, err)
+			}
+			row.Chairman = &spec
+		}
+
+		if row.ID == "" {
+			row.ID = rowHash(fr)
+		}
+		if seen[row.ID] {
+			return nil, fmt.Errorf("row %d: duplicate row ID %s (give repeated rows an id)", n, row.ID)
+		}
+		seen[row.ID] = true
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func rowHash(fr fileRow) string {
+	fields := []string{fr.Topic, fr.Mode, strings.Join(fr.Members, ","), fr.Chairman, fr.Style, strconv.Itoa(fr.Turns), fr.Project}
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\x00")))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+func splitMembers(s string) []string {
+	var members []string
+	for _, m := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ';' }) {
+		if m = strings.TrimSpace(m); m != "" {
+			members = append(members, m)
+		}
+	}
+	return members
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,226 @@
+package batch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+	"github.com/alienxp03/conclave/internal/council"
+	"github.com/alienxp03/conclave/internal/engine"
+	"github.com/alienxp03/conclave/internal/provider"
+	"github.com/alienxp03/conclave/internal/storage"
+	extprovider "github.com/alienxp03/conclave/provider"
+)
+
+type mockProvider struct {
+	name string
+}
+
+func (m *mockProvider) Name() string    { return m.name }
+func (m *mockProvider) Available() bool { return true }
+
+func (m *mockProvider) Execute(ctx context.Context, req *extprovider.Request) (*extprovider.Response, error) {
+	content := "I AGREE. " + m.name + " makes a fair point."
+	switch {
+	case strings.Contains(req.Prompt, "You are the Chairman synthesizing"):
+		content = "Synthesis: adopt it gradually."
+	case strings.Contains(req.Prompt, "FINAL RANKING"):
+		content = "FINAL RANKING:\n1. Response A"
+	}
+	return &extprovider.Response{
+		Content:  content,
+		Model:    "test-model",
+		Provider: m.name,
+		Metadata: &extprovider.Metadata{TotalTokens: 10},
+	}, nil
+}
+
+func (m *mockProvider) HealthCheck(ctx context.Context) extprovider.HealthStatus {
+	return extprovider.HealthStatus{Available: true, CheckedAt: time.Now()}
+}
+
+func setupTestRunner(t *testing.T) (*Runner, storage.Storage) {
+	t.Helper()
+
+	store, err := storage.NewSQLiteStorage(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to create storage: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	if err := store.Initialize(); err != nil {
+		t.Fatalf("failed to initialize storage: %v", err)
+	}
+
+	registry := provider.NewRegistry()
+	for _, name := range []string{"alpha", "beta"} {
+		registry.Register(&mockProvider{name: name})
+	}
+
+	return New(store, engine.New(store, registry, nil), council.New(store, registry, nil)), store
+}
+
+func TestParseYAML(t *testing.T) {
+	rows, err := ParseYAML(strings.NewReader(`
+- id: graphql
+  topic: Should we adopt GraphQL?
+  mode: council
+  members: [alpha:skeptic, beta/fast]
+  chairman: alpha/opus
+- topic: Monolith or microservices?
+  members: alpha:optimist, beta:pragmatist
+  style: adversarial
+  turns: 2
+  project: Platform
+`))
+	if err != nil {
+		t.Fatalf("ParseYAML() error = %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+
+	first := rows[0]
+	if first.ID != "graphql" || first.Mode != ModeCouncil || len(first.Members) != 2 || first.Members[1].Model != "fast" {
+		t.Errorf("unexpected first row: %+v", first)
+	}
+	if first.Chairman == nil || first.Chairman.Model != "opus" {
+		t.Errorf("unexpected chairman: %+v", first.Chairman)
+	}
+
+	second := rows[1]
+	if len(second.ID) != 12 || second.Style != "adversarial" || second.Turns != 2 || second.Project != "Platform" {
+		t.Errorf("unexpected second row: %+v", second)
+	}
+	if len(second.Members) != 2 || second.Members[0].Persona != "optimist" {
+		t.Errorf("comma-separated members not split: %+v", second.Members)
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	rows, err := ParseCSV(strings.NewReader("Topic,members,turns\nTabs or spaces?,alpha:skeptic;beta,3\n\"Rust, or Go?\",\"alpha, beta\",\n"))
+	if err != nil {
+		t.Fatalf("ParseCSV() error = %v", err)
+	}
+	if len(rows) != 2 || rows[0].Turns != 3 || len(rows[0].Members) != 2 || rows[1].Topic != "Rust, or Go?" || len(rows[1].Members) != 2 {
+		t.Fatalf("unexpected rows: %+v %+v", rows[0], rows[1])
+	}
+
+	// Hashed IDs are stable across parses
+	again, _ := ParseCSV(strings.NewReader("topic,members,turns\nTabs or spaces?,alpha:skeptic;beta,3\n"))
+	if again[0].ID != rows[0].ID {
+		t.Errorf("row ID changed between parses: %s != %s", again[0].ID, rows[0].ID)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func() error
+		want  string
+	}{
+		{"unknown column", func() error { _, err := ParseCSV(strings.NewReader("topic,color\nT,red\n")); return err }, "unknown CSV column"},
+		{"no topic column", func() error { _, err := ParseCSV(strings.NewReader("id\nx\n")); return err }, "no topic column"},
+		{"bad turns", func() error { _, err := ParseCSV(strings.NewReader("topic,turns\nT,many\n")); return err }, "invalid turns"},
+		{"missing topic", func() error { _, err := ParseYAML(strings.NewReader("- mode: debate\n")); return err }, "topic is required"},
+		{"bad mode", func() error { _, err := ParseYAML(strings.NewReader("- topic: T\n  mode: panel\n")); return err }, "invalid mode"},
+		{"duplicate", func() error { _, err := ParseYAML(strings.NewReader("- topic: T\n- topic: T\n")); return err }, "duplicate row ID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.parse(); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunResumesFromReport(t *testing.T) {
+	runner, store := setupTestRunner(t)
+	if err := store.CreateProject(&core.Project{ID: "proj-1", Name: "Platform", CreatedAt: time.Now(), UpdatedAt: time.Now()}); err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+
+	rows := []*Row{
+		{ID: "debate", Topic: "Tabs or spaces?", Project: "platform"},
+		{ID: "council", Topic: "Adopt GraphQL?", Mode: ModeCouncil},
+		{ID: "broken", Topic: "Who wins?", Members: []core.MemberSpec{{Provider: "missing"}, {Provider: "alpha"}}},
+	}
+	opts := Options{
+		Defaults: Row{Members: []core.MemberSpec{{Provider: "alpha", Persona: "optimist"}, {Provider: "beta", Persona: "skeptic"}}, Turns: 1},
+		Workers:  2,
+		Report:   filepath.Join(t.TempDir(), "report.jsonl"),
+	}
+
+	results, err := runner.Run(context.Background(), rows, opts, nil)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	for i, want := range []core.DebateStatus{core.StatusCompleted, core.StatusCompleted, core.StatusFailed} {
+		if results[i].Status != want {
+			t.Errorf("row %s: status = %s (%s), want %s", rows[i].ID, results[i].Status, results[i].Error, want)
+		}
+	}
+	if results[0].Tokens == 0 || results[0].SessionID == "" {
+		t.Errorf("debate result missing usage: %+v", results[0])
+	}
+	if !strings.Contains(results[1].Excerpt, "adopt it gradually") || results[1].Tokens == 0 {
+		t.Errorf("council result missing synthesis: %+v", results[1])
+	}
+	debate, _ := store.GetDebate(results[0].SessionID)
+	if debate == nil || debate.ProjectID != "proj-1" {
+		t.Errorf("project not resolved by name: %+v", debate)
+	}
+
+	report, err := LoadReport(opts.Report)
+	if err != nil || len(report) != 3 {
+		t.Fatalf("LoadReport() = %d results, %v", len(report), err)
+	}
+	data, _ := os.ReadFile(opts.Report)
+	if lines := strings.Count(string(data), "\n"); lines != 3 {
+		t.Errorf("report has %d lines, want one per row", lines)
+	}
+
+	// A rerun only retries the failed row
+	var skipped, started atomic.Int32
+	callbacks := &Callbacks{
+		OnRowSkipped: func(*Row, *Result) { skipped.Add(1) },
+		OnRowStart:   func(*Row) { started.Add(1) },
+	}
+	rows[2].Members = []core.MemberSpec{{Provider: "beta"}, {Provider: "alpha"}}
+	results, err = runner.Run(context.Background(), rows, opts, callbacks)
+	if err != nil {
+		t.Fatalf("second Run() error = %v", err)
+	}
+	if skipped.Load() != 2 || started.Load() != 1 {
+		t.Errorf("skipped %d and started %d rows, want 2 and 1", skipped.Load(), started.Load())
+	}
+	if results[2].Status != core.StatusCompleted {
+		t.Errorf("retried row: status = %s (%s)", results[2].Status, results[2].Error)
+	}
+}
+
+func TestRunPausedResumesSession(t *testing.T) {
+	runner, _ := setupTestRunner(t)
+	rows := []*Row{{ID: "only", Topic: "Tabs or spaces?", Members: []core.MemberSpec{{Provider: "alpha"}, {Provider: "beta"}}, Turns: 1}}
+	opts := Options{Report: filepath.Join(t.TempDir(), "report.jsonl")}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	callbacks := &Callbacks{OnRowStart: func(*Row) { cancel() }}
+	results, err := runner.Run(ctx, rows, opts, callbacks)
+	if err == nil || results[0].Status != core.StatusPaused || results[0].SessionID == "" {
+		t.Fatalf("expected a paused session, got %+v, %v", results[0], err)
+	}
+
+	resumed, err := runner.Run(context.Background(), rows, opts, nil)
+	if err != nil {
+		t.Fatalf("second Run() error = %v", err)
+	}
+	if resumed[0].Status != core.StatusCompleted || resumed[0].SessionID != results[0].SessionID {
+		t.Errorf("expected the paused session to be resumed, got %+v", resumed[0])
+	}
+}
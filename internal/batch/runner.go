@@ -0,0 +1,460 @@
+package batch
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+	"github.com/alienxp03/conclave/internal/council"
+	"github.com/alienxp03/conclave/internal/engine"
+	"github.com/alienxp03/conclave/internal/storage"
+)
+
+// Defaults for batch settings left unset.
+const (
+	DefaultStyle    = "collaborative"
+	DefaultMaxTurns = 5
+	DefaultWorkers  = 2
+
+	// ExcerptLength is how many characters of the conclusion or synthesis a
+	// result keeps.
+	ExcerptLength = 280
+)
+
+// DefaultDebateMembers argue a debate row that names no members.
+var DefaultDebateMembers = []core.MemberSpec{
+	{Provider: "claude", Persona: "pragmatist"},
+	{Provider: "claude", Persona: "skeptic"},
+}
+
+// Result is one line of the report: how a row's last run went.
+type Result struct {
+	ID         string            `json:"id"`
+	Topic      string            `json:"topic"`
+	Mode       Mode              `json:"mode"`
+	SessionID  string            `json:"session_id,omitempty"`
+	Status     core.DebateStatus `json:"status"`
+	Error      string            `json:"error,omitempty"`
+	StopReason string            `json:"stop_reason,omitempty"`
+	Tokens     int               `json:"tokens"`
+	CostUSD    float64           `json:"cost_usd,omitempty"`
+	DurationMs int64             `json:"duration_ms"` // Time spent in this run
+	Excerpt    string            `json:"excerpt,omitempty"`
+	FinishedAt time.Time         `json:"finished_at"`
+}
+
+// Options configure a batch run.
+type Options struct {
+	// Defaults fill the fields a row leaves empty. Its ID and Topic are
+	// ignored.
+	Defaults Row
+
+	// Workers is how many sessions run at once.
+	Workers int
+
+	// Report is the JSONL report's path. Rows it records as completed are
+	// skipped, and unfinished sessions it records are resumed.
+	Report string
+
+	// Budget limits each session; unset limits fall back to the project's.
+	Budget *core.Budget
+}
+
+// Callbacks report batch progress. All fields are optional and may be
+// called from several workers at once.
+type Callbacks struct {
+	OnRowSkipped  func(row *Row, previous *Result)
+	OnRowStart    func(row *Row)
+	OnRowComplete func(row *Row, result *Result)
+}
+
+// Runner runs batches on top of the debate and council engines.
+type Runner struct {
+	storage  storage.Storage
+	engine   *engine.Engine
+	councils *council.Engine
+}
+
+// New creates a batch runner.
+func New(store storage.Storage, eng *engine.Engine, councils *council.Engine) *Runner {
+	return &Runner{storage: store, engine: eng, councils: councils}
+}
+
+// Run runs every row not yet completed according to the report, on a pool
+// of workers, and returns a result per row in row order. Each finished row
+// is appended to the report as it ends; the report is then rewritten with
+// one line per row. Once ctx is done, workers stop picking up rows and the
+// sessions in flight are paused, to be resumed by the next run.
+func (r *Runner) Run(ctx context.Context, rows []*Row, opts Options, callbacks *Callbacks) ([]*Result, error) {
+	if callbacks == nil {
+		callbacks = &Callbacks{}
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+
+	previous, err := LoadReport(opts.Report)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read report: %w", err)
+	}
+
+	report, err := os.OpenFile(opts.Report, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open report: %w", err)
+	}
+	defer report.Close()
+
+	results := make([]*Result, len(rows))
+	var pending []int
+	for i, row := range rows {
+		if prev := previous[row.ID]; prev != nil && prev.Status == core.StatusCompleted {
+			results[i] = prev
+			if callbacks.OnRowSkipped != nil {
+				callbacks.OnRowSkipped(row, prev)
+			}
+			continue
+		}
+		pending = append(pending, i)
+	}
+	slog.Info("Starting batch", "rows", len(rows), "pending", len(pending), "workers", workers)
+
+	projects := &projectCache{storage: r.storage, ids: make(map[string]string)}
+	var mu sync.Mutex // Guards report writes
+	queue := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				row := rows[i]
+				if callbacks.OnRowStart != nil {
+					callbacks.OnRowStart(row)
+				}
+				result := r.runRow(ctx, row, opts, previous[row.ID], projects)
+				results[i] = result
+
+				mu.Lock()
+				if err := appendResult(report, result); err != nil {
+					slog.Error("Failed to write batch report", "row_id", row.ID, "error", err)
+				}
+				mu.Unlock()
+
+				if callbacks.OnRowComplete != nil {
+					callbacks.OnRowComplete(row, result)
+				}
+			}
+		}()
+	}
+
+	for _, i := range pending {
+		if ctx.Err() != nil {
+			break
+		}
+		queue <- i
+	}
+	close(queue)
+	wg.Wait()
+
+	// Rows never started keep their previous result, if any
+	for i, row := range rows {
+		if results[i] == nil {
+			results[i] = previous[row.ID]
+		}
+	}
+	if err := writeReport(opts.Report, results); err != nil {
+		return results, fmt.Errorf("failed to write report: %w", err)
+	}
+	return results, context.Cause(ctx)
+}
+
+// runRow runs one row to completion and reports how it went. A session the
+// previous run left unfinished is resumed rather than started over.
+func (r *Runner) runRow(ctx context.Context, row *Row, opts Options, previous *Result, projects *projectCache) *Result {
+	row = withDefaults(row, opts.Defaults)
+	result := &Result{ID: row.ID, Topic: row.Topic, Mode: row.Mode}
+	started := time.Now()
+
+	var err error
+	if previous != nil && previous.SessionID != "" && previous.Mode == row.Mode {
+		result.SessionID = previous.SessionID
+	}
+	if row.Mode == ModeCouncil {
+		err = r.runCouncil(ctx, row, opts, result, projects)
+	} else {
+		err = r.runDebate(ctx, row, opts, result, projects)
+	}
+
+	result.DurationMs = time.Since(started).Milliseconds()
+	result.FinishedAt = time.Now()
+	switch {
+	case ctx.Err() != nil:
+		result.Status = core.StatusPaused
+	case err != nil:
+		result.Status, result.Error = core.StatusFailed, err.Error()
+	case result.Status == "":
+		result.Status = core.StatusCompleted
+	}
+	return result
+}
+
+func (r *Runner) runDebate(ctx context.Context, row *Row, opts Options, result *Result, projects *projectCache) error {
+	if result.SessionID == "" {
+		projectID, err := projects.resolve(row.Project)
+		if err != nil {
+			return err
+		}
+		debate, err := r.engine.CreateDebate(ctx, core.NewDebateConfig{
+			Topic:     row.Topic,
+			ProjectID: projectID,
+			Agents:    row.Members,
+			Style:     row.Style,
+			MaxTurns:  row.Turns,
+			Budget:    opts.Budget,
+		})
+		if err != nil {
+			return err
+		}
+		result.SessionID = debate.ID
+	}
+
+	// A session that finished after the report was last written needs no run
+	debate, err := r.engine.GetDebate(result.SessionID)
+	if err != nil {
+		return err
+	}
+	if debate == nil {
+		return fmt.Errorf("debate not found: %s", result.SessionID)
+	}
+	if debate.Status != core.StatusCompleted {
+		if err := r.engine.RunDebate(ctx, result.SessionID, nil); err != nil {
+			if errors.Is(err, engine.ErrAwaitingInput) {
+				return fmt.Errorf("debate is waiting for a human turn")
+			}
+			return err
+		}
+	}
+
+	debate, turns, err := r.engine.GetDebateWithTurns(result.SessionID)
+	if err != nil {
+		return err
+	}
+	var usage core.Usage
+	for _, t := range turns {
+		usage.AddTurn(t)
+	}
+	result.Tokens, result.CostUSD = usage.Tokens, usage.CostUSD
+	result.StopReason = debate.StopReason
+	if n := len(debate.Conclusions); n > 0 {
+		result.Excerpt = excerpt(debate.Conclusions[n-1].Summary)
+	}
+	if debate.Status != core.StatusCompleted {
+		result.Status = debate.Status
+	}
+	return nil
+}
+
+func (r *Runner) runCouncil(ctx context.Context, row *Row, opts Options, result *Result, projects *projectCache) error {
+	var c *core.Council
+	if result.SessionID != "" {
+		var err error
+		if c, err = r.storage.GetCouncil(result.SessionID); err != nil {
+			return err
+		}
+	}
+	if c == nil {
+		projectID, err := projects.resolve(row.Project)
+		if err != nil {
+			return err
+		}
+		if len(row.Members) == 0 {
+			return fmt.Errorf("a council row needs members")
+		}
+		c, err = r.councils.CreateCouncil(ctx, core.NewCouncilConfig{
+			Topic:     row.Topic,
+			ProjectID: projectID,
+			Members:   row.Members,
+			Chairman:  row.Chairman,
+			Budget:    opts.Budget,
+		})
+		if err != nil {
+			return err
+		}
+		result.SessionID = c.ID
+	}
+
+	if c.Status != core.StatusCompleted {
+		if err := r.councils.RunCouncil(ctx, c); err != nil {
+			return err
+		}
+	}
+
+	usage := r.councils.Usage(c)
+	result.Tokens, result.CostUSD = usage.Tokens, usage.CostUSD
+	result.StopReason = c.StopReason
+	if n := len(c.Syntheses); n > 0 {
+		result.Excerpt = excerpt(c.Syntheses[n-1].Content)
+	}
+	if c.Status != core.StatusCompleted {
+		result.Status = c.Status
+	}
+	return nil
+}
+
+// withDefaults returns a copy of row with its empty fields filled in.
+func withDefaults(row *Row, defaults Row) *Row {
+	out := *row
+	if out.Mode == "" {
+		out.Mode = defaults.Mode
+	}
+	if out.Mode == "" {
+		out.Mode = ModeDebate
+	}
+	if len(out.Members) == 0 {
+		out.Members = defaults.Members
+	}
+	if len(out.Members) == 0 && out.Mode == ModeDebate {
+		out.Members = DefaultDebateMembers
+	}
+	if out.Chairman == nil {
+		out.Chairman = defaults.Chairman
+	}
+	if out.Style == "" {
+		out.Style = defaults.Style
+	}
+	if out.Style == "" {
+		out.Style = DefaultStyle
+	}
+	if out.Turns == 0 {
+		out.Turns = defaults.Turns
+	}
+	if out.Turns == 0 {
+		out.Turns = DefaultMaxTurns
+	}
+	if out.Project == "" {
+		out.Project = defaults.Project
+	}
+	return &out
+}
+
+// projectCache resolves a row's project, by ID or case-insensitive name,
+// once per batch.
+type projectCache struct {
+	storage storage.Storage
+	mu      sync.Mutex
+	ids     map[string]string
+}
+
+func (p *projectCache) resolve(ref string) (string, error) {
+	if ref == "" {
+		return "", nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if id, ok := p.ids[ref]; ok {
+		return id, nil
+	}
+
+	project, err := p.storage.GetProject(ref)
+	if err != nil {
+		return "", err
+	}
+	if project == nil {
+		projects, err := p.storage.ListProjects(-1, 0) // A negative limit lists them all
+		if err != nil {
+			return "", err
+		}
+		for _, candidate := range projects {
+			if strings.EqualFold(candidate.Name, ref) {
+				project = candidate
+				break
+			}
+		}
+	}
+	if project == nil {
+		return "", fmt.Errorf("project not found: %s", ref)
+	}
+	p.ids[ref] = project.ID
+	return project.ID, nil
+}
+
+// LoadReport reads a JSONL report into each row's latest result. A missing
+// report is empty.
+func LoadReport(path string) (map[string]*Result, error) {
+	results := make(map[string]*Result)
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return results, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var result Result
+		if err := json.Unmarshal([]byte(text), &result); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		results[result.ID] = &result
+	}
+	return results, scanner.Err()
+}
+
+func appendResult(f *os.File, result *Result) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+// writeReport replaces the report with one line per row that has a result.
+func writeReport(path string, results []*Result) error {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		if err := appendResult(f, result); err != nil {
+			f.Close()
+			os.Remove(tmp)
+			return err
+		}
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// excerpt collapses whitespace and shortens text to ExcerptLength
+// characters.
+func excerpt(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if runes := []rune(text); len(runes) > ExcerptLength {
+		return string(runes[:ExcerptLength-1]) + "…"
+	}
+	return text
+}
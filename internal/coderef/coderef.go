@@ -0,0 +1,329 @@
+// Package coderef finds the code references agents cite in their turns —
+// file paths, line ranges and symbols — and checks them against the
+// session's workspace, so readers can tell real citations from invented ones.
+package coderef
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// maxReferences caps the references checked in one turn or response.
+const maxReferences = 30
+
+// Workspace scan limits: symbol and partial-path lookups read at most
+// maxFiles source files, skipping any larger than maxFileSize.
+const (
+	maxFiles    = 5000
+	maxFileSize = 1 << 20
+)
+
+// sourceExts are the file extensions a path must have to count as a code
+// reference, so prose like "e.g." or "v1.2" is not mistaken for a file.
+var sourceExts = map[string]bool{
+	"go": true, "mod": true, "sum": true, "py": true, "js": true, "mjs": true, "cjs": true, "ts": true,
+	"tsx": true, "jsx": true, "vue": true, "svelte": true, "rs": true, "java": true, "kt": true,
+	"scala": true, "rb": true, "php": true, "c": true, "h": true, "cc": true, "cpp": true, "hpp": true,
+	"cs": true, "swift": true, "m": true, "sh": true, "bash": true, "zsh": true, "sql": true,
+	"proto": true, "graphql": true, "html": true, "css": true, "scss": true, "md": true, "txt": true,
+	"json": true, "yaml": true, "yml": true, "toml": true, "ini": true, "cfg": true, "xml": true,
+	"gradle": true, "lock": true, "tf": true, "lua": true, "ex": true, "exs": true, "erl": true,
+	"hs": true, "ml": true, "dart": true, "r": true, "pl": true, "env": true,
+}
+
+// sourceNames are extensionless files that count as code references.
+var sourceNames = map[string]bool{"Makefile": true, "Dockerfile": true, "Gemfile": true, "Rakefile": true}
+
+// skipDirs are never scanned for symbols or partial paths.
+var skipDirs = map[string]bool{"node_modules": true, "vendor": true, "dist": true, "build": true, "target": true, "__pycache__": true}
+
+var (
+	inlineCode = regexp.MustCompile("`([^`\n]+)`")
+
+	// pathRef matches a path with an optional line or range:
+	// foo/bar.go, foo/bar.go:12, foo/bar.go:12-20, foo/bar.go#L12-L20
+	pathRef = regexp.MustCompile(`^(?:\./)?(/?(?:[\w.@-]+/)*[\w.@-]+)(?::(\d+)(?:[-–](\d+))?(?::\d+)?|#L(\d+)(?:-L?(\d+))?)?$`)
+
+	// symbolRef matches a call like foo() or pkg.Foo(), or a qualified
+	// name like Engine.RunDebate.
+	symbolRef = regexp.MustCompile(`^(?:func\s+)?((?:[A-Za-z_]\w*\.)*[A-Za-z_]\w*)(\(\))?$`)
+
+	wrapping = "`'\"()[]{}<>,;!?*"
+)
+
+// Extract returns the code references in content, in order of first
+// appearance. Paths are found anywhere outside code blocks; a bare file
+// name without a directory or line number, and symbols, only count inside
+// inline code.
+func Extract(content string) []*core.CodeReference {
+	var refs []*core.CodeReference
+	seen := make(map[string]bool)
+	add := func(ref *core.CodeReference) {
+		if ref == nil || seen[ref.Text] || len(refs) >= maxReferences {
+			return
+		}
+		seen[ref.Text] = true
+		refs = append(refs, ref)
+	}
+
+	inBlock := false
+	for _, line := range strings.Split(content, "\n") {
+		// Code blocks hold proposed code, not citations
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inBlock = !inBlock
+			continue
+		}
+		if inBlock {
+			continue
+		}
+		for _, m := range inlineCode.FindAllStringSubmatch(line, -1) {
+			code := strings.TrimSpace(m[1])
+			if ref := parsePath(code, true); ref != nil {
+				add(ref)
+			} else {
+				add(parseSymbol(code))
+			}
+		}
+		for _, word := range strings.Fields(inlineCode.ReplaceAllString(line, " ")) {
+			word = strings.TrimRight(strings.Trim(word, wrapping), ".:")
+			add(parsePath(word, false))
+		}
+	}
+	return refs
+}
+
+// parsePath reads a path reference. Outside inline code a path needs a
+// directory or a line number to count, so a passing "main.go" in prose is
+// not checked.
+func parsePath(s string, inCode bool) *core.CodeReference {
+	if strings.Contains(s, "://") {
+		return nil
+	}
+	m := pathRef.FindStringSubmatch(s)
+	if m == nil {
+		return nil
+	}
+	path := m[1]
+	base := filepath.Base(path)
+	ext := ""
+	if i := strings.LastIndex(base, "."); i > 0 {
+		ext = base[i+1:]
+	}
+	if !sourceExts[ext] && !sourceNames[base] {
+		return nil
+	}
+
+	start, end := atoi(m[2]), atoi(m[3])
+	if m[4] != "" {
+		start, end = atoi(m[4]), atoi(m[5])
+	}
+	if !inCode && !strings.Contains(path, "/") && start == 0 {
+		return nil
+	}
+	if end == 0 {
+		end = start
+	}
+	return &core.CodeReference{Text: s, Path: path, LineStart: start, LineEnd: end}
+}
+
+// parseSymbol reads a symbol reference: a call like runDebate(), or a
+// qualified name like Engine.RunDebate. A lone word is too ambiguous.
+func parseSymbol(s string) *core.CodeReference {
+	m := symbolRef.FindStringSubmatch(s)
+	if m == nil {
+		return nil
+	}
+	name := m[1]
+	if m[2] == "" && !strings.Contains(name, ".") {
+		return nil
+	}
+	parts := strings.Split(name, ".")
+	return &core.CodeReference{Text: s, Symbol: parts[len(parts)-1]}
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// Verify checks each reference against the workspace at root and records
+// whether it holds: the file exists, the line range is within the file, or
+// the symbol appears in the workspace's source files. A root that is not
+// a directory leaves the references unchecked and returns nil.
+func Verify(root string, refs []*core.CodeReference) []*core.CodeReference {
+	if root == "" || len(refs) == 0 {
+		return nil
+	}
+	if info, err := os.Stat(root); err != nil || !info.IsDir() {
+		return nil
+	}
+	if abs, err := filepath.Abs(root); err == nil {
+		root = abs
+	}
+
+	ws := &workspace{root: root}
+	for _, ref := range refs {
+		if ref.Symbol != "" {
+			ws.verifySymbol(ref)
+		} else {
+			ws.verifyPath(ref)
+		}
+	}
+	return refs
+}
+
+// Check extracts the code references in content and verifies them
+// against the workspace at root.
+func Check(root, content string) []*core.CodeReference {
+	return Verify(root, Extract(content))
+}
+
+// Summary counts a list of references by outcome.
+func Summary(refs []*core.CodeReference) (verified, unverified int) {
+	for _, ref := range refs {
+		if ref.Verified {
+			verified++
+		} else {
+			unverified++
+		}
+	}
+	return verified, unverified
+}
+
+// workspace lazily lists a workspace's source files for the lookups that
+// need them.
+type workspace struct {
+	root    string
+	files   []string // Slash-separated, relative to root
+	scanned bool
+}
+
+func (w *workspace) verifyPath(ref *core.CodeReference) {
+	rel := ref.Path
+	if filepath.IsAbs(rel) {
+		var err error
+		if rel, err = filepath.Rel(w.root, rel); err != nil {
+			ref.Reason = "path is outside the workspace"
+			return
+		}
+	}
+	rel = filepath.ToSlash(filepath.Clean(rel))
+	if rel == ".." || strings.HasPrefix(rel, "../") {
+		ref.Reason = "path is outside the workspace"
+		return
+	}
+
+	resolved := ""
+	if info, err := os.Stat(filepath.Join(w.root, rel)); err == nil && !info.IsDir() {
+		resolved = rel
+	} else {
+		// Agents often cite a path relative to a subdirectory
+		for _, f := range w.list() {
+			if f == rel || strings.HasSuffix(f, "/"+rel) {
+				resolved = f
+				break
+			}
+		}
+	}
+	if resolved == "" {
+		ref.Reason = "file not found"
+		return
+	}
+	ref.Resolved = resolved
+
+	if ref.LineStart == 0 {
+		ref.Verified = true
+		return
+	}
+	lines, err := countLines(filepath.Join(w.root, resolved))
+	if err != nil {
+		ref.Reason = "file could not be read"
+		return
+	}
+	switch {
+	case ref.LineEnd < ref.LineStart:
+		ref.Reason = fmt.Sprintf("line range %d-%d is reversed", ref.LineStart, ref.LineEnd)
+	case ref.LineEnd > lines:
+		ref.Reason = fmt.Sprintf("line %d is past the end of the file (%d lines)", ref.LineEnd, lines)
+	default:
+		ref.Verified = true
+	}
+}
+
+func (w *workspace) verifySymbol(ref *core.CodeReference) {
+	word := regexp.MustCompile(`\b` + regexp.QuoteMeta(ref.Symbol) + `\b`)
+	for _, f := range w.list() {
+		data, err := os.ReadFile(filepath.Join(w.root, f))
+		if err != nil || !word.Match(data) {
+			continue
+		}
+		ref.Verified = true
+		ref.Resolved = fmt.Sprintf("%s:%d", f, bytes.Count(data[:word.FindIndex(data)[0]], []byte("\n"))+1)
+		return
+	}
+	ref.Reason = "symbol not found in the workspace"
+}
+
+// list returns the workspace's source files in sorted order, skipping
+// hidden and dependency directories.
+func (w *workspace) list() []string {
+	if w.scanned {
+		return w.files
+	}
+	w.scanned = true
+	filepath.WalkDir(w.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		name := d.Name()
+		if d.IsDir() {
+			if path != w.root && (strings.HasPrefix(name, ".") || skipDirs[name]) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if len(w.files) >= maxFiles {
+			return filepath.SkipAll
+		}
+		ext := strings.TrimPrefix(filepath.Ext(name), ".")
+		if !sourceExts[ext] && !sourceNames[name] {
+			return nil
+		}
+		if info, err := d.Info(); err != nil || info.Size() > maxFileSize {
+			return nil
+		}
+		rel, err := filepath.Rel(w.root, path)
+		if err == nil {
+			w.files = append(w.files, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	sort.Strings(w.files)
+	return w.files
+}
+
+func countLines(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), maxFileSize)
+	lines := 0
+	for scanner.Scan() {
+		lines++
+	}
+	return lines, scanner.Err()
+}
@@ -0,0 +1,98 @@
+package coderef
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	content := "The bug is in internal/engine/engine.go:40-52, see also `storage.go` and `runDebate()`.\n" +
+		"Compare pkg/foo.go#L3-L5 with https://example.com/a/b.go, e.g. v1.2 or main.go in prose.\n" +
+		"```go\nfunc other() { x := a/b.go }\n```\n" +
+		"`Engine.RunDebate` and `word` and internal/engine/engine.go:40-52 again."
+
+	refs := Extract(content)
+	want := []struct {
+		text, path, symbol string
+		start, end         int
+	}{
+		{"storage.go", "storage.go", "", 0, 0},
+		{"runDebate()", "", "runDebate", 0, 0},
+		{"internal/engine/engine.go:40-52", "internal/engine/engine.go", "", 40, 52},
+		{"pkg/foo.go#L3-L5", "pkg/foo.go", "", 3, 5},
+		{"Engine.RunDebate", "", "RunDebate", 0, 0},
+	}
+	if len(refs) != len(want) {
+		for _, r := range refs {
+			t.Logf("got %+v", r)
+		}
+		t.Fatalf("got %d references, want %d", len(refs), len(want))
+	}
+	for i, w := range want {
+		r := refs[i]
+		if r.Text != w.text || r.Path != w.path || r.Symbol != w.symbol || r.LineStart != w.start || r.LineEnd != w.end {
+			t.Errorf("reference %d = %+v, want %+v", i, r, w)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	root := t.TempDir()
+	write := func(rel, data string) {
+		path := filepath.Join(root, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("internal/engine/engine.go", "package engine\n\nfunc RunDebate() {}\n")
+	write("node_modules/lib/index.js", "function hidden() {}\n")
+
+	content := "See internal/engine/engine.go:3, `engine.go`, engine/engine.go:1-2, " +
+		"internal/engine/engine.go:2-9, missing/file.go:1, ../outside/x.go:1, " +
+		"`RunDebate()`, `Nope()` and `hidden()`."
+	refs := Check(root, content)
+
+	want := map[string]struct {
+		verified bool
+		resolved string
+		reason   string
+	}{
+		"internal/engine/engine.go:3":   {true, "internal/engine/engine.go", ""},
+		"engine.go":                     {true, "internal/engine/engine.go", ""},
+		"engine/engine.go:1-2":          {true, "internal/engine/engine.go", ""},
+		"internal/engine/engine.go:2-9": {false, "internal/engine/engine.go", "line 9 is past the end of the file (3 lines)"},
+		"missing/file.go:1":             {false, "", "file not found"},
+		"../outside/x.go:1":             {false, "", "path is outside the workspace"},
+		"RunDebate()":                   {true, "internal/engine/engine.go:3", ""},
+		"Nope()":                        {false, "", "symbol not found in the workspace"},
+		"hidden()":                      {false, "", "symbol not found in the workspace"},
+	}
+	if len(refs) != len(want) {
+		for _, r := range refs {
+			t.Logf("got %+v", r)
+		}
+		t.Fatalf("got %d references, want %d", len(refs), len(want))
+	}
+	for _, r := range refs {
+		w, ok := want[r.Text]
+		if !ok {
+			t.Errorf("unexpected reference %q", r.Text)
+			continue
+		}
+		if r.Verified != w.verified || r.Resolved != w.resolved || r.Reason != w.reason {
+			t.Errorf("%s: got verified=%v resolved=%q reason=%q, want %+v", r.Text, r.Verified, r.Resolved, r.Reason, w)
+		}
+	}
+
+	if verified, unverified := Summary(refs); verified != 4 || unverified != 5 {
+		t.Errorf("Summary = %d, %d; want 4, 5", verified, unverified)
+	}
+
+	if refs := Check(filepath.Join(root, "missing"), content); refs != nil {
+		t.Errorf("expected no references for a missing workspace, got %d", len(refs))
+	}
+}
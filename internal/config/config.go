@@ -30,6 +30,9 @@ type Config struct {
 // ServerConfig holds server settings.
 type ServerConfig struct {
 	Port int `yaml:"port"`
+	// Recovery decides what startup does with sessions left in progress by
+	// a server that died mid-run: "resume" (default) or "interrupt".
+	Recovery core.RecoveryPolicy `yaml:"recovery,omitempty"`
 }
 
 // DefaultsConfig holds default settings.
@@ -88,7 +91,8 @@ func Default() *Config {
 			Model:    "",
 		},
 		Server: ServerConfig{
-			Port: 8182,
+			Port:     8182,
+			Recovery: core.RecoveryResume,
 		},
 		Providers: providers,
 	}
@@ -128,6 +132,10 @@ func LoadFrom(path string) (*Config, error) {
 		ApplyEnvOverrides(cfg, env)
 	}
 
+	if !core.ValidRecoveryPolicy(cfg.Server.Recovery) {
+		return nil, fmt.Errorf("invalid server.recovery %q (want resume or interrupt)", cfg.Server.Recovery)
+	}
+
 	return cfg, nil
 }
 
@@ -251,6 +259,10 @@ defaults:
   provider: claude          # Default provider
   model: ""                 # Default model (empty = provider default)
 
+server:
+  port: 8182
+  recovery: resume          # Sessions left in progress by a crash: resume, or interrupt to wait for "conclave resume"
+
 providers:
   claude:
     command: claude
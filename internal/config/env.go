@@ -7,6 +7,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
 )
 
 // LoadEnv reads a .env file and returns a map of key-value pairs.
@@ -60,6 +62,9 @@ func ApplyEnvOverrides(cfg *Config, env map[string]string) {
 			cfg.Server.Port = port
 		}
 	}
+	if val, ok := env["SERVER_RECOVERY"]; ok {
+		cfg.Server.Recovery = core.RecoveryPolicy(val)
+	}
 
 	// Defaults
 	if val, ok := env["DEFAULT_STYLE"]; ok {
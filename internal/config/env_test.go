@@ -5,6 +5,8 @@ import (
 	"path/filepath"
 	"testing"
 	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
 )
 
 func TestLoadEnv(t *testing.T) {
@@ -55,6 +57,7 @@ func TestApplyEnvOverrides(t *testing.T) {
 		"PROVIDER_CLAUDE_ENABLED": "false",
 		"PROVIDER_TIMEOUT":        "60",
 		"SERVER_PORT":             "9090",
+		"SERVER_RECOVERY":         "interrupt",
 	}
 
 	ApplyEnvOverrides(cfg, env)
@@ -74,4 +77,7 @@ func TestApplyEnvOverrides(t *testing.T) {
 	if cfg.Server.Port != 9090 {
 		t.Errorf("expected port 9090, got %d", cfg.Server.Port)
 	}
+	if cfg.Server.Recovery != core.RecoveryInterrupt {
+		t.Errorf("expected recovery interrupt, got %s", cfg.Server.Recovery)
+	}
 }
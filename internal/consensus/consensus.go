@@ -0,0 +1,131 @@
+// Package consensus detects when debate participants have converged.
+package consensus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// Input is what a detector sees when checking for consensus.
+type Input struct {
+	Topic   string
+	Agents  []core.Agent // Participants, used to name agents in rationales
+	Turns   []*core.Turn // The latest turn from each participant, oldest first
+	History string       // Formatted debate history for LLM-based detectors
+
+	// Instructions are formatted project instructions passed to LLM-based detectors.
+	Instructions string
+}
+
+// Detector scores how far participants agree.
+type Detector interface {
+	Method() core.ConsensusMethod
+	// Detect returns an agreement score between 0 and 1 and explains it.
+	Detect(ctx context.Context, in Input) (score float64, rationale string, err error)
+}
+
+// GenerateFunc sends a prompt to a model and returns its reply.
+type GenerateFunc func(ctx context.Context, prompt string) (string, error)
+
+// ValidMethod reports whether m is a known consensus method.
+// The empty method is valid and means the default.
+func ValidMethod(m core.ConsensusMethod) bool {
+	switch m {
+	case "", core.ConsensusHybrid, core.ConsensusKeyword, core.ConsensusLLM, core.ConsensusStance:
+		return true
+	}
+	return false
+}
+
+// DefaultThreshold returns the score a method must reach when no threshold is configured.
+func DefaultThreshold(m core.ConsensusMethod) float64 {
+	switch m {
+	case core.ConsensusKeyword:
+		return 1.0 // Every participant signals agreement
+	default:
+		return 0.7
+	}
+}
+
+// New builds the detector for a config. generate is required for every
+// method but keyword.
+func New(cfg core.ConsensusConfig, generate GenerateFunc) (Detector, error) {
+	switch cfg.Method {
+	case core.ConsensusKeyword:
+		return &KeywordDetector{Signals: cfg.Keywords}, nil
+	case core.ConsensusStance:
+		if generate == nil {
+			return nil, fmt.Errorf("consensus method %s requires a model", cfg.Method)
+		}
+		return &StanceDetector{Confirm: &LLMDetector{Generate: generate}}, nil
+	case core.ConsensusLLM:
+		if generate == nil {
+			return nil, fmt.Errorf("consensus method %s requires a model", cfg.Method)
+		}
+		return &LLMDetector{Generate: generate}, nil
+	case "", core.ConsensusHybrid:
+		if generate == nil {
+			return nil, fmt.Errorf("consensus method %s requires a model", core.ConsensusHybrid)
+		}
+		return &HybridDetector{
+			Gate:    &KeywordDetector{Signals: cfg.Keywords},
+			Confirm: &LLMDetector{Generate: generate},
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown consensus method: %s", cfg.Method)
+}
+
+// Evaluate runs a detector and compares its score with the threshold
+// (zero uses the method's default).
+func Evaluate(ctx context.Context, d Detector, threshold float64, in Input) (*core.ConsensusResult, error) {
+	if threshold <= 0 {
+		threshold = DefaultThreshold(d.Method())
+	}
+
+	score, rationale, err := d.Detect(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.ConsensusResult{
+		Method:    d.Method(),
+		Score:     score,
+		Threshold: threshold,
+		Reached:   score >= threshold,
+		Rationale: rationale,
+	}, nil
+}
+
+// HybridDetector only asks the model once every participant has signalled
+// agreement, keeping LLM calls rare. This is the default method.
+type HybridDetector struct {
+	Gate    *KeywordDetector
+	Confirm *LLMDetector
+}
+
+// Method implements Detector.
+func (d *HybridDetector) Method() core.ConsensusMethod { return core.ConsensusHybrid }
+
+// Detect implements Detector.
+func (d *HybridDetector) Detect(ctx context.Context, in Input) (float64, string, error) {
+	score, rationale, err := d.Gate.Detect(ctx, in)
+	if err != nil || score < 1 {
+		return 0, rationale, err
+	}
+	return d.Confirm.Detect(ctx, in)
+}
+
+// agentName returns the masked name of an agent, falling back to its ID.
+func agentName(agents []core.Agent, id string) string {
+	for _, a := range agents {
+		if a.ID == id {
+			if a.MaskedName != "" {
+				return a.MaskedName
+			}
+			return a.Name
+		}
+	}
+	return id
+}
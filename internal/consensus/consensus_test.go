@@ -0,0 +1,202 @@
+package consensus
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+func testInput(contents ...string) Input {
+	names := []string{"Agent A", "Agent B", "Agent C"}
+	in := Input{Topic: "Tabs or spaces?"}
+	for i, c := range contents {
+		id := string(rune('a' + i))
+		in.Agents = append(in.Agents, core.Agent{ID: id, MaskedName: names[i]})
+		in.Turns = append(in.Turns, &core.Turn{AgentID: id, Content: c})
+	}
+	return in
+}
+
+func TestKeywordDetector(t *testing.T) {
+	tests := []struct {
+		name     string
+		signals  []string
+		contents []string
+		want     float64
+	}{
+		{"all agree", nil, []string{"I agree with that.", "We have common ground here."}, 1},
+		{"one holdout", nil, []string{"I agree.", "No, that is wrong.", "You're right."}, 2.0 / 3},
+		{"none", nil, []string{"Wrong.", "Also wrong."}, 0},
+		{"custom signals", []string{"d'accord"}, []string{"Je suis d'accord.", "D'accord!"}, 1},
+		{"custom signals replace defaults", []string{"d'accord"}, []string{"I agree.", "D'accord!"}, 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &KeywordDetector{Signals: tt.signals}
+			score, rationale, err := d.Detect(context.Background(), testInput(tt.contents...))
+			if err != nil {
+				t.Fatalf("Detect() error = %v", err)
+			}
+			if score != tt.want {
+				t.Errorf("score = %v, want %v", score, tt.want)
+			}
+			if rationale == "" {
+				t.Error("expected a rationale")
+			}
+		})
+	}
+}
+
+func TestStanceSimilarity(t *testing.T) {
+	similar, _ := stanceSimilarity(testInput(
+		"Spaces give consistent indentation across editors and code review tools.",
+		"Consistent indentation across editors matters, so spaces win in code review tools.",
+	))
+	apart, _ := stanceSimilarity(testInput(
+		"Spaces give consistent indentation across editors and code review tools.",
+		"Tabs respect accessibility preferences and let readers choose their width.",
+	))
+	_, rationale := stanceSimilarity(testInput(
+		"Spaces give consistent indentation across editors.",
+		"Spaces give consistent indentation across editors.",
+		"Tabs respect accessibility preferences.",
+	))
+
+	if similar <= apart {
+		t.Errorf("similar stances scored %v, differing stances %v; want similar > differing", similar, apart)
+	}
+	if similar < StanceGate {
+		t.Errorf("similar stances scored %v, below the gate", similar)
+	}
+	if !strings.Contains(rationale, "Agent A") {
+		t.Errorf("rationale %q should name the furthest pair", rationale)
+	}
+	if !strings.Contains(rationale, "Agent C") {
+		t.Errorf("rationale %q should name the holdout", rationale)
+	}
+
+	single, _ := stanceSimilarity(testInput("Only one turn."))
+	if single != 0 {
+		t.Errorf("single turn scored %v, want 0", single)
+	}
+}
+
+func TestStanceDetector(t *testing.T) {
+	calls := 0
+	generate := func(ctx context.Context, prompt string) (string, error) {
+		calls++
+		return `{"consensus": false, "confidence": 0.9, "rationale": "They disagree on safety."}`, nil
+	}
+	d, err := New(core.ConsensusConfig{Method: core.ConsensusStance}, generate)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	// Differing stances fail the gate: no model call
+	score, _, _ := d.Detect(context.Background(), testInput(
+		"Spaces give consistent indentation across editors.",
+		"Tabs respect accessibility preferences.",
+	))
+	if score != 0 || calls != 0 {
+		t.Errorf("gate failure: score = %v, calls = %d; want 0, 0", score, calls)
+	}
+
+	// Opposite claims share every content word, so the model decides
+	score, rationale, _ := d.Detect(context.Background(), testInput(
+		"Tabs are safe for this codebase.",
+		"Tabs are not safe for this codebase.",
+	))
+	if score != 0 || calls != 1 || rationale != "They disagree on safety." {
+		t.Errorf("gate pass: score = %v, rationale = %q after %d calls", score, rationale, calls)
+	}
+
+	if _, err := New(core.ConsensusConfig{Method: core.ConsensusStance}, nil); err == nil {
+		t.Error("expected stance without a model to fail")
+	}
+}
+
+func TestParseLLMVerdict(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  string
+		wantScore float64
+		wantRat   string
+	}{
+		{"json yes", `{"consensus": true, "confidence": 0.9, "rationale": "Both back spaces."}`, 0.9, "Both back spaces."},
+		{"json no", `{"consensus": false, "confidence": 0.8, "rationale": "Still split."}`, 0, "Still split."},
+		{"json unsure no", `{"consensus": false, "confidence": 0.2, "rationale": "Hard to tell."}`, 0, "Hard to tell."},
+		{"json in prose", "Sure:\n```json\n{\"consensus\": true, \"confidence\": 0.75, \"rationale\": \"Aligned.\"}\n```", 0.75, "Aligned."},
+		{"missing confidence", `{"consensus": true}`, 1, "The judge gave no rationale."},
+		{"plain yes", "YES", 1, "The judge answered YES without structured output."},
+		{"plain no", "no", 0, "The judge did not confirm consensus."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, rationale := parseLLMVerdict(tt.response)
+			if diff := score - tt.wantScore; diff > 1e-9 || diff < -1e-9 {
+				t.Errorf("score = %v, want %v", score, tt.wantScore)
+			}
+			if rationale != tt.wantRat {
+				t.Errorf("rationale = %q, want %q", rationale, tt.wantRat)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	calls := 0
+	generate := func(ctx context.Context, prompt string) (string, error) {
+		calls++
+		return `{"consensus": true, "confidence": 0.8, "rationale": "Aligned."}`, nil
+	}
+
+	d, err := New(core.ConsensusConfig{}, generate)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if d.Method() != core.ConsensusHybrid {
+		t.Errorf("default method = %s, want hybrid", d.Method())
+	}
+
+	// Keyword gate fails: no model call
+	result, err := Evaluate(context.Background(), d, 0, testInput("I agree.", "Nope."))
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if result.Reached || calls != 0 {
+		t.Errorf("gate failure: reached = %v, calls = %d; want false, 0", result.Reached, calls)
+	}
+
+	// Gate passes: the model confirms
+	result, _ = Evaluate(context.Background(), d, 0, testInput("I agree.", "We agree."))
+	if !result.Reached || calls != 1 || result.Rationale != "Aligned." {
+		t.Errorf("gate pass: got %+v after %d calls", result, calls)
+	}
+	if result.Threshold != DefaultThreshold(core.ConsensusHybrid) {
+		t.Errorf("threshold = %v, want default", result.Threshold)
+	}
+
+	// A stricter threshold rejects the same confidence
+	result, _ = Evaluate(context.Background(), d, 0.9, testInput("I agree.", "We agree."))
+	if result.Reached {
+		t.Error("expected 0.8 to miss a 0.9 threshold")
+	}
+
+	failing := func(ctx context.Context, prompt string) (string, error) { return "", errors.New("boom") }
+	llm, _ := New(core.ConsensusConfig{Method: core.ConsensusLLM}, failing)
+	if _, err := Evaluate(context.Background(), llm, 0, testInput("a", "b")); err == nil {
+		t.Error("expected generate error to propagate")
+	}
+
+	if _, err := New(core.ConsensusConfig{Method: "vibes"}, generate); err == nil {
+		t.Error("expected unknown method error")
+	}
+	if _, err := New(core.ConsensusConfig{Method: core.ConsensusLLM}, nil); err == nil {
+		t.Error("expected llm without a model to fail")
+	}
+}
@@ -0,0 +1,77 @@
+package consensus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// DefaultSignals are the agreement phrases the keyword detector looks for.
+var DefaultSignals = []string{
+	"i agree",
+	"we agree",
+	"consensus",
+	"common ground",
+	"we've reached",
+	"i concur",
+	"you're right",
+	"you are right",
+	"that's a fair point",
+	"i accept",
+	"we can conclude",
+	"in agreement",
+}
+
+// KeywordDetector scores the fraction of latest turns containing an
+// agreement phrase.
+type KeywordDetector struct {
+	Signals []string // Defaults to DefaultSignals
+}
+
+// Method implements Detector.
+func (d *KeywordDetector) Method() core.ConsensusMethod { return core.ConsensusKeyword }
+
+// Detect implements Detector.
+func (d *KeywordDetector) Detect(_ context.Context, in Input) (float64, string, error) {
+	if len(in.Turns) == 0 {
+		return 0, "No turns to compare.", nil
+	}
+
+	signals := d.Signals
+	if len(signals) == 0 {
+		signals = DefaultSignals
+	}
+
+	var agreeing, missing []string
+	for _, turn := range in.Turns {
+		name := agentName(in.Agents, turn.AgentID)
+		if signal := findSignal(turn.Content, signals); signal != "" {
+			agreeing = append(agreeing, fmt.Sprintf("%s (%q)", name, signal))
+		} else {
+			missing = append(missing, name)
+		}
+	}
+
+	score := float64(len(agreeing)) / float64(len(in.Turns))
+	rationale := fmt.Sprintf("%d of %d participants signalled agreement", len(agreeing), len(in.Turns))
+	if len(agreeing) > 0 {
+		rationale += ": " + strings.Join(agreeing, ", ")
+	}
+	if len(missing) > 0 {
+		rationale += "; no signal from " + strings.Join(missing, ", ")
+	}
+	return score, rationale + ".", nil
+}
+
+// findSignal returns the first signal contained in content, or "".
+func findSignal(content string, signals []string) string {
+	lower := strings.ToLower(content)
+	for _, signal := range signals {
+		if strings.Contains(lower, strings.ToLower(signal)) {
+			return signal
+		}
+	}
+	return ""
+}
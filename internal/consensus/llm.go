@@ -0,0 +1,87 @@
+package consensus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// LLMDetector asks a model to judge consensus and reply with JSON.
+type LLMDetector struct {
+	Generate GenerateFunc
+}
+
+// llmVerdict is the structured output requested from the model.
+type llmVerdict struct {
+	Consensus  bool    `json:"consensus"`
+	Confidence float64 `json:"confidence"`
+	Rationale  string  `json:"rationale"`
+}
+
+// Method implements Detector.
+func (d *LLMDetector) Method() core.ConsensusMethod { return core.ConsensusLLM }
+
+// Detect implements Detector.
+func (d *LLMDetector) Detect(ctx context.Context, in Input) (float64, string, error) {
+	who := "both participants"
+	if len(in.Agents) > 2 {
+		who = "all participants"
+	}
+
+	instructionBlock := ""
+	if in.Instructions != "" {
+		instructionBlock = "\n\n" + in.Instructions
+	}
+
+	prompt := fmt.Sprintf(`You are reviewing a debate on: "%s"%s
+
+Recent discussion:
+%s
+
+Based on the last few exchanges, have %s clearly reached a consensus or agreement on the main points?
+
+Respond with ONLY a JSON object in this exact shape:
+{"consensus": true or false, "confidence": <0.0-1.0>, "rationale": "<one sentence>"}`, in.Topic, instructionBlock, in.History, who)
+
+	response, err := d.Generate(ctx, prompt)
+	if err != nil {
+		return 0, "", err
+	}
+
+	score, rationale := parseLLMVerdict(response)
+	return score, rationale, nil
+}
+
+// parseLLMVerdict turns a model reply into an agreement score. The score is
+// the model's confidence in consensus; a "no" always scores 0, however
+// unsure the model is. Replies without valid JSON fall back to a leading YES/NO.
+func parseLLMVerdict(response string) (float64, string) {
+	start := strings.Index(response, "{")
+	end := strings.LastIndex(response, "}")
+	if start >= 0 && end > start {
+		var v llmVerdict
+		if err := json.Unmarshal([]byte(response[start:end+1]), &v); err == nil {
+			confidence := v.Confidence
+			if confidence <= 0 || confidence > 1 {
+				confidence = 1
+			}
+			rationale := strings.TrimSpace(v.Rationale)
+			if rationale == "" {
+				rationale = "The judge gave no rationale."
+			}
+			if !v.Consensus {
+				return 0, rationale
+			}
+			return confidence, rationale
+		}
+	}
+
+	trimmed := strings.TrimSpace(response)
+	if strings.HasPrefix(strings.ToLower(trimmed), "yes") {
+		return 1, "The judge answered YES without structured output."
+	}
+	return 0, "The judge did not confirm consensus."
+}
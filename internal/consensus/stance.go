@@ -0,0 +1,117 @@
+package consensus
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// StanceDetector gates a model check on the lexical convergence of
+// participants' latest turns. Agents converging on a position tend to reuse
+// each other's terms, but bag-of-words similarity cannot tell "X is safe"
+// from "X is not safe", so the model confirms, as in HybridDetector.
+type StanceDetector struct {
+	Confirm *LLMDetector
+}
+
+// StanceGate is the similarity the latest turns must reach before the
+// model is asked.
+const StanceGate = 0.6
+
+// Method implements Detector.
+func (d *StanceDetector) Method() core.ConsensusMethod { return core.ConsensusStance }
+
+// Detect implements Detector.
+func (d *StanceDetector) Detect(ctx context.Context, in Input) (float64, string, error) {
+	similarity, rationale := stanceSimilarity(in)
+	if similarity < StanceGate {
+		return 0, rationale, nil
+	}
+	return d.Confirm.Detect(ctx, in)
+}
+
+// stanceSimilarity returns the mean pairwise cosine similarity of the
+// turns' term frequencies and explains it.
+func stanceSimilarity(in Input) (float64, string) {
+	if len(in.Turns) < 2 {
+		return 0, "Need at least two turns to compare stances."
+	}
+
+	vectors := make([]map[string]float64, len(in.Turns))
+	for i, turn := range in.Turns {
+		vectors[i] = termFrequencies(turn.Content)
+	}
+
+	var total float64
+	pairs := 0
+	lowest := math.Inf(1)
+	var lowA, lowB string
+	for i := 0; i < len(vectors); i++ {
+		for j := i + 1; j < len(vectors); j++ {
+			sim := cosine(vectors[i], vectors[j])
+			total += sim
+			pairs++
+			if sim < lowest {
+				lowest = sim
+				lowA = agentName(in.Agents, in.Turns[i].AgentID)
+				lowB = agentName(in.Agents, in.Turns[j].AgentID)
+			}
+		}
+	}
+
+	score := total / float64(pairs)
+	rationale := fmt.Sprintf("Mean stance similarity %.2f across %d participants", score, len(in.Turns))
+	if pairs > 1 {
+		rationale += fmt.Sprintf("; furthest apart: %s and %s (%.2f)", lowA, lowB, lowest)
+	}
+	return score, rationale + "."
+}
+
+// stopwords are dropped before comparing turns.
+var stopwords = map[string]bool{
+	"the": true, "and": true, "for": true, "are": true, "but": true, "not": true,
+	"you": true, "your": true, "with": true, "that": true, "this": true, "have": true,
+	"has": true, "was": true, "were": true, "will": true, "would": true, "could": true,
+	"should": true, "can": true, "our": true, "their": true, "they": true, "them": true,
+	"its": true, "from": true, "about": true, "into": true, "than": true, "then": true,
+	"also": true, "more": true, "most": true, "some": true, "such": true, "what": true,
+	"which": true, "when": true, "where": true, "who": true, "why": true, "how": true,
+	"all": true, "any": true, "both": true, "each": true, "other": true, "there": true,
+	"these": true, "those": true, "been": true, "being": true, "does": true, "just": true,
+	"only": true, "very": true, "because": true, "while": true, "agent": true,
+}
+
+// termFrequencies counts the content words (three letters or more) in text.
+func termFrequencies(text string) map[string]float64 {
+	tf := make(map[string]float64)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, w := range words {
+		if len([]rune(w)) < 3 || stopwords[w] {
+			continue
+		}
+		tf[w]++
+	}
+	return tf
+}
+
+// cosine returns the cosine similarity of two term vectors.
+func cosine(a, b map[string]float64) float64 {
+	var dot, normA, normB float64
+	for term, x := range a {
+		dot += x * b[term]
+		normA += x * x
+	}
+	for _, y := range b {
+		normB += y * y
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -89,13 +89,13 @@ var DefaultPersonaOrder = []string{
 }
 
 // AssignDefaultPersonas assigns default personas to member specs that don't have one.
-// Personas are assigned in order from DefaultPersonaOrder.
+// Personas are assigned in order from DefaultPersonaOrder. Human seats keep none.
 func AssignDefaultPersonas(members []MemberSpec) []MemberSpec {
 	result := make([]MemberSpec, len(members))
 	copy(result, members)
 
 	for i := range result {
-		if result[i].Persona == "" {
+		if result[i].Persona == "" && result[i].Provider != ProviderHuman {
 			// Assign persona from default order (cycle if more members than personas)
 			personaIdx := i % len(DefaultPersonaOrder)
 			result[i].Persona = DefaultPersonaOrder[personaIdx]
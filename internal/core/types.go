@@ -2,6 +2,8 @@
 package core
 
 import (
+	"fmt"
+	"regexp"
 	"strings"
 	"time"
 )
@@ -10,31 +12,139 @@ import (
 type DebateStatus string
 
 const (
-	StatusPending    DebateStatus = "pending"
-	StatusInProgress DebateStatus = "in_progress"
-	StatusCompleted  DebateStatus = "completed"
-	StatusFailed     DebateStatus = "failed"
+	StatusPending       DebateStatus = "pending"
+	StatusInProgress    DebateStatus = "in_progress"
+	StatusCompleted     DebateStatus = "completed"
+	StatusFailed        DebateStatus = "failed"
+	StatusPaused        DebateStatus = "paused"         // Stopped on request; resumes from the next turn or stage
+	StatusCancelled     DebateStatus = "cancelled"      // Stopped on request for good
+	StatusInterrupted   DebateStatus = "interrupted"    // Left in progress by a crashed server; resumable
+	StatusAwaitingInput DebateStatus = "awaiting_input" // Waiting for a human participant's turn
 )
 
-// Debate represents a debate session between two AI agents.
+// Resumable reports whether a session in this status can be resumed.
+func (s DebateStatus) Resumable() bool {
+	return s == StatusPaused || s == StatusInterrupted
+}
+
+// RecoveryPolicy decides what server startup does with sessions a previous
+// process left in progress.
+type RecoveryPolicy string
+
+const (
+	RecoveryResume    RecoveryPolicy = "resume"    // Continue from the next turn or stage (default)
+	RecoveryInterrupt RecoveryPolicy = "interrupt" // Mark interrupted and wait for an explicit resume
+)
+
+// ValidRecoveryPolicy reports whether p is a known recovery policy (empty means default).
+func ValidRecoveryPolicy(p RecoveryPolicy) bool {
+	switch p {
+	case "", RecoveryResume, RecoveryInterrupt:
+		return true
+	}
+	return false
+}
+
+// SpeakingOrder controls who speaks next within a debate round.
+type SpeakingOrder string
+
+const (
+	SpeakingOrderRoundRobin SpeakingOrder = "round_robin" // Participants speak in declaration order
+	SpeakingOrderRandom     SpeakingOrder = "random"      // Order is shuffled once per round (default)
+	SpeakingOrderModerator  SpeakingOrder = "moderator"   // A moderator picks the next speaker each turn
+)
+
+// ValidSpeakingOrder reports whether o is a known speaking order (empty means default).
+func ValidSpeakingOrder(o SpeakingOrder) bool {
+	switch o {
+	case "", SpeakingOrderRoundRobin, SpeakingOrderRandom, SpeakingOrderModerator:
+		return true
+	}
+	return false
+}
+
+// ConsensusMethod selects how early consensus is detected during a debate.
+type ConsensusMethod string
+
+const (
+	ConsensusHybrid  ConsensusMethod = "hybrid"  // Keyword gate confirmed by an LLM judge (default)
+	ConsensusKeyword ConsensusMethod = "keyword" // Agreement phrases in every agent's latest turn
+	ConsensusLLM     ConsensusMethod = "llm"     // LLM judge with structured output
+	ConsensusStance  ConsensusMethod = "stance"  // Lexical similarity gate confirmed by an LLM judge
+)
+
+// ConsensusConfig configures early consensus detection for a debate or style.
+type ConsensusConfig struct {
+	Method    ConsensusMethod `json:"method"`
+	Threshold float64         `json:"threshold,omitempty"` // 0-1; zero uses the method's default
+	Keywords  []string        `json:"keywords,omitempty"`  // Agreement phrases for keyword/hybrid (defaults to English phrases)
+}
+
+// ConsensusResult records a consensus detector's decision.
+type ConsensusResult struct {
+	Method    ConsensusMethod `json:"method"`
+	Score     float64         `json:"score"` // Agreement between 0 and 1
+	Threshold float64         `json:"threshold"`
+	Reached   bool            `json:"reached"`
+	Rationale string          `json:"rationale"`
+}
+
+// StalemateConfig configures stalemate detection for a debate. Detection is
+// on by default: a debate ends when two consecutive rotations add nothing new.
+type StalemateConfig struct {
+	Disabled  bool    `json:"disabled,omitempty"`
+	Threshold float64 `json:"threshold,omitempty"` // Novelty (0-1) a rotation needs to count as progress; zero uses the default
+	Confirm   bool    `json:"confirm,omitempty"`   // Ask a model to confirm the stalemate before ending the debate
+}
+
+// StalemateResult records why a debate ended in a stalemate.
+type StalemateResult struct {
+	Novelty   []float64 `json:"novelty"` // Novelty of each stale rotation, oldest first
+	Threshold float64   `json:"threshold"`
+	Confirmed bool      `json:"confirmed,omitempty"` // A model confirmed no new arguments were made
+	Rationale string    `json:"rationale"`
+}
+
+// SteelmanConfig turns on the steelman step: before rebutting, each agent
+// restates the strongest argument of the agent it answers, and the judge
+// (or that agent) rates how faithful the restatement is.
+type SteelmanConfig struct {
+	MinScore   int `json:"min_score,omitempty"`   // Fidelity (1-10) a restatement needs; zero uses the default
+	MaxRetries int `json:"max_retries,omitempty"` // Retries after a low score; zero uses the default
+}
+
+// Debate represents a debate session between two or more AI agents.
 type Debate struct {
-	ID                  string        `json:"id"`
-	Title               string        `json:"title"`
-	Topic               string        `json:"topic"`
-	CWD                 string        `json:"cwd"`
-	WorkspaceID         string        `json:"workspace_id,omitempty"` // ID of the workspace (if any)
-	ProjectID           string        `json:"project_id,omitempty"`
-	ProjectInstructions string        `json:"project_instructions,omitempty"`
-	AgentA              Agent         `json:"agent_a"`
-	AgentB              Agent         `json:"agent_b"`
-	Style               string        `json:"style"`
-	MaxTurns            int           `json:"max_turns"` // Turns per agent (total = MaxTurns * 2)
-	Status              DebateStatus  `json:"status"`
-	ReadOnly            bool          `json:"read_only"` // If true, debate cannot be modified or deleted
-	Conclusions         []*Conclusion `json:"conclusions,omitempty"`
-	CreatedAt           time.Time     `json:"created_at"`
-	UpdatedAt           time.Time     `json:"updated_at"`
-	CompletedAt         *time.Time    `json:"completed_at,omitempty"`
+	ID                  string           `json:"id"`
+	Title               string           `json:"title"`
+	Topic               string           `json:"topic"`
+	CWD                 string           `json:"cwd"`
+	WorkspaceID         string           `json:"workspace_id,omitempty"` // ID of the workspace (if any)
+	ProjectID           string           `json:"project_id,omitempty"`
+	ProjectInstructions string           `json:"project_instructions,omitempty"`
+	AgentA              Agent            `json:"agent_a"`          // First participant
+	AgentB              Agent            `json:"agent_b"`          // Second participant
+	Agents              []Agent          `json:"agents,omitempty"` // All participants in declaration order (includes A and B)
+	SpeakingOrder       SpeakingOrder    `json:"speaking_order,omitempty"`
+	Judge               *Agent           `json:"judge,omitempty"`     // Optional independent judge (scores rounds, writes the summary)
+	Moderator           *Agent           `json:"moderator,omitempty"` // Optional moderator (summarizes and sharpens the question between rotations)
+	Budget              *Budget          `json:"budget,omitempty"`
+	StopReason          string           `json:"stop_reason,omitempty"` // Why the last run ended early (e.g. a budget was reached)
+	Consensus           *ConsensusConfig `json:"consensus,omitempty"`   // Overrides the style's consensus detection
+	Vote                *VoteConfig      `json:"vote,omitempty"`        // Vote over options instead of AGREE/DISAGREE
+	Stalemate           *StalemateConfig `json:"stalemate,omitempty"`   // Overrides the default stalemate detection
+	Steelman            *SteelmanConfig  `json:"steelman,omitempty"`    // Agents restate the opposing argument before rebutting
+	ParentID            string           `json:"parent_id,omitempty"`   // Debate this one was forked from
+	ForkPoint           int              `json:"fork_point,omitempty"`  // Last parent turn number copied into the fork
+	SwapOf              string           `json:"swap_of,omitempty"`     // Debate this one reruns with the agents' stances swapped
+	Style               string           `json:"style"`
+	MaxTurns            int              `json:"max_turns"` // Turns per agent per round (total = MaxTurns * participants)
+	Status              DebateStatus     `json:"status"`
+	ReadOnly            bool             `json:"read_only"` // If true, debate cannot be modified or deleted
+	Conclusions         []*Conclusion    `json:"conclusions,omitempty"`
+	CreatedAt           time.Time        `json:"created_at"`
+	UpdatedAt           time.Time        `json:"updated_at"`
+	CompletedAt         *time.Time       `json:"completed_at,omitempty"`
 	// Failure tracking
 	FailedTurns    int `json:"failed_turns,omitempty"`    // Count of failed turns
 	CompletedTurns int `json:"completed_turns,omitempty"` // Count of successfully completed turns
@@ -43,11 +153,60 @@ type Debate struct {
 // Agent represents an AI agent participating in a debate.
 type Agent struct {
 	ID         string `json:"id"`
-	Name       string `json:"name"`        // Real name for UI display (e.g., "claude (Optimist)")
-	MaskedName string `json:"masked_name"` // Masked name for prompts (e.g., "Agent A")
-	Provider   string `json:"provider"`    // claude, codex, gemini, qwen
-	Model      string `json:"model"`       // specific model (optional)
-	Persona    string `json:"persona"`     // optimist, skeptic, etc.
+	Name       string `json:"name"`             // Real name for UI display (e.g., "claude (Optimist)")
+	MaskedName string `json:"masked_name"`      // Masked name for prompts (e.g., "Agent A")
+	Provider   string `json:"provider"`         // claude, codex, gemini, qwen
+	Model      string `json:"model"`            // specific model (optional)
+	Persona    string `json:"persona"`          // optimist, skeptic, etc.
+	Stance     string `json:"stance,omitempty"` // Assigned position: FOR, AGAINST or free text
+}
+
+// Stances for debates that argue for or against the topic. Any other
+// non-empty stance is a free-text position.
+const (
+	StanceFor     = "FOR"
+	StanceAgainst = "AGAINST"
+)
+
+// NormalizeStance trims a stance and upper-cases the FOR/AGAINST keywords.
+func NormalizeStance(stance string) string {
+	stance = strings.TrimSpace(stance)
+	switch strings.ToUpper(stance) {
+	case StanceFor:
+		return StanceFor
+	case StanceAgainst:
+		return StanceAgainst
+	}
+	return stance
+}
+
+// DescribeStance returns the position an agent argues, as shown in prompts.
+func DescribeStance(stance string) string {
+	switch stance {
+	case StanceFor:
+		return "FOR the topic"
+	case StanceAgainst:
+		return "AGAINST the topic"
+	}
+	return stance
+}
+
+// ProviderHuman is the provider name of a seat held by a person. Its turns
+// are submitted through the API or CLI instead of generated.
+const ProviderHuman = "human"
+
+// IsHuman reports whether the agent's seat is held by a person.
+func (a Agent) IsHuman() bool {
+	return a.Provider == ProviderHuman
+}
+
+// AwaitingInput describes the human turn a debate is waiting for.
+type AwaitingInput struct {
+	AgentID    string `json:"agent_id"`
+	MaskedName string `json:"masked_name"` // The name other participants see
+	Round      int    `json:"round"`
+	TurnNumber int    `json:"turn_number"` // Turn within the round, 1-based
+	TotalTurns int    `json:"total_turns"`
 }
 
 // TurnType represents the type of turn for separate tracking.
@@ -57,6 +216,8 @@ const (
 	TurnTypeDebate     TurnType = "debate"     // Regular debate turn
 	TurnTypeConclusion TurnType = "conclusion" // Conclusion generation
 	TurnTypeVote       TurnType = "vote"       // Voting turn
+	TurnTypeJudge      TurnType = "judge"      // Judge scoring and verdict
+	TurnTypeModerator  TurnType = "moderator"  // Moderator summary and question between rotations
 	TurnTypeUser       TurnType = "user"       // User input (follow-up)
 )
 
@@ -71,17 +232,186 @@ type Turn struct {
 	CreatedAt time.Time `json:"created_at"`
 
 	// Metadata from provider response
-	TurnType     TurnType `json:"turn_type,omitempty"`     // Type of turn for separate tracking
-	InputTokens  int      `json:"input_tokens,omitempty"`  // Input tokens from provider
-	OutputTokens int      `json:"output_tokens,omitempty"` // Output tokens from provider
-	TotalTokens  int      `json:"total_tokens,omitempty"`  // Total tokens
-	DurationMs   int64    `json:"duration_ms,omitempty"`   // Duration in milliseconds from provider
-	Model        string   `json:"model,omitempty"`         // Model used for this turn
-	StopReason   string   `json:"stop_reason,omitempty"`   // Stop reason from provider
+	TurnType        TurnType `json:"turn_type,omitempty"`        // Type of turn for separate tracking
+	InputTokens     int      `json:"input_tokens,omitempty"`     // Input tokens from provider
+	OutputTokens    int      `json:"output_tokens,omitempty"`    // Output tokens from provider
+	TotalTokens     int      `json:"total_tokens,omitempty"`     // Total tokens
+	DurationMs      int64    `json:"duration_ms,omitempty"`      // Duration in milliseconds from provider
+	Model           string   `json:"model,omitempty"`            // Model used for this turn
+	StopReason      string   `json:"stop_reason,omitempty"`      // Stop reason from provider
+	TokensEstimated bool     `json:"tokens_estimated,omitempty"` // Token counts approximated (provider omitted usage)
+	InputEstimated  bool     `json:"input_estimated,omitempty"`  // Input count approximated
+	OutputEstimated bool     `json:"output_estimated,omitempty"` // Output count approximated
+	CostUSD         float64  `json:"cost_usd,omitempty"`         // Cost reported by the provider (0 if not reported)
 
 	// Failure tracking
 	Status string `json:"status,omitempty"` // "completed", "failed"
 	Error  string `json:"error,omitempty"`  // Error message if turn failed
+
+	// Regenerated versions; the fields above hold the selected one
+	Versions        []*Version `json:"versions,omitempty"`
+	SelectedVersion int        `json:"selected_version,omitempty"`
+
+	Novelty  *Novelty       `json:"novelty,omitempty"`  // Set on debate turns
+	Steelman *SteelmanScore `json:"steelman,omitempty"` // Set on debate turns of steelman debates that answer another agent
+
+	References []*CodeReference `json:"references,omitempty"` // Code the turn cites, checked against the workspace
+	Targeting  *Targeting       `json:"targeting,omitempty"`  // Set on user follow-ups addressed to some agents only
+}
+
+// CodeReference is a file, line range or symbol cited in a turn or
+// response, checked against the session's workspace.
+type CodeReference struct {
+	Text      string `json:"text"`                 // As written, e.g. "internal/engine/engine.go:40-52"
+	Path      string `json:"path,omitempty"`       // Cited file; empty for a symbol
+	LineStart int    `json:"line_start,omitempty"` // Cited lines, if any
+	LineEnd   int    `json:"line_end,omitempty"`
+	Symbol    string `json:"symbol,omitempty"` // Cited function, type or method name
+	Verified  bool   `json:"verified"`
+	Resolved  string `json:"resolved,omitempty"` // Workspace file the reference matched (file:line for symbols)
+	Reason    string `json:"reason,omitempty"`   // Why it could not be verified
+}
+
+// Novelty measures how much a turn adds over its agent's earlier turns.
+type Novelty struct {
+	Score   float64 `json:"score"`   // Share of the turn's word trigrams the agent has not used before (1 = all new)
+	Overlap float64 `json:"overlap"` // Highest trigram overlap with any single earlier turn by the agent
+	NGrams  int     `json:"ngrams"`  // Distinct trigrams in the turn
+}
+
+// SteelmanScore rates how faithfully a turn restated the argument it answers.
+type SteelmanScore struct {
+	TargetID    string `json:"target_id"`   // Agent whose argument was restated
+	TargetTurn  int    `json:"target_turn"` // Number of the turn restated
+	RaterID     string `json:"rater_id"`    // The judge, or the target agent
+	Restatement string `json:"restatement"`
+	Score       int    `json:"score"` // Fidelity 1-10; 0 if no restatement was found
+	Rationale   string `json:"rationale"`
+	Passed      bool   `json:"passed"`   // Score reached the debate's minimum
+	Attempts    int    `json:"attempts"` // Generations the turn took, including retries
+}
+
+// Claim is one argument extracted from a debate turn.
+type Claim struct {
+	ID         string   `json:"id"` // "t<turn>c<n>", e.g. t3c2 for the second claim of turn 3
+	TurnID     string   `json:"turn_id"`
+	TurnNumber int      `json:"turn_number"`
+	AgentID    string   `json:"agent_id"`
+	Text       string   `json:"text"`
+	Evidence   []string `json:"evidence,omitempty"` // Support offered for the claim
+	Rebuts     []string `json:"rebuts,omitempty"`   // IDs of earlier claims this one answers
+}
+
+// ArgumentGraph holds the claims of a debate's turns, linked by rebuttals.
+type ArgumentGraph struct {
+	DebateID string   `json:"debate_id"`
+	Claims   []*Claim `json:"claims"`            // In turn order
+	Pending  int      `json:"pending,omitempty"` // Debate turns not yet extracted
+}
+
+// Unanswered returns the claims no other agent rebutted, in turn order.
+func (g *ArgumentGraph) Unanswered() []*Claim {
+	byID := make(map[string]*Claim, len(g.Claims))
+	for _, c := range g.Claims {
+		byID[c.ID] = c
+	}
+	answered := make(map[string]bool)
+	for _, c := range g.Claims {
+		for _, id := range c.Rebuts {
+			if target, ok := byID[id]; ok && target.AgentID != c.AgentID {
+				answered[id] = true
+			}
+		}
+	}
+
+	var unanswered []*Claim
+	for _, c := range g.Claims {
+		if !answered[c.ID] {
+			unanswered = append(unanswered, c)
+		}
+	}
+	return unanswered
+}
+
+// Version is one generated version of a turn or council response.
+type Version struct {
+	Content         string         `json:"content"`
+	CreatedAt       time.Time      `json:"created_at"`
+	InputTokens     int            `json:"input_tokens,omitempty"`
+	OutputTokens    int            `json:"output_tokens,omitempty"`
+	TotalTokens     int            `json:"total_tokens,omitempty"`
+	DurationMs      int64          `json:"duration_ms,omitempty"`
+	Model           string         `json:"model,omitempty"`
+	StopReason      string         `json:"stop_reason,omitempty"`
+	TokensEstimated bool           `json:"tokens_estimated,omitempty"`
+	InputEstimated  bool           `json:"input_estimated,omitempty"`
+	OutputEstimated bool           `json:"output_estimated,omitempty"`
+	CostUSD         float64        `json:"cost_usd,omitempty"`
+	Status          string         `json:"status,omitempty"`
+	Error           string         `json:"error,omitempty"`
+	Novelty         *Novelty       `json:"novelty,omitempty"`
+	Steelman        *SteelmanScore `json:"steelman,omitempty"`
+
+	References []*CodeReference `json:"references,omitempty"`
+}
+
+// CurrentVersion returns the turn's content and metadata as a version.
+func (t *Turn) CurrentVersion() *Version {
+	return &Version{
+		Content:         t.Content,
+		CreatedAt:       t.CreatedAt,
+		InputTokens:     t.InputTokens,
+		OutputTokens:    t.OutputTokens,
+		TotalTokens:     t.TotalTokens,
+		DurationMs:      t.DurationMs,
+		Model:           t.Model,
+		StopReason:      t.StopReason,
+		TokensEstimated: t.TokensEstimated,
+		InputEstimated:  t.InputEstimated,
+		OutputEstimated: t.OutputEstimated,
+		CostUSD:         t.CostUSD,
+		Status:          t.Status,
+		Error:           t.Error,
+		Novelty:         t.Novelty,
+		Steelman:        t.Steelman,
+		References:      t.References,
+	}
+}
+
+// PushVersion records a regenerated version of the turn and selects it.
+// The turn's current content becomes version 0 the first time.
+func (t *Turn) PushVersion(v *Version) {
+	if len(t.Versions) == 0 {
+		t.Versions = []*Version{t.CurrentVersion()}
+	}
+	t.Versions = append(t.Versions, v)
+	t.SelectVersion(len(t.Versions) - 1)
+}
+
+// SelectVersion makes version i the turn's content.
+func (t *Turn) SelectVersion(i int) error {
+	if i < 0 || i >= len(t.Versions) {
+		return fmt.Errorf("version %d not found (turn has %d)", i, len(t.Versions))
+	}
+	v := t.Versions[i]
+	t.Content = v.Content
+	t.InputTokens = v.InputTokens
+	t.OutputTokens = v.OutputTokens
+	t.TotalTokens = v.TotalTokens
+	t.DurationMs = v.DurationMs
+	t.Model = v.Model
+	t.StopReason = v.StopReason
+	t.TokensEstimated = v.TokensEstimated
+	t.InputEstimated = v.InputEstimated
+	t.OutputEstimated = v.OutputEstimated
+	t.CostUSD = v.CostUSD
+	t.Status = v.Status
+	t.Error = v.Error
+	t.Novelty = v.Novelty
+	t.Steelman = v.Steelman
+	t.References = v.References
+	t.SelectedVersion = i
+	return nil
 }
 
 // DebateStats contains aggregated usage statistics for a debate.
@@ -93,7 +423,14 @@ type DebateStats struct {
 	TotalDurationMs   int64 `json:"total_duration_ms"`
 	TurnCount         int   `json:"turn_count"`
 
-	// Per-agent breakdown
+	// Estimation tracking: set when any turn's token counts were approximated
+	TokensEstimated    bool `json:"tokens_estimated"`
+	EstimatedTurnCount int  `json:"estimated_turn_count"`
+
+	// Per-agent breakdown for every participant, in the order passed to ComputeDebateStats
+	Agents []*AgentStats `json:"agents,omitempty"`
+
+	// First two participants (kept for two-agent clients)
 	AgentAInputTokens  int   `json:"agent_a_input_tokens"`
 	AgentAOutputTokens int   `json:"agent_a_output_tokens"`
 	AgentATotalTokens  int   `json:"agent_a_total_tokens"`
@@ -106,6 +443,13 @@ type DebateStats struct {
 	AgentBDurationMs   int64 `json:"agent_b_duration_ms"`
 	AgentBTurnCount    int   `json:"agent_b_turn_count"`
 
+	// Moderator stats (tracked separately)
+	ModeratorInputTokens  int   `json:"moderator_input_tokens"`
+	ModeratorOutputTokens int   `json:"moderator_output_tokens"`
+	ModeratorTotalTokens  int   `json:"moderator_total_tokens"`
+	ModeratorDurationMs   int64 `json:"moderator_duration_ms"`
+	ModeratorTurnCount    int   `json:"moderator_turn_count"`
+
 	// Conclusion/voting stats (tracked separately)
 	ConclusionInputTokens  int   `json:"conclusion_input_tokens"`
 	ConclusionOutputTokens int   `json:"conclusion_output_tokens"`
@@ -114,10 +458,37 @@ type DebateStats struct {
 	ConclusionTurnCount    int   `json:"conclusion_turn_count"`
 }
 
+// AgentStats contains usage statistics for a single debate participant.
+type AgentStats struct {
+	AgentID      string `json:"agent_id"`
+	InputTokens  int    `json:"input_tokens"`
+	OutputTokens int    `json:"output_tokens"`
+	TotalTokens  int    `json:"total_tokens"`
+	DurationMs   int64  `json:"duration_ms"`
+	TurnCount    int    `json:"turn_count"`
+}
+
 // ComputeDebateStats computes aggregated statistics from turns.
-func ComputeDebateStats(turns []*Turn, agentAID, agentBID string) *DebateStats {
+// agentIDs lists the participants (see Debate.AgentIDs); the first two also
+// populate the AgentA and AgentB fields.
+func ComputeDebateStats(turns []*Turn, agentIDs ...string) *DebateStats {
 	stats := &DebateStats{}
 
+	byAgent := make(map[string]*AgentStats, len(agentIDs))
+	for _, id := range agentIDs {
+		agentStats := &AgentStats{AgentID: id}
+		stats.Agents = append(stats.Agents, agentStats)
+		byAgent[id] = agentStats
+	}
+
+	var agentAID, agentBID string
+	if len(agentIDs) > 0 {
+		agentAID = agentIDs[0]
+	}
+	if len(agentIDs) > 1 {
+		agentBID = agentIDs[1]
+	}
+
 	for _, turn := range turns {
 		// Overall totals
 		stats.TotalInputTokens += turn.InputTokens
@@ -125,17 +496,34 @@ func ComputeDebateStats(turns []*Turn, agentAID, agentBID string) *DebateStats {
 		stats.TotalTokens += turn.TotalTokens
 		stats.TotalDurationMs += turn.DurationMs
 		stats.TurnCount++
+		if turn.TokensEstimated {
+			stats.TokensEstimated = true
+			stats.EstimatedTurnCount++
+		}
 
 		// Categorize by turn type and agent
 		switch turn.TurnType {
-		case TurnTypeVote, TurnTypeConclusion:
+		case TurnTypeVote, TurnTypeConclusion, TurnTypeJudge:
 			stats.ConclusionInputTokens += turn.InputTokens
 			stats.ConclusionOutputTokens += turn.OutputTokens
 			stats.ConclusionTotalTokens += turn.TotalTokens
 			stats.ConclusionDurationMs += turn.DurationMs
 			stats.ConclusionTurnCount++
+		case TurnTypeModerator:
+			stats.ModeratorInputTokens += turn.InputTokens
+			stats.ModeratorOutputTokens += turn.OutputTokens
+			stats.ModeratorTotalTokens += turn.TotalTokens
+			stats.ModeratorDurationMs += turn.DurationMs
+			stats.ModeratorTurnCount++
 		default:
 			// Regular debate turns - attribute to agent
+			if agentStats, ok := byAgent[turn.AgentID]; ok {
+				agentStats.InputTokens += turn.InputTokens
+				agentStats.OutputTokens += turn.OutputTokens
+				agentStats.TotalTokens += turn.TotalTokens
+				agentStats.DurationMs += turn.DurationMs
+				agentStats.TurnCount++
+			}
 			if turn.AgentID == agentAID {
 				stats.AgentAInputTokens += turn.InputTokens
 				stats.AgentAOutputTokens += turn.OutputTokens
@@ -157,21 +545,278 @@ func ComputeDebateStats(turns []*Turn, agentAID, agentBID string) *DebateStats {
 
 // Vote represents an agent's vote on the conclusion.
 type Vote struct {
-	AgentID   string `json:"agent_id"`
-	Agrees    bool   `json:"agrees"`    // Does the agent agree with the proposed conclusion?
-	Reasoning string `json:"reasoning"` // Why they voted this way
+	AgentID    string  `json:"agent_id"`
+	Agrees     bool    `json:"agrees"`               // Does the agent agree with the proposed conclusion? Option votes agree when they chose the winner
+	Reasoning  string  `json:"reasoning"`            // Why they voted this way
+	Option     string  `json:"option,omitempty"`     // Chosen option when the debate votes over options
+	Confidence float64 `json:"confidence,omitempty"` // 0-1, option votes only
+}
+
+// Label is the vote as shown to people: AGREE or DISAGREE, or the chosen
+// option with its confidence.
+func (v *Vote) Label() string {
+	if v.Option != "" {
+		return fmt.Sprintf("%s (%.0f%% confidence)", v.Option, v.Confidence*100)
+	}
+	if v.Agrees {
+		return "AGREE"
+	}
+	return "DISAGREE"
+}
+
+// VoteConfig makes a debate's closing vote a choice between options, each
+// vote with a confidence and rationale, instead of AGREE/DISAGREE.
+type VoteConfig struct {
+	Options []string `json:"options,omitempty"` // Empty extracts the options from the debate at each conclusion
+}
+
+// MaxVoteOptions is the most options a vote may offer.
+const MaxVoteOptions = 10
+
+// NormalizeVoteOptions trims options and drops empty ones. It fails on
+// duplicates (ignoring case) and on fewer than 2 or more than
+// MaxVoteOptions options.
+func NormalizeVoteOptions(options []string) ([]string, error) {
+	var normalized []string
+	seen := make(map[string]bool, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			continue
+		}
+		key := strings.ToLower(option)
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate vote option: %s", option)
+		}
+		seen[key] = true
+		normalized = append(normalized, option)
+	}
+	if len(normalized) < 2 {
+		return nil, fmt.Errorf("a vote needs at least 2 options, got %d", len(normalized))
+	}
+	if len(normalized) > MaxVoteOptions {
+		return nil, fmt.Errorf("a vote allows at most %d options, got %d", MaxVoteOptions, len(normalized))
+	}
+	return normalized, nil
+}
+
+// OptionTally counts the votes for one option.
+type OptionTally struct {
+	Option     string   `json:"option"`
+	Votes      int      `json:"votes"`
+	Confidence float64  `json:"confidence"` // Sum of the voters' confidence
+	AgentIDs   []string `json:"agent_ids,omitempty"`
+}
+
+// VoteTally rolls a round's option votes up by option.
+type VoteTally struct {
+	Options []*OptionTally `json:"options"`          // In the order they were offered
+	Winner  string         `json:"winner,omitempty"` // Most votes, then highest total confidence; empty on a tie
+}
+
+// TallyVotes counts option votes. Votes for options not offered are ignored.
+func TallyVotes(options []string, votes []*Vote) *VoteTally {
+	tally := &VoteTally{}
+	byOption := make(map[string]*OptionTally, len(options))
+	for _, option := range options {
+		t := &OptionTally{Option: option}
+		tally.Options = append(tally.Options, t)
+		byOption[option] = t
+	}
+	for _, v := range votes {
+		if v == nil {
+			continue
+		}
+		if t := byOption[v.Option]; t != nil {
+			t.Votes++
+			t.Confidence += v.Confidence
+			t.AgentIDs = append(t.AgentIDs, v.AgentID)
+		}
+	}
+
+	var best *OptionTally
+	tied := false
+	for _, t := range tally.Options {
+		switch {
+		case t.Votes == 0:
+		case best == nil || t.Votes > best.Votes || (t.Votes == best.Votes && t.Confidence > best.Confidence):
+			best, tied = t, false
+		case t.Votes == best.Votes && t.Confidence == best.Confidence:
+			tied = true
+		}
+	}
+	if best != nil && !tied {
+		tally.Winner = best.Option
+	}
+	return tally
+}
+
+// String summarizes the tally in one line, e.g. for prompts.
+func (t *VoteTally) String() string {
+	parts := make([]string, 0, len(t.Options))
+	for _, o := range t.Options {
+		parts = append(parts, fmt.Sprintf("%s: %d vote(s), total confidence %.2f", o.Option, o.Votes, o.Confidence))
+	}
+	winner := "no winner (tie or no votes)"
+	if t.Winner != "" {
+		winner = "winner " + t.Winner
+	}
+	return strings.Join(parts, "; ") + "; " + winner
+}
+
+// RubricCriterion is one dimension a judge scores each agent on.
+type RubricCriterion struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// DefaultRubric is the rubric used by debate judges. Each criterion is scored 1-10.
+var DefaultRubric = []RubricCriterion{
+	{ID: "reasoning", Name: "Reasoning", Description: "Logical soundness and depth of the argument"},
+	{ID: "evidence", Name: "Evidence", Description: "Use of concrete facts, examples, and sources"},
+	{ID: "rebuttal", Name: "Rebuttal", Description: "How directly and effectively other agents' points were addressed"},
+	{ID: "clarity", Name: "Clarity", Description: "How clear, focused, and well-structured the contribution was"},
+}
+
+// VerdictOutcome is a judge's decision for a round.
+type VerdictOutcome string
+
+const (
+	VerdictWinner    VerdictOutcome = "winner"    // One agent argued best
+	VerdictConsensus VerdictOutcome = "consensus" // Agents converged on a shared position
+	VerdictDraw      VerdictOutcome = "draw"      // No clear winner and no consensus
+)
+
+// JudgeScore holds a judge's rubric scores for one agent.
+type JudgeScore struct {
+	AgentID  string         `json:"agent_id"`
+	Scores   map[string]int `json:"scores"` // RubricCriterion.ID -> 1-10
+	Total    int            `json:"total"`
+	Feedback string         `json:"feedback,omitempty"`
 }
 
+// JudgeVerdict is an independent judge's assessment of a debate round.
+type JudgeVerdict struct {
+	JudgeID  string         `json:"judge_id"`
+	Outcome  VerdictOutcome `json:"outcome"`
+	WinnerID string         `json:"winner_id,omitempty"` // Set when Outcome is VerdictWinner
+	Scores   []*JudgeScore  `json:"scores"`
+}
+
+// ScoreFor returns the judge's scores for an agent, or nil if it was not scored.
+func (v *JudgeVerdict) ScoreFor(agentID string) *JudgeScore {
+	for _, s := range v.Scores {
+		if s.AgentID == agentID {
+			return s
+		}
+	}
+	return nil
+}
+
+// ConclusionType says how a debate round ended.
+type ConclusionType string
+
+const (
+	ConclusionStandard  ConclusionType = ""          // The round ran its turns, or ended on consensus
+	ConclusionStalemate ConclusionType = "stalemate" // Agents kept repeating themselves
+)
+
 // Conclusion represents the outcome of a debate round.
 type Conclusion struct {
-	Round          int    `json:"round"`
-	Agreed         bool   `json:"agreed"`
-	Summary        string `json:"summary"`
-	AgentASummary  string `json:"agent_a_summary,omitempty"`
-	AgentBSummary  string `json:"agent_b_summary,omitempty"`
-	EarlyConsensus bool   `json:"early_consensus,omitempty"` // True if debate ended early due to consensus
-	AgentAVote     *Vote  `json:"agent_a_vote,omitempty"`
-	AgentBVote     *Vote  `json:"agent_b_vote,omitempty"`
+	Round          int     `json:"round"`
+	Agreed         bool    `json:"agreed"`
+	Summary        string  `json:"summary"`
+	AgentASummary  string  `json:"agent_a_summary,omitempty"`
+	AgentBSummary  string  `json:"agent_b_summary,omitempty"`
+	EarlyConsensus bool    `json:"early_consensus,omitempty"` // True if debate ended early due to consensus
+	AgentAVote     *Vote   `json:"agent_a_vote,omitempty"`
+	AgentBVote     *Vote   `json:"agent_b_vote,omitempty"`
+	Votes          []*Vote `json:"votes,omitempty"`            // One vote per participant (all debates with N-agent support)
+	ModelVotesOnly bool    `json:"model_votes_only,omitempty"` // Agreed reflects the model participants only; the human seat does not vote
+
+	Tally *VoteTally `json:"tally,omitempty"` // Set when the debate votes over options
+
+	Verdict   *JudgeVerdict    `json:"verdict,omitempty"`   // Set when the debate has a judge (replaces votes)
+	Consensus *ConsensusResult `json:"consensus,omitempty"` // Latest consensus check of the round
+
+	Type      ConclusionType   `json:"type,omitempty"`
+	Stalemate *StalemateResult `json:"stalemate,omitempty"` // Set when Type is ConclusionStalemate
+
+	Steelman []*SteelmanSummary `json:"steelman,omitempty"` // Set when the debate has the steelman step
+}
+
+// SteelmanSummary totals an agent's steelman scores over a round.
+type SteelmanSummary struct {
+	AgentID string  `json:"agent_id"`
+	Turns   int     `json:"turns"`   // Rated restatements
+	Average float64 `json:"average"` // Mean fidelity 1-10
+	Passed  int     `json:"passed"`  // Restatements that reached the minimum score
+	Retries int     `json:"retries"` // Extra generations low scores caused
+}
+
+// SummarizeSteelman totals the steelman scores of a round's turns per
+// agent, in the order of agentIDs. Agents with no rated turns are left out.
+func SummarizeSteelman(turns []*Turn, round int, agentIDs []string) []*SteelmanSummary {
+	byAgent := make(map[string]*SteelmanSummary)
+	for _, t := range turns {
+		if t.Round != round || t.Steelman == nil {
+			continue
+		}
+		s, ok := byAgent[t.AgentID]
+		if !ok {
+			s = &SteelmanSummary{AgentID: t.AgentID}
+			byAgent[t.AgentID] = s
+		}
+		s.Turns++
+		s.Average += float64(t.Steelman.Score)
+		if t.Steelman.Passed {
+			s.Passed++
+		}
+		if t.Steelman.Attempts > 1 {
+			s.Retries += t.Steelman.Attempts - 1
+		}
+	}
+
+	var summaries []*SteelmanSummary
+	for _, id := range agentIDs {
+		if s, ok := byAgent[id]; ok {
+			s.Average /= float64(s.Turns)
+			summaries = append(summaries, s)
+		}
+	}
+	return summaries
+}
+
+// VoteFor returns the vote cast by an agent, or nil if it did not vote.
+// Conclusions stored before per-agent votes only have AgentAVote/AgentBVote.
+func (c *Conclusion) VoteFor(agentID string) *Vote {
+	for _, v := range c.Votes {
+		if v != nil && v.AgentID == agentID {
+			return v
+		}
+	}
+	for _, v := range []*Vote{c.AgentAVote, c.AgentBVote} {
+		if v != nil && v.AgentID == agentID {
+			return v
+		}
+	}
+	return nil
+}
+
+// PositionOf returns an agent's closing position when no consensus was reached.
+// It prefers the AgentA/AgentB summaries and falls back to the vote reasoning.
+func (c *Conclusion) PositionOf(agentID string) string {
+	if c.AgentAVote != nil && c.AgentAVote.AgentID == agentID && c.AgentASummary != "" {
+		return c.AgentASummary
+	}
+	if c.AgentBVote != nil && c.AgentBVote.AgentID == agentID && c.AgentBSummary != "" {
+		return c.AgentBSummary
+	}
+	if v := c.VoteFor(agentID); v != nil {
+		return v.Reasoning
+	}
+	return ""
 }
 
 // DebateSummary is a lightweight representation for listing debates.
@@ -186,6 +831,7 @@ type DebateSummary struct {
 	Style       string       `json:"style"`
 	AgentA      string       `json:"agent_a"` // "provider:persona"
 	AgentB      string       `json:"agent_b"`
+	AgentCount  int          `json:"agent_count"`
 	TurnCount   int          `json:"turn_count"`
 	ReadOnly    bool         `json:"read_only"`
 	CreatedAt   time.Time    `json:"created_at"`
@@ -202,8 +848,41 @@ type NewDebateConfig struct {
 	AgentBProvider string `json:"agent_b_provider"`
 	AgentBModel    string `json:"agent_b_model"`
 	AgentBPersona  string `json:"agent_b_persona"`
+	AgentAStance   string `json:"agent_a_stance,omitempty"` // FOR, AGAINST or a free-text position
+	AgentBStance   string `json:"agent_b_stance,omitempty"`
 	Style          string `json:"style"`
 	MaxTurns       int    `json:"max_turns"`
+
+	// Agents lists every participant for debates with more than two agents.
+	// When set it replaces the AgentA*/AgentB* fields.
+	Agents        []MemberSpec  `json:"agents,omitempty"`
+	SpeakingOrder SpeakingOrder `json:"speaking_order,omitempty"`
+
+	// Judge is an optional independent agent that scores each round and
+	// writes the conclusion instead of the debaters voting on themselves.
+	Judge *MemberSpec `json:"judge,omitempty"`
+
+	// Moderator is an optional agent that speaks after each rotation: it
+	// summarizes where the sides stand, flags unsupported claims and poses
+	// the question the next rotation should focus on.
+	Moderator *MemberSpec `json:"moderator,omitempty"`
+
+	// Consensus overrides the style's early consensus detection.
+	Consensus *ConsensusConfig `json:"consensus,omitempty"`
+
+	// Vote makes the closing vote a choice between options with
+	// confidence scores instead of AGREE/DISAGREE.
+	Vote *VoteConfig `json:"vote,omitempty"`
+
+	// Stalemate overrides the default stalemate detection.
+	Stalemate *StalemateConfig `json:"stalemate,omitempty"`
+
+	// Steelman makes each agent restate the strongest opposing argument
+	// before rebutting it, with the restatement's fidelity rated.
+	Steelman *SteelmanConfig `json:"steelman,omitempty"`
+
+	// Budget limits the debate's spending; unset limits fall back to the project's.
+	Budget *Budget `json:"budget,omitempty"`
 }
 
 // IsModifiable returns true if the debate can be modified.
@@ -211,9 +890,81 @@ func (d *Debate) IsModifiable() bool {
 	return !d.ReadOnly && d.Status != StatusCompleted
 }
 
-// TotalTurns returns the total number of turns (both agents).
+// TotalTurns returns the total number of turns in a round (all agents).
 func (d *Debate) TotalTurns() int {
-	return d.MaxTurns * 2
+	return d.MaxTurns * len(d.Participants())
+}
+
+// Participants returns every agent in the debate in declaration order.
+// Debates created before N-agent support only have AgentA and AgentB.
+func (d *Debate) Participants() []Agent {
+	if len(d.Agents) > 0 {
+		return d.Agents
+	}
+	return []Agent{d.AgentA, d.AgentB}
+}
+
+// SetParticipants replaces the participant list and keeps AgentA/AgentB in sync.
+func (d *Debate) SetParticipants(agents []Agent) {
+	d.Agents = agents
+	if len(agents) > 0 {
+		d.AgentA = agents[0]
+	}
+	if len(agents) > 1 {
+		d.AgentB = agents[1]
+	}
+}
+
+// HumanAgent returns the participant held by a person, if any.
+func (d *Debate) HumanAgent() (Agent, bool) {
+	for _, a := range d.Participants() {
+		if a.IsHuman() {
+			return a, true
+		}
+	}
+	return Agent{}, false
+}
+
+// ModelParticipants returns the participants backed by a model, skipping
+// any human seat.
+func (d *Debate) ModelParticipants() []Agent {
+	var agents []Agent
+	for _, a := range d.Participants() {
+		if !a.IsHuman() {
+			agents = append(agents, a)
+		}
+	}
+	return agents
+}
+
+// AgentByID returns the participant with the given ID.
+func (d *Debate) AgentByID(id string) (Agent, bool) {
+	for _, a := range d.Participants() {
+		if a.ID == id {
+			return a, true
+		}
+	}
+	return Agent{}, false
+}
+
+// IsJudge reports whether agentID belongs to the debate's judge.
+func (d *Debate) IsJudge(agentID string) bool {
+	return d.Judge != nil && d.Judge.ID == agentID
+}
+
+// IsModerator reports whether agentID belongs to the debate's moderator.
+func (d *Debate) IsModerator(agentID string) bool {
+	return d.Moderator != nil && d.Moderator.ID == agentID
+}
+
+// AgentIDs returns the IDs of all participants in declaration order.
+func (d *Debate) AgentIDs() []string {
+	participants := d.Participants()
+	ids := make([]string, len(participants))
+	for i, a := range participants {
+		ids[i] = a.ID
+	}
+	return ids
 }
 
 // CouncilSynthesis represents a chairman's synthesis for a specific round.
@@ -223,12 +974,16 @@ type CouncilSynthesis struct {
 	CreatedAt time.Time `json:"created_at"`
 
 	// Metadata from provider response
-	InputTokens  int    `json:"input_tokens,omitempty"`
-	OutputTokens int    `json:"output_tokens,omitempty"`
-	TotalTokens  int    `json:"total_tokens,omitempty"`
-	DurationMs   int64  `json:"duration_ms,omitempty"`
-	Model        string `json:"model,omitempty"`
-	StopReason   string `json:"stop_reason,omitempty"`
+	InputTokens     int     `json:"input_tokens,omitempty"`
+	OutputTokens    int     `json:"output_tokens,omitempty"`
+	TotalTokens     int     `json:"total_tokens,omitempty"`
+	DurationMs      int64   `json:"duration_ms,omitempty"`
+	Model           string  `json:"model,omitempty"`
+	StopReason      string  `json:"stop_reason,omitempty"`
+	TokensEstimated bool    `json:"tokens_estimated,omitempty"`
+	InputEstimated  bool    `json:"input_estimated,omitempty"`
+	OutputEstimated bool    `json:"output_estimated,omitempty"`
+	CostUSD         float64 `json:"cost_usd,omitempty"`
 }
 
 // Council represents a multi-agent council session.
@@ -242,8 +997,13 @@ type Council struct {
 	ProjectInstructions string              `json:"project_instructions,omitempty"`
 	Members             []Agent             `json:"members"`
 	Chairman            Agent               `json:"chairman"`
+	ParentID            string              `json:"parent_id,omitempty"`   // Council this one was forked from
+	ForkPoint           int                 `json:"fork_point,omitempty"`  // Last parent round copied into the fork
+	ScheduleID          string              `json:"schedule_id,omitempty"` // Schedule that started this council
 	Status              DebateStatus        `json:"status"`
 	Syntheses           []*CouncilSynthesis `json:"syntheses,omitempty"`
+	Budget              *Budget             `json:"budget,omitempty"`
+	StopReason          string              `json:"stop_reason,omitempty"` // Why the last run ended early (e.g. a budget was reached)
 	CreatedAt           time.Time           `json:"created_at"`
 	UpdatedAt           time.Time           `json:"updated_at"`
 	CompletedAt         *time.Time          `json:"completed_at,omitempty"`
@@ -256,6 +1016,7 @@ const (
 	ResponseTypeResponse  ResponseType = "response"  // Stage 1: Member response
 	ResponseTypeRanking   ResponseType = "ranking"   // Stage 2: Ranking
 	ResponseTypeSynthesis ResponseType = "synthesis" // Stage 3: Chairman synthesis
+	ResponseTypeAnswer    ResponseType = "answer"    // Chairman's answer to a question about a completed council
 )
 
 // Response represents a council member's response in Stage 1.
@@ -268,13 +1029,76 @@ type Response struct {
 	CreatedAt time.Time `json:"created_at"`
 
 	// Metadata from provider response
-	ResponseType ResponseType `json:"response_type,omitempty"` // Type of response for tracking
-	InputTokens  int          `json:"input_tokens,omitempty"`
-	OutputTokens int          `json:"output_tokens,omitempty"`
-	TotalTokens  int          `json:"total_tokens,omitempty"`
-	DurationMs   int64        `json:"duration_ms,omitempty"`
-	Model        string       `json:"model,omitempty"`
-	StopReason   string       `json:"stop_reason,omitempty"`
+	ResponseType    ResponseType `json:"response_type,omitempty"` // Type of response for tracking
+	InputTokens     int          `json:"input_tokens,omitempty"`
+	OutputTokens    int          `json:"output_tokens,omitempty"`
+	TotalTokens     int          `json:"total_tokens,omitempty"`
+	DurationMs      int64        `json:"duration_ms,omitempty"`
+	Model           string       `json:"model,omitempty"`
+	StopReason      string       `json:"stop_reason,omitempty"`
+	TokensEstimated bool         `json:"tokens_estimated,omitempty"`
+	InputEstimated  bool         `json:"input_estimated,omitempty"`
+	OutputEstimated bool         `json:"output_estimated,omitempty"`
+	CostUSD         float64      `json:"cost_usd,omitempty"`
+
+	// Regenerated versions; the fields above hold the selected one
+	Versions        []*Version `json:"versions,omitempty"`
+	SelectedVersion int        `json:"selected_version,omitempty"`
+
+	References []*CodeReference `json:"references,omitempty"` // Code the response cites, checked against the workspace
+	Targeting  *Targeting       `json:"targeting,omitempty"`  // Set on user follow-ups addressed to some members only
+	Question   string           `json:"question,omitempty"`   // Set on answers: what the user asked the chairman
+}
+
+// CurrentVersion returns the response's content and metadata as a version.
+func (r *Response) CurrentVersion() *Version {
+	return &Version{
+		Content:         r.Content,
+		CreatedAt:       r.CreatedAt,
+		InputTokens:     r.InputTokens,
+		OutputTokens:    r.OutputTokens,
+		TotalTokens:     r.TotalTokens,
+		DurationMs:      r.DurationMs,
+		Model:           r.Model,
+		StopReason:      r.StopReason,
+		TokensEstimated: r.TokensEstimated,
+		InputEstimated:  r.InputEstimated,
+		OutputEstimated: r.OutputEstimated,
+		CostUSD:         r.CostUSD,
+		References:      r.References,
+	}
+}
+
+// PushVersion records a regenerated version of the response and selects it.
+// The response's current content becomes version 0 the first time.
+func (r *Response) PushVersion(v *Version) {
+	if len(r.Versions) == 0 {
+		r.Versions = []*Version{r.CurrentVersion()}
+	}
+	r.Versions = append(r.Versions, v)
+	r.SelectVersion(len(r.Versions) - 1)
+}
+
+// SelectVersion makes version i the response's content.
+func (r *Response) SelectVersion(i int) error {
+	if i < 0 || i >= len(r.Versions) {
+		return fmt.Errorf("version %d not found (response has %d)", i, len(r.Versions))
+	}
+	v := r.Versions[i]
+	r.Content = v.Content
+	r.InputTokens = v.InputTokens
+	r.OutputTokens = v.OutputTokens
+	r.TotalTokens = v.TotalTokens
+	r.DurationMs = v.DurationMs
+	r.Model = v.Model
+	r.StopReason = v.StopReason
+	r.TokensEstimated = v.TokensEstimated
+	r.InputEstimated = v.InputEstimated
+	r.OutputEstimated = v.OutputEstimated
+	r.CostUSD = v.CostUSD
+	r.References = v.References
+	r.SelectedVersion = i
+	return nil
 }
 
 // CouncilStats contains aggregated usage statistics for a council session.
@@ -327,12 +1151,16 @@ type Ranking struct {
 	CreatedAt  time.Time `json:"created_at"`
 
 	// Metadata from provider response
-	InputTokens  int    `json:"input_tokens,omitempty"`
-	OutputTokens int    `json:"output_tokens,omitempty"`
-	TotalTokens  int    `json:"total_tokens,omitempty"`
-	DurationMs   int64  `json:"duration_ms,omitempty"`
-	Model        string `json:"model,omitempty"`
-	StopReason   string `json:"stop_reason,omitempty"`
+	InputTokens     int     `json:"input_tokens,omitempty"`
+	OutputTokens    int     `json:"output_tokens,omitempty"`
+	TotalTokens     int     `json:"total_tokens,omitempty"`
+	DurationMs      int64   `json:"duration_ms,omitempty"`
+	Model           string  `json:"model,omitempty"`
+	StopReason      string  `json:"stop_reason,omitempty"`
+	TokensEstimated bool    `json:"tokens_estimated,omitempty"`
+	InputEstimated  bool    `json:"input_estimated,omitempty"`
+	OutputEstimated bool    `json:"output_estimated,omitempty"`
+	CostUSD         float64 `json:"cost_usd,omitempty"`
 }
 
 // CouncilSummary is a lightweight representation for listing councils.
@@ -343,6 +1171,7 @@ type CouncilSummary struct {
 	CWD         string       `json:"cwd"`
 	WorkspaceID string       `json:"workspace_id,omitempty"`
 	ProjectID   string       `json:"project_id,omitempty"`
+	ScheduleID  string       `json:"schedule_id,omitempty"`
 	Status      DebateStatus `json:"status"`
 	MemberCount int          `json:"member_count"`
 	CreatedAt   time.Time    `json:"created_at"`
@@ -355,6 +1184,115 @@ type NewCouncilConfig struct {
 	ProjectID   string
 	Members     []MemberSpec
 	Chairman    *MemberSpec // Optional, defaults to first member's provider with best model
+	Budget      *Budget     // Optional, unset limits fall back to the project's
+	ScheduleID  string      // Set on councils started by a schedule
+}
+
+// Budget limits what a debate or council may spend. Zero limits are
+// unlimited. Tokens and cost count every turn or response of the session,
+// including regenerated versions; the time limit applies to each run, so
+// resuming a session restarts its clock. Engines check the budget between
+// turns and stages and end the run early once a limit is reached.
+type Budget struct {
+	MaxTokens     int     `json:"max_tokens,omitempty"`
+	MaxCostUSD    float64 `json:"max_cost_usd,omitempty"`    // Only providers that report cost count toward it
+	MaxDurationMs int64   `json:"max_duration_ms,omitempty"` // Wall-clock time of a run
+}
+
+// IsZero reports whether the budget sets no limits.
+func (b *Budget) IsZero() bool {
+	return b == nil || (b.MaxTokens <= 0 && b.MaxCostUSD <= 0 && b.MaxDurationMs <= 0)
+}
+
+// WithDefaults returns the budget with unset limits taken from defaults,
+// or nil if neither sets any.
+func (b *Budget) WithDefaults(defaults *Budget) *Budget {
+	var merged Budget
+	if b != nil {
+		merged = *b
+	}
+	if defaults != nil {
+		if merged.MaxTokens <= 0 {
+			merged.MaxTokens = defaults.MaxTokens
+		}
+		if merged.MaxCostUSD <= 0 {
+			merged.MaxCostUSD = defaults.MaxCostUSD
+		}
+		if merged.MaxDurationMs <= 0 {
+			merged.MaxDurationMs = defaults.MaxDurationMs
+		}
+	}
+	if merged.IsZero() {
+		return nil
+	}
+	return &merged
+}
+
+// Exceeded returns which limit usage has reached, or "" if it is within budget.
+func (b *Budget) Exceeded(u Usage) string {
+	if b == nil {
+		return ""
+	}
+	if b.MaxTokens > 0 && u.Tokens >= b.MaxTokens {
+		return fmt.Sprintf("token budget of %d reached (%d used)", b.MaxTokens, u.Tokens)
+	}
+	if b.MaxCostUSD > 0 && u.CostUSD >= b.MaxCostUSD {
+		return fmt.Sprintf("cost budget of $%.2f reached ($%.2f spent)", b.MaxCostUSD, u.CostUSD)
+	}
+	if limit := time.Duration(b.MaxDurationMs) * time.Millisecond; limit > 0 && u.Elapsed >= limit {
+		return fmt.Sprintf("time budget of %s reached (%s elapsed)", limit, u.Elapsed.Round(time.Second))
+	}
+	return ""
+}
+
+// String lists the budget's limits, e.g. "50000 tokens, $1.50, 10m0s".
+func (b *Budget) String() string {
+	if b.IsZero() {
+		return "none"
+	}
+	var limits []string
+	if b.MaxTokens > 0 {
+		limits = append(limits, fmt.Sprintf("%d tokens", b.MaxTokens))
+	}
+	if b.MaxCostUSD > 0 {
+		limits = append(limits, fmt.Sprintf("$%.2f", b.MaxCostUSD))
+	}
+	if b.MaxDurationMs > 0 {
+		limits = append(limits, (time.Duration(b.MaxDurationMs) * time.Millisecond).String())
+	}
+	return strings.Join(limits, ", ")
+}
+
+// Usage is what a session has spent, checked against its Budget.
+type Usage struct {
+	Tokens  int
+	CostUSD float64
+	Elapsed time.Duration
+}
+
+// AddTurn counts a turn's tokens and cost, including discarded versions.
+func (u *Usage) AddTurn(t *Turn) {
+	if !u.addVersions(t.Versions) {
+		u.Tokens += t.TotalTokens
+		u.CostUSD += t.CostUSD
+	}
+}
+
+// AddResponse counts a council response's tokens and cost, including
+// discarded versions.
+func (u *Usage) AddResponse(r *Response) {
+	if !u.addVersions(r.Versions) {
+		u.Tokens += r.TotalTokens
+		u.CostUSD += r.CostUSD
+	}
+}
+
+func (u *Usage) addVersions(versions []*Version) bool {
+	for _, v := range versions {
+		u.Tokens += v.TotalTokens
+		u.CostUSD += v.CostUSD
+	}
+	return len(versions) > 0
 }
 
 // Project represents a top-level workspace for chats.
@@ -363,15 +1301,402 @@ type Project struct {
 	Name         string    `json:"name"`
 	Description  string    `json:"description"`
 	Instructions string    `json:"instructions"`
+	Budget       *Budget   `json:"budget,omitempty"` // Default budget for the project's debates and councils
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
 }
 
+// CatchUpPolicy decides what a schedule does about a run it missed while
+// the server was down.
+type CatchUpPolicy string
+
+const (
+	CatchUpOnce CatchUpPolicy = "once" // Run once as soon as possible, however many runs were missed
+	CatchUpSkip CatchUpPolicy = "skip" // Wait for the next scheduled time
+)
+
+// Schedule starts a council on a cron schedule while `conclave serve` runs.
+// Each council it starts records the schedule's ID.
+type Schedule struct {
+	ID            string        `json:"id"`
+	Name          string        `json:"name"`
+	Cron          string        `json:"cron"` // Five fields (minute hour day month weekday) or a macro like @weekly, in server local time
+	Topic         string        `json:"topic"`
+	Members       []MemberSpec  `json:"members"`
+	Chairman      *MemberSpec   `json:"chairman,omitempty"`
+	WorkspaceID   string        `json:"workspace_id,omitempty"`
+	ProjectID     string        `json:"project_id,omitempty"`
+	Budget        *Budget       `json:"budget,omitempty"`
+	CatchUp       CatchUpPolicy `json:"catch_up"`
+	Paused        bool          `json:"paused"`
+	LastRunAt     *time.Time    `json:"last_run_at,omitempty"`
+	NextRunAt     *time.Time    `json:"next_run_at,omitempty"`
+	LastCouncilID string        `json:"last_council_id,omitempty"`
+	LastError     string        `json:"last_error,omitempty"` // Why the last run could not start
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+}
+
+// PresetMode is the kind of session a preset starts from `conclave new`.
+type PresetMode string
+
+const (
+	PresetCouncil PresetMode = "council"
+	PresetDebate  PresetMode = "debate"
+)
+
+// Preset is a named set of session settings. Settings a new debate or
+// council leaves unset are taken from the preset it names.
+type Preset struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"` // Unique
+	Description string       `json:"description,omitempty"`
+	Mode        PresetMode   `json:"mode"`
+	Members     []MemberSpec `json:"members,omitempty"` // Council members or debate agents
+	Chairman    *MemberSpec  `json:"chairman,omitempty"`
+	Style       string       `json:"style,omitempty"`     // Debates only
+	MaxTurns    int          `json:"max_turns,omitempty"` // Debates only
+	ProjectID   string       `json:"project_id,omitempty"`
+	Budget      *Budget      `json:"budget,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// Validate checks a preset's name and mode, defaulting the mode to council.
+func (p *Preset) Validate() error {
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Name == "" {
+		return fmt.Errorf("preset name is required")
+	}
+	switch p.Mode {
+	case "":
+		p.Mode = PresetCouncil
+	case PresetCouncil, PresetDebate:
+	default:
+		return fmt.Errorf("invalid preset mode: %s (expected council or debate)", p.Mode)
+	}
+	if p.MaxTurns < 0 {
+		return fmt.Errorf("max turns must be positive")
+	}
+	return nil
+}
+
+// ApplyToCouncil fills the settings config leaves unset from the preset.
+func (p *Preset) ApplyToCouncil(config *NewCouncilConfig) {
+	if len(config.Members) == 0 {
+		config.Members = append([]MemberSpec(nil), p.Members...)
+	}
+	if config.Chairman == nil && p.Chairman != nil {
+		chairman := *p.Chairman
+		config.Chairman = &chairman
+	}
+	if config.ProjectID == "" {
+		config.ProjectID = p.ProjectID
+	}
+	config.Budget = config.Budget.WithDefaults(p.Budget)
+}
+
+// ApplyToDebate fills the settings config leaves unset from the preset. The
+// preset's members become the agents unless config names any.
+func (p *Preset) ApplyToDebate(config *NewDebateConfig) {
+	if len(config.Agents) == 0 && config.AgentAProvider == "" && config.AgentBProvider == "" {
+		config.Agents = append([]MemberSpec(nil), p.Members...)
+	}
+	if config.Style == "" {
+		config.Style = p.Style
+	}
+	if config.MaxTurns <= 0 {
+		config.MaxTurns = p.MaxTurns
+	}
+	if config.ProjectID == "" {
+		config.ProjectID = p.ProjectID
+	}
+	config.Budget = config.Budget.WithDefaults(p.Budget)
+}
+
 // MemberSpec specifies a council member: provider[/model][:persona]
 type MemberSpec struct {
-	Provider string
-	Model    string // Optional, defaults to provider's default
-	Persona  string // Optional, auto-assigned if empty
+	Provider string `json:"provider"`
+	Model    string `json:"model,omitempty"`   // Optional, defaults to provider's default
+	Persona  string `json:"persona,omitempty"` // Optional, auto-assigned if empty
+	Stance   string `json:"stance,omitempty"`  // Debates only: FOR, AGAINST or a free-text position
+}
+
+// String formats the spec as provider[/model][:persona], the form
+// ParseMemberSpec reads. The stance is not included.
+func (m MemberSpec) String() string {
+	s := m.Provider
+	if m.Model != "" {
+		s += "/" + m.Model
+	}
+	if m.Persona != "" {
+		s += ":" + m.Persona
+	}
+	return s
+}
+
+// Phase is one stage of a debate round in a multi-phase style, such as
+// opening statements or cross-examination. Phases run in order.
+type Phase struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Turns each agent takes in the phase. A phase with 0 turns is flexible:
+	// flexible phases share the debate's turns per agent that the fixed
+	// phases leave over.
+	Turns  int    `json:"turns,omitempty"`
+	Prompt string `json:"prompt,omitempty"` // Template for the phase's turns
+	// Roles are assigned by speaking position: the round's first speaker
+	// plays the first role, the second speaker the second, wrapping around.
+	Roles []PhaseRole `json:"roles,omitempty"`
+}
+
+// PhaseRole is a part an agent plays during a phase, e.g. questioner.
+type PhaseRole struct {
+	Name   string `json:"name"`
+	Prompt string `json:"prompt,omitempty"` // Replaces the phase's prompt for this role
+}
+
+// RoleAt returns the role for the agent at a 0-based speaking position, or
+// nil if the phase has no roles.
+func (p *Phase) RoleAt(position int) *PhaseRole {
+	if len(p.Roles) == 0 || position < 0 {
+		return nil
+	}
+	return &p.Roles[position%len(p.Roles)]
+}
+
+// ForkConfig describes a fork of a debate or council: a new session that
+// copies the parent up to a turn (debates) or round (councils) and continues
+// from there.
+type ForkConfig struct {
+	At      int         `json:"at"`                // Last turn number or round to copy; 0 copies everything
+	Content string      `json:"content,omitempty"` // Debates only: replaces the content of turn At
+	Style   string      `json:"style,omitempty"`   // Debates only: switches the debate style
+	Swaps   []AgentSwap `json:"swaps,omitempty"`
+}
+
+// AgentSwap replaces the provider, model or persona of one seat in a fork.
+// Empty fields keep the parent's value.
+type AgentSwap struct {
+	Seat     int    `json:"seat"` // 0-based index into the participants or members
+	Provider string `json:"provider,omitempty"`
+	Model    string `json:"model,omitempty"`
+	Persona  string `json:"persona,omitempty"`
+}
+
+// FollowUp is a user's follow-up to a debate or council. By default every
+// participant answers it; Targets, or @mentions in the content when Targets
+// is empty, address it to some participants only.
+type FollowUp struct {
+	Content    string   `json:"content"`
+	Targets    []string `json:"targets,omitempty"`    // Participant IDs, names, masked names or personas
+	Synthesize bool     `json:"synthesize,omitempty"` // Councils only: the chairman updates the synthesis with a targeted follow-up's answers
+}
+
+// Targeting records who a targeted follow-up addresses.
+type Targeting struct {
+	AgentIDs   []string `json:"agent_ids"`
+	Synthesize bool     `json:"synthesize,omitempty"`
+}
+
+// Addresses reports whether the follow-up asks agentID to answer.
+func (t *Targeting) Addresses(agentID string) bool {
+	if t == nil {
+		return true
+	}
+	for _, id := range t.AgentIDs {
+		if id == agentID {
+			return true
+		}
+	}
+	return false
+}
+
+// mention matches an @mention such as "@Skeptic" or "@AgentA".
+var mention = regexp.MustCompile(`(?:^|[^\w@.])@([A-Za-z][\w-]*)`)
+
+// mentionKey normalizes a name for matching: lower case, without spaces,
+// hyphens or underscores, so "@agent_a" matches "Agent A".
+func mentionKey(name string) string {
+	return strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.ToLower(strings.TrimSpace(name)))
+}
+
+// matchAgents returns the IDs of the agents name refers to by ID, name,
+// masked name, persona or provider.
+func matchAgents(agents []Agent, name string) []string {
+	key := mentionKey(strings.TrimPrefix(strings.TrimSpace(name), "@"))
+	var ids []string
+	for _, a := range agents {
+		for _, candidate := range []string{a.ID, a.Name, a.MaskedName, a.Persona, a.Provider} {
+			if candidate != "" && mentionKey(candidate) == key {
+				ids = append(ids, a.ID)
+				break
+			}
+		}
+	}
+	return ids
+}
+
+// ResolveTargets returns the IDs of the agents a follow-up addresses, in
+// declaration order, or nil when it addresses everyone. Explicit targets
+// must each match an agent; otherwise the content's @mentions that match
+// one are used.
+func ResolveTargets(agents []Agent, f FollowUp) ([]string, error) {
+	matched := make(map[string]bool)
+	if len(f.Targets) > 0 {
+		for _, target := range f.Targets {
+			ids := matchAgents(agents, target)
+			if len(ids) == 0 {
+				return nil, fmt.Errorf("no participant matches %q", target)
+			}
+			for _, id := range ids {
+				matched[id] = true
+			}
+		}
+	} else {
+		for _, m := range mention.FindAllStringSubmatch(f.Content, -1) {
+			for _, id := range matchAgents(agents, m[1]) {
+				matched[id] = true
+			}
+		}
+	}
+	if len(matched) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(matched))
+	for _, a := range agents {
+		if matched[a.ID] {
+			ids = append(ids, a.ID)
+		}
+	}
+	return ids, nil
+}
+
+// ForkNode is a session in a fork tree.
+type ForkNode struct {
+	ID        string       `json:"id"`
+	Title     string       `json:"title"`
+	Status    DebateStatus `json:"status"`
+	ForkPoint int          `json:"fork_point,omitempty"`
+	CreatedAt time.Time    `json:"created_at"`
+	Forks     []*ForkNode  `json:"forks,omitempty"`
+}
+
+// ExpandForks fills in the node's forks recursively. list returns the direct
+// forks of a session.
+func (n *ForkNode) ExpandForks(list func(parentID string) ([]*ForkNode, error)) error {
+	forks, err := list(n.ID)
+	if err != nil {
+		return err
+	}
+	for _, f := range forks {
+		if err := f.ExpandForks(list); err != nil {
+			return err
+		}
+	}
+	n.Forks = forks
+	return nil
+}
+
+// SideDependence is what a swap-sides rerun says decided a debate's outcome.
+type SideDependence string
+
+const (
+	DependsOnSide         SideDependence = "side"         // The same stance won with either provider
+	DependsOnProvider     SideDependence = "provider"     // The same agent (provider, model and persona) won with either stance
+	DependsOnNeither      SideDependence = "neither"      // Both runs ended in the same non-winner outcome
+	DependsOnInconclusive SideDependence = "inconclusive" // The runs can't be compared (no judge, unfinished, mixed outcomes)
+)
+
+// SideResult is the outcome of one run of a swap-sides comparison.
+type SideResult struct {
+	DebateID     string         `json:"debate_id"`
+	Status       DebateStatus   `json:"status"`
+	Agreed       bool           `json:"agreed"`
+	Outcome      VerdictOutcome `json:"outcome,omitempty"` // Set when the debate has a judge
+	Winner       string         `json:"winner,omitempty"`  // Winning agent's name and stance when Outcome is VerdictWinner
+	WinnerStance string         `json:"winner_stance,omitempty"`
+	WinnerSeat   int            `json:"-"`
+}
+
+// SideComparison compares a debate with its swap-sides rerun.
+type SideComparison struct {
+	Original  *SideResult    `json:"original"`
+	Swapped   *SideResult    `json:"swapped"`
+	DependsOn SideDependence `json:"depends_on"`
+}
+
+// TournamentFormat is how a tournament pairs its entrants.
+type TournamentFormat string
+
+const (
+	TournamentRoundRobin TournamentFormat = "round_robin" // Every pair of entrants debates every topic (default)
+	TournamentBracket    TournamentFormat = "bracket"     // Single elimination, seeded by rating
+)
+
+// DefaultRating is the Elo rating of an entrant that has not played a rated match.
+const DefaultRating = 1500.0
+
+// Tournament runs judged two-agent debates between combinations of
+// provider, model and persona. Each verdict updates the entrants' Ratings.
+type Tournament struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Topics      []string           `json:"topics"`
+	Entrants    []MemberSpec       `json:"entrants"`
+	Judge       MemberSpec         `json:"judge"`
+	Format      TournamentFormat   `json:"format"`
+	Style       string             `json:"style"`
+	MaxTurns    int                `json:"max_turns"`
+	Workers     int                `json:"workers"` // Debates run at once
+	Status      DebateStatus       `json:"status"`
+	Matches     []*TournamentMatch `json:"matches,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+	CompletedAt *time.Time         `json:"completed_at,omitempty"`
+}
+
+// TournamentMatch is one judged debate between two entrants. Entrants are
+// identified by MemberSpec.String(), which is also their leaderboard key.
+type TournamentMatch struct {
+	ID           string         `json:"id"`
+	TournamentID string         `json:"tournament_id"`
+	Round        int            `json:"round"` // Bracket round; always 1 in a round robin
+	Topic        string         `json:"topic"`
+	EntrantA     string         `json:"entrant_a"`
+	EntrantB     string         `json:"entrant_b"`
+	DebateID     string         `json:"debate_id,omitempty"`
+	Status       DebateStatus   `json:"status"`
+	Outcome      VerdictOutcome `json:"outcome,omitempty"`
+	Winner       string         `json:"winner,omitempty"` // Set when Outcome is VerdictWinner
+	Error        string         `json:"error,omitempty"`
+	CreatedAt    time.Time      `json:"created_at"`
+	CompletedAt  *time.Time     `json:"completed_at,omitempty"`
+}
+
+// Rating is an entrant's place on the leaderboard. It carries over between
+// tournaments, so ratings reflect every judged match an entrant has played.
+type Rating struct {
+	Entrant   string    `json:"entrant"`
+	Rating    float64   `json:"rating"`
+	Matches   int       `json:"matches"`
+	Wins      int       `json:"wins"`
+	Losses    int       `json:"losses"`
+	Draws     int       `json:"draws"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewTournamentConfig holds configuration for creating a new tournament.
+type NewTournamentConfig struct {
+	Name     string           `json:"name"`
+	Topics   []string         `json:"topics"`
+	Entrants []MemberSpec     `json:"entrants"`
+	Judge    MemberSpec       `json:"judge"`
+	Format   TournamentFormat `json:"format,omitempty"`
+	Style    string           `json:"style,omitempty"`     // Defaults to adversarial
+	MaxTurns int              `json:"max_turns,omitempty"` // Turns per agent, defaults to 3
+	Workers  int              `json:"workers,omitempty"`   // Debates run at once, defaults to 2
 }
 
 // AggregateRanking holds the aggregated ranking data for a response.
@@ -0,0 +1,173 @@
+package council
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/coderef"
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// Errors returned by AskChairman for a council that cannot take questions.
+var (
+	ErrNotCompleted  = errors.New("council is not completed")
+	ErrBudgetReached = errors.New("council budget reached")
+)
+
+// AskChairman answers a clarifying question about a completed council
+// without rerunning its members: the chairman alone answers from the
+// stored responses, rankings, syntheses and earlier answers. The answer is
+// saved as an answer response in the latest round, which the council's
+// stages ignore.
+func (e *Engine) AskChairman(ctx context.Context, councilID, question string) (*core.Response, error) {
+	question = strings.TrimSpace(question)
+	if question == "" {
+		return nil, fmt.Errorf("question is required")
+	}
+	council, err := e.controlledCouncil(councilID)
+	if err != nil {
+		return nil, err
+	}
+	if council.Status != core.StatusCompleted {
+		return nil, fmt.Errorf("%w (status: %s)", ErrNotCompleted, council.Status)
+	}
+	if reason := e.overBudget(council, time.Now()); reason != "" {
+		return nil, fmt.Errorf("%w: %s", ErrBudgetReached, reason)
+	}
+	e.ensureMaskedNames(council)
+
+	var answer *core.Response
+	err = e.runs.Run(ctx, councilID, func(ctx context.Context) error {
+		responses, err := e.storage.GetResponses(councilID)
+		if err != nil {
+			return err
+		}
+		rankings, err := e.storage.GetRankings(councilID)
+		if err != nil {
+			return err
+		}
+
+		prov, err := e.registry.Get(council.Chairman.Provider)
+		if err != nil {
+			return fmt.Errorf("chairman provider not found: %w", err)
+		}
+		prompt := e.buildAskPrompt(council, responses, rankings, question)
+		provResp, err := prov.GenerateWithResponseDir(ctx, prompt, council.Chairman.Model, council.CWD)
+		if err != nil {
+			return fmt.Errorf("chairman failed to answer: %w", err)
+		}
+
+		round := 1
+		if len(responses) > 0 {
+			round = responses[len(responses)-1].Round
+		}
+		answer = &core.Response{
+			ID:           core.GenerateID(),
+			CouncilID:    council.ID,
+			MemberID:     council.Chairman.ID,
+			Round:        round,
+			Content:      provResp.Content,
+			CreatedAt:    time.Now(),
+			ResponseType: core.ResponseTypeAnswer,
+			Model:        provResp.Model,
+			Question:     question,
+			References:   coderef.Check(council.CWD, provResp.Content),
+		}
+		if provResp.Metadata != nil {
+			answer.InputTokens = provResp.Metadata.InputTokens
+			answer.OutputTokens = provResp.Metadata.OutputTokens
+			answer.TotalTokens = provResp.Metadata.TotalTokens
+			answer.DurationMs = provResp.Metadata.Duration.Milliseconds()
+			answer.StopReason = provResp.Metadata.StopReason
+			answer.TokensEstimated = provResp.Metadata.Estimated
+			answer.InputEstimated = provResp.Metadata.InputEstimated
+			answer.OutputEstimated = provResp.Metadata.OutputEstimated
+			answer.CostUSD = provResp.Metadata.CostUSD
+		}
+		if err := e.storage.AddResponse(answer); err != nil {
+			return fmt.Errorf("failed to save answer: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return answer, nil
+}
+
+// buildAskPrompt gives the chairman the council's record, round by round,
+// and the question to answer.
+func (e *Engine) buildAskPrompt(council *core.Council, responses []*core.Response, rankings []*core.Ranking, question string) string {
+	memberNames := make(map[string]string)
+	for _, m := range council.Members {
+		memberNames[m.ID] = m.MaskedName
+	}
+
+	var record strings.Builder
+	var earlier strings.Builder
+	rounds := 0
+	for _, r := range responses {
+		rounds = max(rounds, r.Round)
+	}
+	for round := 1; round <= rounds; round++ {
+		record.WriteString(fmt.Sprintf("\n--- Round %d ---\n", round))
+
+		var roundResponses []core.Response
+		for _, r := range responses {
+			if r.Round != round {
+				continue
+			}
+			switch {
+			case r.MemberID == "user":
+				record.WriteString(fmt.Sprintf("\n[User Follow-up]\n%s\n", r.Content))
+			case r.ResponseType == core.ResponseTypeAnswer:
+				earlier.WriteString(fmt.Sprintf("\nQ: %s\nA: %s\n", r.Question, r.Content))
+			case r.ResponseType == "" || r.ResponseType == core.ResponseTypeResponse:
+				roundResponses = append(roundResponses, *r)
+				record.WriteString(fmt.Sprintf("\n[%s]\n%s\n", memberNames[r.MemberID], r.Content))
+			}
+		}
+
+		var roundRankings []core.Ranking
+		for _, r := range rankings {
+			if r.Round == round {
+				roundRankings = append(roundRankings, *r)
+			}
+		}
+		if aggregate := e.calculateAggregateRankings(roundResponses, roundRankings, council.Members); len(aggregate) > 0 {
+			record.WriteString("\nAggregate Rankings (by quality):\n")
+			for i, ar := range aggregate {
+				record.WriteString(fmt.Sprintf("%d. %s - Avg rank: %.2f\n", i+1, memberNames[ar.MemberID], ar.AvgRank))
+			}
+		}
+
+		for _, s := range council.Syntheses {
+			if s.Round == round {
+				record.WriteString(fmt.Sprintf("\nYour Conclusion:\n%s\n", s.Content))
+			}
+		}
+	}
+
+	instructionBlock := ""
+	if instructions := formatProjectInstructions(council.ProjectInstructions); instructions != "" {
+		instructionBlock = "\n" + instructions + "\n"
+	}
+	earlierBlock := ""
+	if earlier.Len() > 0 {
+		earlierBlock = "\nQuestions you already answered:\n" + earlier.String()
+	}
+
+	return fmt.Sprintf(`You are the Chairman of a council discussion that has finished. The user has a clarifying question about it. Answer it yourself from the council's record below; the members will not be consulted again.
+
+Topic: %s
+%s
+Council record:
+%s%s
+User's question: "%s"
+
+Answer directly and concisely in Markdown, naming the members whose responses you draw on. If the record does not settle the question, say so.`,
+		council.Topic, instructionBlock, record.String(), earlierBlock, question)
+}
@@ -0,0 +1,72 @@
+package council
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// Usage totals the tokens and cost a council has spent across every round:
+// its responses, rankings and syntheses.
+func (e *Engine) Usage(council *core.Council) core.Usage {
+	var usage core.Usage
+	responses, _ := e.storage.GetResponses(council.ID)
+	for _, r := range responses {
+		usage.AddResponse(r)
+	}
+	rankings, _ := e.storage.GetRankings(council.ID)
+	for _, r := range rankings {
+		usage.Tokens += r.TotalTokens
+		usage.CostUSD += r.CostUSD
+	}
+	for _, s := range council.Syntheses {
+		usage.Tokens += s.TotalTokens
+		usage.CostUSD += s.CostUSD
+	}
+	return usage
+}
+
+// budgetUsage is the council's Usage plus the time since the current run
+// started.
+func (e *Engine) budgetUsage(council *core.Council, started time.Time) core.Usage {
+	usage := e.Usage(council)
+	usage.Elapsed = time.Since(started)
+	return usage
+}
+
+// overBudget reports why the council has to stop, or "" while it is
+// still within its budget.
+func (e *Engine) overBudget(council *core.Council, started time.Time) string {
+	if council.Budget.IsZero() {
+		return ""
+	}
+	return council.Budget.Exceeded(e.budgetUsage(council, started))
+}
+
+// finishOverBudget ends a run that reached its budget. The council
+// completes with a placeholder synthesis recording why it stopped instead
+// of asking the chairman.
+func (e *Engine) finishOverBudget(council *core.Council, reason string, round int, callbacks *CouncilCallbacks) error {
+	slog.Info("Council reached its budget", "council_id", council.ID, "reason", reason)
+
+	synthesis := &core.CouncilSynthesis{
+		Round:      round,
+		Content:    fmt.Sprintf("Stopped early: %s. Review the responses above.", reason),
+		CreatedAt:  time.Now(),
+		Model:      "system",
+		StopReason: "budget",
+	}
+	if callbacks != nil && callbacks.OnSynthesisComplete != nil {
+		callbacks.OnSynthesisComplete(*synthesis)
+	}
+
+	council.Syntheses = append(council.Syntheses, synthesis)
+	council.StopReason = reason
+	council.Status = core.StatusCompleted
+	if err := e.storage.UpdateCouncil(council); err != nil {
+		return fmt.Errorf("failed to update council: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package council
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/alienxp03/conclave/internal/core"
+	"github.com/alienxp03/conclave/internal/run"
+)
+
+// StartCouncil runs a council in the background, detached from the caller's
+// context. It returns run.ErrAlreadyRunning if the council is running here.
+func (e *Engine) StartCouncil(council *core.Council) error {
+	return e.runs.Start(council.ID, func(ctx context.Context) error {
+		return e.runCouncil(ctx, council, nil)
+	})
+}
+
+// PauseCouncil stops a running council; ResumeCouncil continues it from its
+// first unfinished stage. Councils running in another process (such as the
+// CLI) are asked to pause before their next stage.
+func (e *Engine) PauseCouncil(id string) error {
+	council, err := e.controlledCouncil(id)
+	if err != nil {
+		return err
+	}
+	if council.Status != core.StatusInProgress {
+		return fmt.Errorf("council is not running (status: %s)", council.Status)
+	}
+
+	if e.runs.Pause(id) {
+		return nil
+	}
+	return e.storage.RequestStop(id, core.StatusPaused)
+}
+
+// ResumeCouncil continues a paused or interrupted council in the background.
+func (e *Engine) ResumeCouncil(id string) error {
+	council, err := e.controlledCouncil(id)
+	if err != nil {
+		return err
+	}
+	if !council.Status.Resumable() {
+		return fmt.Errorf("council is not paused (status: %s)", council.Status)
+	}
+
+	if err := e.storage.ClearStopRequest(id); err != nil {
+		return err
+	}
+	return e.StartCouncil(council)
+}
+
+// CancelCouncil stops a council for good. Councils that are not running
+// are marked cancelled immediately.
+func (e *Engine) CancelCouncil(id string) error {
+	council, err := e.controlledCouncil(id)
+	if err != nil {
+		return err
+	}
+
+	switch council.Status {
+	case core.StatusCompleted, core.StatusCancelled:
+		return fmt.Errorf("council is already %s", council.Status)
+	case core.StatusInProgress:
+		if e.runs.Cancel(id) {
+			return nil
+		}
+		return e.storage.RequestStop(id, core.StatusCancelled)
+	}
+
+	council.Status = core.StatusCancelled
+	return e.storage.UpdateCouncil(council)
+}
+
+// WaitCouncil blocks until the council's background run in this process ends.
+func (e *Engine) WaitCouncil(ctx context.Context, id string) error {
+	return e.runs.Wait(ctx, id)
+}
+
+// IsRunning reports whether a council is running in this process.
+func (e *Engine) IsRunning(id string) bool {
+	return e.runs.Running(id)
+}
+
+// controlledCouncil loads a council that is about to be paused, resumed, or cancelled.
+func (e *Engine) controlledCouncil(id string) (*core.Council, error) {
+	council, err := e.storage.GetCouncil(id)
+	if err != nil {
+		return nil, err
+	}
+	if council == nil {
+		return nil, fmt.Errorf("council not found: %s", id)
+	}
+	return council, nil
+}
+
+// roundProgress is the stored output of the current round's finished stages.
+type roundProgress struct {
+	round     int
+	followUp  *core.Response  // The user follow-up that opened the round, if any
+	responses []core.Response // Stage 1, empty until every response was saved
+	rankings  []core.Ranking  // Stage 2
+}
+
+// targeting returns the round's follow-up targeting, or nil if every member
+// answers.
+func (p roundProgress) targeting() *core.Targeting {
+	if p.followUp == nil {
+		return nil
+	}
+	return p.followUp.Targeting
+}
+
+// roundProgress loads what the current round has already produced so a
+// resumed council skips finished stages. A round that already has a
+// synthesis starts over, as it did before stages were resumable.
+func (e *Engine) roundProgress(council *core.Council) roundProgress {
+	responses, _ := e.storage.GetResponses(council.ID)
+	p := roundProgress{round: 1}
+	if len(responses) > 0 {
+		p.round = responses[len(responses)-1].Round
+	}
+	for _, r := range responses {
+		if r.Round == p.round && r.MemberID == "user" {
+			p.followUp = r
+			break
+		}
+	}
+
+	for _, s := range council.Syntheses {
+		if s.Round == p.round {
+			return p
+		}
+	}
+
+	for _, r := range responses {
+		if r.Round != p.round || r.MemberID == "user" {
+			continue
+		}
+		if r.ResponseType != "" && r.ResponseType != core.ResponseTypeResponse {
+			continue
+		}
+		p.responses = append(p.responses, *r)
+	}
+	if len(p.responses) == 0 {
+		return p
+	}
+
+	rankings, _ := e.storage.GetRankings(council.ID)
+	for _, r := range rankings {
+		if r.Round == p.round {
+			p.rankings = append(p.rankings, *r)
+		}
+	}
+	return p
+}
+
+// checkStop stops the council between stages if its run was paused or
+// cancelled, here or from another process.
+func (e *Engine) checkStop(ctx context.Context, council *core.Council) error {
+	if ctx.Err() != nil {
+		return e.stopCouncil(council, run.StopStatus(ctx), context.Cause(ctx))
+	}
+
+	status, err := e.storage.TakeStopRequest(council.ID)
+	if err != nil {
+		slog.Warn("Failed to check stop request", "council_id", council.ID, "error", err)
+		return nil
+	}
+	if status != "" {
+		return e.stopCouncil(council, status, run.StatusError(status))
+	}
+	return nil
+}
+
+// stopCouncil leaves an interrupted council paused, cancelled, or failed.
+func (e *Engine) stopCouncil(council *core.Council, status core.DebateStatus, cause error) error {
+	council.Status = status
+	if err := e.storage.UpdateCouncil(council); err != nil {
+		slog.Error("Failed to save stopped council", "council_id", council.ID, "error", err)
+	}
+	slog.Info("Council stopped", "council_id", council.ID, "status", status)
+	return cause
+}
@@ -3,32 +3,39 @@ package council
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"sort"
 	"strings"
-	"sync"
 	"time"
 
+	"github.com/alienxp03/conclave/internal/coderef"
 	"github.com/alienxp03/conclave/internal/core"
 	"github.com/alienxp03/conclave/internal/persona"
 	"github.com/alienxp03/conclave/internal/provider"
+	"github.com/alienxp03/conclave/internal/run"
 	"github.com/alienxp03/conclave/internal/storage"
+	"github.com/alienxp03/conclave/internal/workspace"
 )
 
 // Engine orchestrates council sessions.
 type Engine struct {
-	storage  storage.Storage
-	registry *provider.Registry
-	running  sync.Map // councilID -> bool
+	storage    storage.Storage
+	registry   *provider.Registry
+	workspaces *workspace.Manager
+	runs       *run.Manager
 }
 
-// New creates a new council engine.
-func New(store storage.Storage, registry *provider.Registry) *Engine {
+// New creates a new council engine. workspaces may be nil, in which case
+// councils run in the current directory.
+func New(store storage.Storage, registry *provider.Registry, workspaces *workspace.Manager) *Engine {
 	return &Engine{
-		storage:  store,
-		registry: registry,
+		storage:    store,
+		registry:   registry,
+		workspaces: workspaces,
+		runs:       run.NewManager(store),
 	}
 }
 
@@ -117,7 +124,22 @@ func (e *Engine) CreateCouncil(ctx context.Context, config core.NewCouncilConfig
 	// Create council
 	now := time.Now()
 	cwd, _ := os.Getwd()
+
+	// Resolve workspace if specified
+	if config.WorkspaceID != "" {
+		if e.workspaces != nil {
+			path, err := e.workspaces.ResolvePath(config.WorkspaceID)
+			if err != nil {
+				return nil, fmt.Errorf("invalid workspace ID: %w", err)
+			}
+			cwd = path
+		} else {
+			slog.Warn("Workspace ID provided but workspace manager is not initialized")
+		}
+	}
+
 	var projectInstructions string
+	budget := config.Budget.WithDefaults(nil)
 	if config.ProjectID != "" {
 		project, err := e.storage.GetProject(config.ProjectID)
 		if err != nil {
@@ -127,15 +149,19 @@ func (e *Engine) CreateCouncil(ctx context.Context, config core.NewCouncilConfig
 			return nil, fmt.Errorf("project not found")
 		}
 		projectInstructions = project.Instructions
+		budget = budget.WithDefaults(project.Budget)
 	}
 	council := &core.Council{
 		ID:                  core.GenerateID(),
 		Topic:               config.Topic,
 		CWD:                 cwd,
+		WorkspaceID:         config.WorkspaceID,
 		ProjectID:           config.ProjectID,
 		ProjectInstructions: projectInstructions,
 		Members:             agents,
 		Chairman:            chairman,
+		Budget:              budget,
+		ScheduleID:          config.ScheduleID,
 		Status:              core.StatusPending,
 		CreatedAt:           now,
 		UpdatedAt:           now,
@@ -211,19 +237,24 @@ func (e *Engine) RunCouncil(ctx context.Context, council *core.Council) error {
 }
 
 // RunCouncilWithCallbacks executes all 3 stages with progress callbacks.
+// A paused or interrupted council continues from its first unfinished stage.
 func (e *Engine) RunCouncilWithCallbacks(ctx context.Context, council *core.Council, callbacks *CouncilCallbacks) error {
+	err := e.runs.Run(ctx, council.ID, func(ctx context.Context) error {
+		return e.runCouncil(ctx, council, callbacks)
+	})
+	if errors.Is(err, run.ErrAlreadyRunning) {
+		slog.Warn("Council already running, skipping duplicate execution", "council_id", council.ID)
+		return nil
+	}
+	return err
+}
+
+func (e *Engine) runCouncil(ctx context.Context, council *core.Council, callbacks *CouncilCallbacks) error {
 	slog.Info("Starting council execution", "council_id", council.ID, "topic", council.Topic)
 
 	// Ensure masked names for backward compatibility
 	e.ensureMaskedNames(council)
 
-	// Check if already running
-	if _, loaded := e.running.LoadOrStore(council.ID, true); loaded {
-		slog.Warn("Council already running, skipping duplicate execution", "council_id", council.ID)
-		return nil
-	}
-	defer e.running.Delete(council.ID)
-
 	// Ensure council is in storage and set to in_progress
 	existing, _ := e.storage.GetCouncil(council.ID)
 	if existing == nil {
@@ -232,6 +263,10 @@ func (e *Engine) RunCouncilWithCallbacks(ctx context.Context, council *core.Coun
 		}
 	}
 
+	// The budget's clock starts with each run
+	started := time.Now()
+	council.StopReason = ""
+
 	// Update status to in_progress if it's not already
 	if council.Status != core.StatusInProgress {
 		council.Status = core.StatusInProgress
@@ -241,63 +276,113 @@ func (e *Engine) RunCouncilWithCallbacks(ctx context.Context, council *core.Coun
 		}
 	}
 
+	progress := e.roundProgress(council)
+
 	// Stage 1: Collect responses
-	slog.Debug("Stage 1: Collecting responses", "council_id", council.ID)
-	currentResponses, err := e.CollectResponsesWithCallback(ctx, council, callbacks)
-	if err != nil {
-		slog.Error("Stage 1 failed", "error", err)
-		council.Status = core.StatusFailed
-		e.storage.UpdateCouncil(council)
-		return fmt.Errorf("stage 1 failed: %w", err)
+	currentResponses := progress.responses
+	if len(currentResponses) == 0 {
+		if reason := e.overBudget(council, started); reason != "" {
+			return e.finishOverBudget(council, reason, progress.round, callbacks)
+		}
+		slog.Debug("Stage 1: Collecting responses", "council_id", council.ID)
+		responses, err := e.CollectResponsesWithCallback(ctx, council, callbacks)
+		if ctx.Err() != nil {
+			// Partial stages are discarded and rerun on resume
+			return e.stopCouncil(council, run.StopStatus(ctx), context.Cause(ctx))
+		}
+		if err != nil {
+			slog.Error("Stage 1 failed", "error", err)
+			council.Status = core.StatusFailed
+			e.storage.UpdateCouncil(council)
+			return fmt.Errorf("stage 1 failed: %w", err)
+		}
+		currentResponses = responses
+
+		// Save responses
+		for _, r := range currentResponses {
+			r := r // capture loop variable
+			if err := e.storage.AddResponse(&r); err != nil {
+				slog.Error("Failed to save response", "error", err)
+				return fmt.Errorf("failed to save response: %w", err)
+			}
+		}
+	} else {
+		slog.Debug("Stage 1 already complete, resuming", "council_id", council.ID, "round", progress.round)
 	}
 
 	if callbacks != nil && callbacks.OnStageComplete != nil {
 		callbacks.OnStageComplete(1)
 	}
-
-	// Save responses
-	for _, r := range currentResponses {
-		r := r // capture loop variable
-		if err := e.storage.AddResponse(&r); err != nil {
-			slog.Error("Failed to save response", "error", err)
-			return fmt.Errorf("failed to save response: %w", err)
-		}
+	if err := e.checkStop(ctx, council); err != nil {
+		return err
+	}
+	if reason := e.overBudget(council, started); reason != "" {
+		return e.finishOverBudget(council, reason, progress.round, callbacks)
 	}
 
-	// Stage 2: Collect rankings
-	slog.Debug("Stage 2: Collecting rankings", "council_id", council.ID)
-	currentRankings, err := e.CollectRankingsWithCallback(ctx, council, currentResponses, callbacks)
-	if err != nil {
-		slog.Error("Stage 2 failed", "error", err)
-		council.Status = core.StatusFailed
-		e.storage.UpdateCouncil(council)
-		return fmt.Errorf("stage 2 failed: %w", err)
+	// Stage 2: Collect rankings. A targeted follow-up's answers skip peer review.
+	currentRankings := progress.rankings
+	if progress.targeting() != nil {
+		slog.Debug("Stage 2 skipped for a targeted follow-up", "council_id", council.ID, "round", progress.round)
+	} else if len(currentRankings) == 0 {
+		slog.Debug("Stage 2: Collecting rankings", "council_id", council.ID)
+		rankings, err := e.CollectRankingsWithCallback(ctx, council, currentResponses, callbacks)
+		if ctx.Err() != nil {
+			return e.stopCouncil(council, run.StopStatus(ctx), context.Cause(ctx))
+		}
+		if err != nil {
+			slog.Error("Stage 2 failed", "error", err)
+			council.Status = core.StatusFailed
+			e.storage.UpdateCouncil(council)
+			return fmt.Errorf("stage 2 failed: %w", err)
+		}
+		currentRankings = rankings
+
+		// Save rankings
+		for _, r := range currentRankings {
+			r := r // capture loop variable
+			if err := e.storage.AddRanking(&r); err != nil {
+				slog.Error("Failed to save ranking", "error", err)
+				return fmt.Errorf("failed to save ranking: %w", err)
+			}
+		}
+	} else {
+		slog.Debug("Stage 2 already complete, resuming", "council_id", council.ID, "round", progress.round)
 	}
 
 	if callbacks != nil && callbacks.OnStageComplete != nil {
 		callbacks.OnStageComplete(2)
 	}
-
-	// Save rankings
-	for _, r := range currentRankings {
-		r := r // capture loop variable
-		if err := e.storage.AddRanking(&r); err != nil {
-			slog.Error("Failed to save ranking", "error", err)
-			return fmt.Errorf("failed to save ranking: %w", err)
-		}
+	if err := e.checkStop(ctx, council); err != nil {
+		return err
+	}
+	if reason := e.overBudget(council, started); reason != "" {
+		return e.finishOverBudget(council, reason, progress.round, callbacks)
 	}
 
-	// Stage 3: Synthesis
+	// Stage 3: Synthesis, which a targeted follow-up only gets if it asked
+	targeting := progress.targeting()
+	if targeting != nil && !targeting.Synthesize {
+		council.Status = core.StatusCompleted
+		e.storage.UpdateCouncil(council)
+		slog.Info("Council follow-up answered", "council_id", council.ID, "round", progress.round)
+		return nil
+	}
 	slog.Debug("Stage 3: Generating synthesis", "council_id", council.ID)
-	synthesis, err := e.GenerateSynthesis(ctx, council, currentResponses, currentRankings)
+	var synthesis *core.CouncilSynthesis
+	var err error
+	if targeting != nil {
+		synthesis, err = e.generateFollowUpSynthesis(ctx, council, progress.followUp, currentResponses)
+	} else {
+		synthesis, err = e.GenerateSynthesis(ctx, council, currentResponses, currentRankings)
+	}
+	if ctx.Err() != nil {
+		return e.stopCouncil(council, run.StopStatus(ctx), context.Cause(ctx))
+	}
 	if err != nil {
 		slog.Error("Stage 3 failed", "error", err)
-		round := 1
-		if len(currentResponses) > 0 {
-			round = currentResponses[0].Round
-		}
 		synthesis = &core.CouncilSynthesis{
-			Round:      round,
+			Round:      progress.round,
 			Content:    "Synthesis unavailable: chairman model failed. Review the responses above.",
 			CreatedAt:  time.Now(),
 			Model:      "system",
@@ -330,67 +415,28 @@ func (e *Engine) CollectResponsesWithCallback(ctx context.Context, council *core
 		err      error
 	}
 
-	resultChan := make(chan responseResult, len(council.Members))
-
 	// Get current round
 	existingResponses, _ := e.storage.GetResponses(council.ID)
 	round := 1
 	if len(existingResponses) > 0 {
 		round = existingResponses[len(existingResponses)-1].Round
 	}
+	members := roundMembers(council, existingResponses, round)
 
-	for _, member := range council.Members {
-		go func(agent core.Agent) {
-			// Build prompt
-			// If there are previous syntheses, include them in the prompt
-			prompt, err := e.buildResponsePromptWithHistory(council, agent, existingResponses)
-			if err != nil {
-				resultChan <- responseResult{agent: agent, err: fmt.Errorf("failed to build prompt for %s: %w", agent.Name, err)}
-				return
-			}
+	resultChan := make(chan responseResult, len(members))
 
-			// Execute provider
-			prov, err := e.registry.Get(agent.Provider)
-			if err != nil {
-				resultChan <- responseResult{agent: agent, err: fmt.Errorf("provider not found for %s: %w", agent.Name, err)}
-				return
-			}
-
-			provResp, err := prov.GenerateWithResponseDir(ctx, prompt, agent.Model, council.CWD)
-			if err != nil {
-				resultChan <- responseResult{agent: agent, err: fmt.Errorf("generation failed for %s: %w", agent.Name, err)}
-				return
-			}
-
-			response := core.Response{
-				ID:           core.GenerateID(),
-				CouncilID:    council.ID,
-				MemberID:     agent.ID,
-				Round:        round,
-				Content:      provResp.Content,
-				CreatedAt:    time.Now(),
-				ResponseType: core.ResponseTypeResponse,
-				Model:        provResp.Model,
-			}
-
-			// Populate metadata from provider response
-			if provResp.Metadata != nil {
-				response.InputTokens = provResp.Metadata.InputTokens
-				response.OutputTokens = provResp.Metadata.OutputTokens
-				response.TotalTokens = provResp.Metadata.TotalTokens
-				response.DurationMs = provResp.Metadata.Duration.Milliseconds()
-				response.StopReason = provResp.Metadata.StopReason
-			}
-
-			resultChan <- responseResult{agent: agent, response: response}
+	for _, member := range members {
+		go func(agent core.Agent) {
+			response, err := e.generateResponse(ctx, council, agent, existingResponses, round)
+			resultChan <- responseResult{agent: agent, response: response, err: err}
 		}(member)
 	}
 
 	// Collect all results
-	responses := make([]core.Response, 0, len(council.Members))
+	responses := make([]core.Response, 0, len(members))
 	var errCount int
 
-	for i := 0; i < len(council.Members); i++ {
+	for i := 0; i < len(members); i++ {
 		result := <-resultChan
 		if result.err != nil {
 			errCount++
@@ -411,12 +457,60 @@ func (e *Engine) CollectResponsesWithCallback(ctx context.Context, council *core
 	}
 
 	if errCount > 0 {
-		slog.Warn("Some responses failed", "failed", errCount, "total", len(council.Members))
+		slog.Warn("Some responses failed", "failed", errCount, "total", len(members))
 	}
 
 	return responses, nil
 }
 
+// generateResponse prompts a member for its Stage 1 response in round,
+// given the council's responses so far, and returns the unsaved response.
+func (e *Engine) generateResponse(ctx context.Context, council *core.Council, agent core.Agent, history []*core.Response, round int) (core.Response, error) {
+	// Build prompt
+	// If there are previous syntheses, include them in the prompt
+	prompt, err := e.buildResponsePromptWithHistory(council, agent, history)
+	if err != nil {
+		return core.Response{}, fmt.Errorf("failed to build prompt for %s: %w", agent.Name, err)
+	}
+
+	// Execute provider
+	prov, err := e.registry.Get(agent.Provider)
+	if err != nil {
+		return core.Response{}, fmt.Errorf("provider not found for %s: %w", agent.Name, err)
+	}
+
+	provResp, err := prov.GenerateWithResponseDir(ctx, prompt, agent.Model, council.CWD)
+	if err != nil {
+		return core.Response{}, fmt.Errorf("generation failed for %s: %w", agent.Name, err)
+	}
+
+	response := core.Response{
+		ID:           core.GenerateID(),
+		CouncilID:    council.ID,
+		MemberID:     agent.ID,
+		Round:        round,
+		Content:      provResp.Content,
+		CreatedAt:    time.Now(),
+		ResponseType: core.ResponseTypeResponse,
+		Model:        provResp.Model,
+		References:   coderef.Check(council.CWD, provResp.Content),
+	}
+
+	// Populate metadata from provider response
+	if provResp.Metadata != nil {
+		response.InputTokens = provResp.Metadata.InputTokens
+		response.OutputTokens = provResp.Metadata.OutputTokens
+		response.TotalTokens = provResp.Metadata.TotalTokens
+		response.DurationMs = provResp.Metadata.Duration.Milliseconds()
+		response.StopReason = provResp.Metadata.StopReason
+		response.TokensEstimated = provResp.Metadata.Estimated
+		response.InputEstimated = provResp.Metadata.InputEstimated
+		response.OutputEstimated = provResp.Metadata.OutputEstimated
+		response.CostUSD = provResp.Metadata.CostUSD
+	}
+	return response, nil
+}
+
 // CollectRankings implements Stage 2: collect rankings from all members.
 func (e *Engine) CollectRankings(ctx context.Context, council *core.Council, responses []core.Response) ([]core.Ranking, error) {
 	return e.CollectRankingsWithCallback(ctx, council, responses, nil)
@@ -484,6 +578,10 @@ func (e *Engine) CollectRankingsWithCallback(ctx context.Context, council *core.
 				ranking.TotalTokens = provResp.Metadata.TotalTokens
 				ranking.DurationMs = provResp.Metadata.Duration.Milliseconds()
 				ranking.StopReason = provResp.Metadata.StopReason
+				ranking.TokensEstimated = provResp.Metadata.Estimated
+				ranking.InputEstimated = provResp.Metadata.InputEstimated
+				ranking.OutputEstimated = provResp.Metadata.OutputEstimated
+				ranking.CostUSD = provResp.Metadata.CostUSD
 			}
 
 			resultChan <- rankingResult{agent: agent, ranking: ranking, content: provResp.Content}
@@ -530,7 +628,17 @@ func (e *Engine) GenerateSynthesis(ctx context.Context, council *core.Council, r
 	// Build synthesis prompt
 	prompt := e.buildSynthesisPrompt(council, responses, aggregateRanks)
 
-	// Execute chairman
+	// Determine round
+	round := 1
+	if len(responses) > 0 {
+		round = responses[0].Round
+	}
+
+	return e.synthesize(ctx, council, prompt, round)
+}
+
+// synthesize prompts the chairman for round's synthesis.
+func (e *Engine) synthesize(ctx context.Context, council *core.Council, prompt string, round int) (*core.CouncilSynthesis, error) {
 	prov, err := e.registry.Get(council.Chairman.Provider)
 	if err != nil {
 		return nil, fmt.Errorf("chairman provider not found: %w", err)
@@ -541,12 +649,6 @@ func (e *Engine) GenerateSynthesis(ctx context.Context, council *core.Council, r
 		return nil, fmt.Errorf("synthesis generation failed: %w", err)
 	}
 
-	// Determine round
-	round := 1
-	if len(responses) > 0 {
-		round = responses[0].Round
-	}
-
 	synthesis := &core.CouncilSynthesis{
 		Round:     round,
 		Content:   provResp.Content,
@@ -561,6 +663,10 @@ func (e *Engine) GenerateSynthesis(ctx context.Context, council *core.Council, r
 		synthesis.TotalTokens = provResp.Metadata.TotalTokens
 		synthesis.DurationMs = provResp.Metadata.Duration.Milliseconds()
 		synthesis.StopReason = provResp.Metadata.StopReason
+		synthesis.TokensEstimated = provResp.Metadata.Estimated
+		synthesis.InputEstimated = provResp.Metadata.InputEstimated
+		synthesis.OutputEstimated = provResp.Metadata.OutputEstimated
+		synthesis.CostUSD = provResp.Metadata.CostUSD
 	}
 
 	return synthesis, nil
@@ -633,11 +739,21 @@ func (e *Engine) buildResponsePromptWithHistory(council *core.Council, agent cor
 }
 
 // AddFollowUp adds a user follow-up and resumes the council deliberation.
-func (e *Engine) AddFollowUp(ctx context.Context, councilID string, content string) error {
+// A follow-up addressed to some members is answered by them alone, without
+// rankings; the chairman synthesizes their answers only if it asks.
+func (e *Engine) AddFollowUp(ctx context.Context, councilID string, followUp core.FollowUp) error {
 	council, err := e.storage.GetCouncil(councilID)
 	if err != nil {
 		return err
 	}
+	e.ensureMaskedNames(council)
+	targets, err := core.ResolveTargets(council.Members, followUp)
+	if err != nil {
+		return err
+	}
+	if followUp.Synthesize && targets == nil {
+		return fmt.Errorf("only targeted follow-ups can ask for a synthesis")
+	}
 
 	// Determine new round
 	responses, _ := e.storage.GetResponses(councilID)
@@ -651,9 +767,16 @@ func (e *Engine) AddFollowUp(ctx context.Context, councilID string, content stri
 		CouncilID: council.ID,
 		MemberID:  "user",
 		Round:     newRound,
-		Content:   content,
+		Content:   followUp.Content,
 		CreatedAt: time.Now(),
 	}
+	if targets != nil {
+		userResponse.Targeting = &core.Targeting{AgentIDs: targets, Synthesize: followUp.Synthesize}
+	}
+
+	if e.runs.Running(councilID) {
+		return run.ErrAlreadyRunning
+	}
 
 	if err := e.storage.AddResponse(&userResponse); err != nil {
 		return fmt.Errorf("failed to save user follow-up: %w", err)
@@ -667,13 +790,7 @@ func (e *Engine) AddFollowUp(ctx context.Context, councilID string, content stri
 	}
 
 	// Trigger background run
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
-		defer cancel()
-		e.RunCouncil(ctx, council)
-	}()
-
-	return nil
+	return e.StartCouncil(council)
 }
 
 // Helper functions for prompt building (to be implemented)
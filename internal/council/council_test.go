@@ -11,6 +11,7 @@ import (
 
 	"github.com/alienxp03/conclave/internal/core"
 	"github.com/alienxp03/conclave/internal/provider"
+	"github.com/alienxp03/conclave/internal/run"
 	"github.com/alienxp03/conclave/internal/storage"
 	extprovider "github.com/alienxp03/conclave/provider"
 )
@@ -19,6 +20,7 @@ type mockProvider struct {
 	name      string
 	available bool
 	err       error
+	metadata  *extprovider.Metadata
 }
 
 func (m *mockProvider) Name() string    { return m.name }
@@ -46,6 +48,7 @@ func (m *mockProvider) Execute(ctx context.Context, req *extprovider.Request) (*
 		Content:  content,
 		Model:    "test-model",
 		Provider: m.name,
+		Metadata: m.metadata,
 	}, nil
 }
 
@@ -77,7 +80,7 @@ func setupTestCouncilEngine(t *testing.T, registry *provider.Registry) (*Engine,
 		t.Fatalf("failed to initialize storage: %v", err)
 	}
 
-	eng := New(store, registry)
+	eng := New(store, registry, nil)
 
 	cleanup := func() {
 		store.Close()
@@ -188,3 +191,472 @@ func TestRunCouncilFallsBackWhenChairmanFails(t *testing.T) {
 		t.Fatalf("expected fallback synthesis content, got: %s", stored.Syntheses[0].Content)
 	}
 }
+
+func TestPauseResumeCouncil(t *testing.T) {
+	registry := provider.NewRegistry()
+	registry.Register(&mockProvider{name: "okprov", available: true})
+	registry.Register(&mockProvider{name: "otherprov", available: true})
+
+	eng, cleanup := setupTestCouncilEngine(t, registry)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	c, err := eng.CreateCouncil(ctx, core.NewCouncilConfig{
+		Topic: "Test topic",
+		Members: []core.MemberSpec{
+			{Provider: "okprov"},
+			{Provider: "otherprov"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create council: %v", err)
+	}
+
+	// A pause requested during stage 1 takes effect before stage 2
+	err = eng.RunCouncilWithCallbacks(ctx, c, &CouncilCallbacks{
+		OnResponseCollected: func(resp core.Response) {
+			eng.storage.RequestStop(c.ID, core.StatusPaused)
+		},
+	})
+	if !errors.Is(err, run.ErrPaused) {
+		t.Fatalf("expected ErrPaused, got %v", err)
+	}
+
+	stored, _ := eng.storage.GetCouncil(c.ID)
+	if stored.Status != core.StatusPaused {
+		t.Fatalf("expected status paused, got %s", stored.Status)
+	}
+	if rankings, _ := eng.storage.GetRankings(c.ID); len(rankings) != 0 {
+		t.Fatalf("expected no rankings before resume, got %d", len(rankings))
+	}
+
+	// Resume skips the finished response stage
+	if err := eng.ResumeCouncil(c.ID); err != nil {
+		t.Fatalf("failed to resume council: %v", err)
+	}
+	eng.WaitCouncil(ctx, c.ID)
+
+	stored, _ = eng.storage.GetCouncil(c.ID)
+	if stored.Status != core.StatusCompleted {
+		t.Fatalf("expected status completed, got %s", stored.Status)
+	}
+	if responses, _ := eng.storage.GetResponses(c.ID); len(responses) != 2 {
+		t.Fatalf("expected 2 responses, got %d", len(responses))
+	}
+	if rankings, _ := eng.storage.GetRankings(c.ID); len(rankings) != 2 {
+		t.Fatalf("expected 2 rankings, got %d", len(rankings))
+	}
+	if len(stored.Syntheses) != 1 {
+		t.Fatalf("expected 1 synthesis, got %d", len(stored.Syntheses))
+	}
+}
+
+func TestRecoverCouncils(t *testing.T) {
+	registry := provider.NewRegistry()
+	registry.Register(&mockProvider{name: "okprov", available: true})
+	registry.Register(&mockProvider{name: "otherprov", available: true})
+
+	eng, cleanup := setupTestCouncilEngine(t, registry)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	c, err := eng.CreateCouncil(ctx, core.NewCouncilConfig{
+		Topic: "Test topic",
+		Members: []core.MemberSpec{
+			{Provider: "okprov"},
+			{Provider: "otherprov"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create council: %v", err)
+	}
+
+	// Stop after stage 1, then leave it in progress as if the server died
+	eng.RunCouncilWithCallbacks(ctx, c, &CouncilCallbacks{
+		OnResponseCollected: func(resp core.Response) {
+			eng.storage.RequestStop(c.ID, core.StatusPaused)
+		},
+	})
+	stored, _ := eng.storage.GetCouncil(c.ID)
+	stored.Status = core.StatusInProgress
+	eng.storage.UpdateCouncil(stored)
+
+	if next := eng.NextStage(stored); next != "stage 2 (rankings) in round 1" {
+		t.Errorf("expected next stage 2, got %q", next)
+	}
+
+	// A fresh heartbeat means another process is still running it
+	eng.storage.Heartbeat(c.ID, "cli-host:42")
+	if n, _ := eng.RecoverCouncils(core.RecoveryResume); n != 0 || eng.IsRunning(c.ID) {
+		t.Fatalf("expected a council running elsewhere to be left alone, got %d recovered", n)
+	}
+	eng.storage.ClearHeartbeat(c.ID)
+
+	n, err := eng.RecoverCouncils(core.RecoveryInterrupt)
+	if err != nil || n != 1 {
+		t.Fatalf("expected 1 council recovered, got %d, %v", n, err)
+	}
+	stored, _ = eng.storage.GetCouncil(c.ID)
+	if stored.Status != core.StatusInterrupted {
+		t.Fatalf("expected status interrupted, got %s", stored.Status)
+	}
+
+	stored.Status = core.StatusInProgress
+	eng.storage.UpdateCouncil(stored)
+	if n, _ := eng.RecoverCouncils(core.RecoveryResume); n != 1 {
+		t.Fatalf("expected 1 council resumed, got %d", n)
+	}
+	eng.WaitCouncil(ctx, c.ID)
+
+	stored, _ = eng.storage.GetCouncil(c.ID)
+	if stored.Status != core.StatusCompleted {
+		t.Fatalf("expected status completed, got %s", stored.Status)
+	}
+	if responses, _ := eng.storage.GetResponses(c.ID); len(responses) != 2 {
+		t.Fatalf("expected stage 1 to be kept, got %d responses", len(responses))
+	}
+}
+
+func TestForkCouncil(t *testing.T) {
+	registry := provider.NewRegistry()
+	registry.Register(&mockProvider{name: "okprov", available: true})
+	registry.Register(&mockProvider{name: "otherprov", available: true})
+
+	eng, cleanup := setupTestCouncilEngine(t, registry)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	parent, err := eng.CreateCouncil(ctx, core.NewCouncilConfig{
+		Topic: "Test topic",
+		Members: []core.MemberSpec{
+			{Provider: "okprov"},
+			{Provider: "otherprov"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create council: %v", err)
+	}
+	if err := eng.RunCouncil(ctx, parent); err != nil {
+		t.Fatalf("failed to run council: %v", err)
+	}
+
+	fork, err := eng.ForkCouncil(parent.ID, core.ForkConfig{
+		At:    1,
+		Swaps: []core.AgentSwap{{Seat: 1, Provider: "okprov"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to fork council: %v", err)
+	}
+	if fork.ParentID != parent.ID || fork.ForkPoint != 1 {
+		t.Errorf("wrong lineage: parent %q at %d", fork.ParentID, fork.ForkPoint)
+	}
+	if fork.Status != core.StatusCompleted || len(fork.Syntheses) != 1 {
+		t.Errorf("expected a completed fork with 1 synthesis, got %s with %d", fork.Status, len(fork.Syntheses))
+	}
+
+	stored, err := eng.storage.GetCouncil(fork.ID)
+	if err != nil {
+		t.Fatalf("failed to load fork: %v", err)
+	}
+	original, _ := eng.storage.GetCouncil(parent.ID)
+	if stored.Members[1].Provider != "okprov" || stored.Members[1].Persona != original.Members[1].Persona {
+		t.Errorf("member 2 not swapped in place: %+v", stored.Members[1])
+	}
+
+	// Copied rows point at the fork's own members and responses
+	memberIDs := map[string]bool{}
+	for _, m := range stored.Members {
+		memberIDs[m.ID] = true
+	}
+	responses, _ := eng.storage.GetResponses(fork.ID)
+	responseIDs := map[string]bool{}
+	for _, r := range responses {
+		responseIDs[r.ID] = true
+		if r.ResponseType == core.ResponseTypeResponse && !memberIDs[r.MemberID] {
+			t.Errorf("response %s belongs to unknown member %s", r.ID, r.MemberID)
+		}
+	}
+	rankings, _ := eng.storage.GetRankings(fork.ID)
+	if len(rankings) != 2 {
+		t.Fatalf("expected 2 rankings, got %d", len(rankings))
+	}
+	for _, r := range rankings {
+		for _, id := range r.Rankings {
+			if !responseIDs[id] {
+				t.Errorf("ranking %s references unknown response %s", r.ID, id)
+			}
+		}
+	}
+
+	if _, err := eng.ForkCouncil(parent.ID, core.ForkConfig{At: 2}); err == nil {
+		t.Error("expected forking a missing round to fail")
+	}
+
+	tree, err := eng.CouncilForkTree(fork.ID)
+	if err != nil {
+		t.Fatalf("failed to build fork tree: %v", err)
+	}
+	if tree.ID != parent.ID || len(tree.Forks) != 1 || tree.Forks[0].ID != fork.ID {
+		t.Errorf("wrong fork tree: %+v", tree)
+	}
+}
+
+func TestRegenerateResponse(t *testing.T) {
+	registry := provider.NewRegistry()
+	registry.Register(&mockProvider{name: "okprov", available: true})
+	registry.Register(&mockProvider{name: "otherprov", available: true})
+
+	eng, cleanup := setupTestCouncilEngine(t, registry)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	c, err := eng.CreateCouncil(ctx, core.NewCouncilConfig{
+		Topic: "Test topic",
+		Members: []core.MemberSpec{
+			{Provider: "okprov"},
+			{Provider: "otherprov"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create council: %v", err)
+	}
+	if err := eng.RunCouncil(ctx, c); err != nil {
+		t.Fatalf("failed to run council: %v", err)
+	}
+
+	responses, _ := eng.storage.GetResponses(c.ID)
+	if len(responses) != 2 {
+		t.Fatalf("expected 2 responses, got %d", len(responses))
+	}
+	target := responses[0]
+
+	regenerated, err := eng.RegenerateResponse(ctx, c.ID, target.ID)
+	if err != nil {
+		t.Fatalf("failed to regenerate response: %v", err)
+	}
+	if len(regenerated.Versions) != 2 || regenerated.SelectedVersion != 1 {
+		t.Fatalf("expected 2 versions with the second selected, got %d selected %d",
+			len(regenerated.Versions), regenerated.SelectedVersion)
+	}
+	if regenerated.Versions[0].Content != target.Content {
+		t.Errorf("original content not kept: %q", regenerated.Versions[0].Content)
+	}
+
+	if _, err := eng.SelectResponseVersion(c.ID, target.ID, 0); err != nil {
+		t.Fatalf("failed to select version: %v", err)
+	}
+	responses, _ = eng.storage.GetResponses(c.ID)
+	if stored := findResponse(responses, target.ID); stored.SelectedVersion != 0 || len(stored.Versions) != 2 {
+		t.Errorf("version not selected: selected %d of %d", stored.SelectedVersion, len(stored.Versions))
+	}
+	if _, err := eng.SelectResponseVersion(c.ID, target.ID, 3); err == nil {
+		t.Error("expected selecting a missing version to fail")
+	}
+
+	// Rerunning replaces the round's rankings and synthesis
+	if err := eng.RerunStages(c.ID); err != nil {
+		t.Fatalf("failed to rerun stages: %v", err)
+	}
+	eng.WaitCouncil(ctx, c.ID)
+
+	stored, _ := eng.storage.GetCouncil(c.ID)
+	if stored.Status != core.StatusCompleted || len(stored.Syntheses) != 1 {
+		t.Fatalf("expected a completed council with 1 synthesis, got %s with %d", stored.Status, len(stored.Syntheses))
+	}
+	if rankings, _ := eng.storage.GetRankings(c.ID); len(rankings) != 2 {
+		t.Fatalf("expected 2 rankings, got %d", len(rankings))
+	}
+	if responses, _ := eng.storage.GetResponses(c.ID); len(responses) != 2 {
+		t.Fatalf("expected 2 responses, got %d", len(responses))
+	}
+}
+
+func TestRunCouncilStopsAtBudget(t *testing.T) {
+	registry := provider.NewRegistry()
+	registry.Register(&mockProvider{name: "paidprov", available: true, metadata: &extprovider.Metadata{TotalTokens: 100}})
+
+	eng, cleanup := setupTestCouncilEngine(t, registry)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	c, err := eng.CreateCouncil(ctx, core.NewCouncilConfig{
+		Topic:   "Test topic",
+		Members: []core.MemberSpec{{Provider: "paidprov"}, {Provider: "paidprov"}},
+		Budget:  &core.Budget{MaxTokens: 150},
+	})
+	if err != nil {
+		t.Fatalf("failed to create council: %v", err)
+	}
+
+	if err := eng.RunCouncil(ctx, c); err != nil {
+		t.Fatalf("expected council to complete, got error: %v", err)
+	}
+
+	// Stage 1 spends 200 tokens, so rankings and the chairman are skipped
+	stored, _ := eng.storage.GetCouncil(c.ID)
+	if stored.Status != core.StatusCompleted {
+		t.Errorf("Status = %s, want completed", stored.Status)
+	}
+	if !strings.Contains(stored.StopReason, "token budget of 150 reached (200 used)") {
+		t.Errorf("StopReason = %q", stored.StopReason)
+	}
+	if rankings, _ := eng.storage.GetRankings(c.ID); len(rankings) != 0 {
+		t.Errorf("expected no rankings, got %d", len(rankings))
+	}
+	if len(stored.Syntheses) != 1 || stored.Syntheses[0].StopReason != "budget" || stored.Syntheses[0].Model != "system" {
+		t.Errorf("expected a budget placeholder synthesis, got %+v", stored.Syntheses)
+	}
+}
+
+func TestTargetedFollowUp(t *testing.T) {
+	registry := provider.NewRegistry()
+	registry.Register(&mockProvider{name: "okprov", available: true})
+	registry.Register(&mockProvider{name: "otherprov", available: true})
+
+	eng, cleanup := setupTestCouncilEngine(t, registry)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	c, err := eng.CreateCouncil(ctx, core.NewCouncilConfig{
+		Topic: "Test topic",
+		Members: []core.MemberSpec{
+			{Provider: "okprov"},
+			{Provider: "otherprov"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create council: %v", err)
+	}
+	if err := eng.RunCouncil(ctx, c); err != nil {
+		t.Fatalf("failed to run council: %v", err)
+	}
+	target := c.Members[1]
+
+	if err := eng.AddFollowUp(ctx, c.ID, core.FollowUp{Content: "Why?", Targets: []string{"nobody"}}); err == nil {
+		t.Fatal("expected an unknown target to be rejected")
+	}
+	if err := eng.AddFollowUp(ctx, c.ID, core.FollowUp{Content: "Why?", Synthesize: true}); err == nil {
+		t.Fatal("expected a synthesis of an untargeted follow-up to be rejected")
+	}
+
+	// An @mention addresses the follow-up without a synthesis
+	if err := eng.AddFollowUp(ctx, c.ID, core.FollowUp{Content: "@otherprov, expand on the risk"}); err != nil {
+		t.Fatalf("failed to add follow-up: %v", err)
+	}
+	eng.WaitCouncil(ctx, c.ID)
+
+	responses, _ := eng.storage.GetResponses(c.ID)
+	var round2 []*core.Response
+	for _, r := range responses {
+		if r.Round == 2 {
+			round2 = append(round2, r)
+		}
+	}
+	if len(round2) != 2 || round2[0].MemberID != "user" || round2[1].MemberID != target.ID {
+		t.Fatalf("expected the user follow-up and one answer from %s in round 2, got %+v", target.ID, round2)
+	}
+	if tg := round2[0].Targeting; tg == nil || len(tg.AgentIDs) != 1 || tg.AgentIDs[0] != target.ID || tg.Synthesize {
+		t.Fatalf("expected targeting to be stored on the follow-up, got %+v", tg)
+	}
+	if rankings, _ := eng.storage.GetRankings(c.ID); len(rankings) != 2 {
+		t.Fatalf("expected no rankings for the targeted round, got %d in total", len(rankings))
+	}
+	stored, _ := eng.storage.GetCouncil(c.ID)
+	if stored.Status != core.StatusCompleted || len(stored.Syntheses) != 1 {
+		t.Fatalf("expected a completed council with 1 synthesis, got %s with %d", stored.Status, len(stored.Syntheses))
+	}
+
+	// The chairman synthesizes a targeted follow-up's answers on request
+	followUp := core.FollowUp{Content: "And the cost?", Targets: []string{target.ID}, Synthesize: true}
+	if err := eng.AddFollowUp(ctx, c.ID, followUp); err != nil {
+		t.Fatalf("failed to add follow-up: %v", err)
+	}
+	eng.WaitCouncil(ctx, c.ID)
+
+	stored, _ = eng.storage.GetCouncil(c.ID)
+	if len(stored.Syntheses) != 2 || stored.Syntheses[1].Round != 3 {
+		t.Fatalf("expected a synthesis for round 3, got %+v", stored.Syntheses)
+	}
+	if responses, _ := eng.storage.GetResponses(c.ID); len(responses) != 6 {
+		t.Fatalf("expected 6 responses, got %d", len(responses))
+	}
+}
+
+func TestAskChairman(t *testing.T) {
+	registry := provider.NewRegistry()
+	registry.Register(&mockProvider{name: "okprov", available: true})
+	registry.Register(&mockProvider{name: "otherprov", available: true})
+
+	eng, cleanup := setupTestCouncilEngine(t, registry)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	c, err := eng.CreateCouncil(ctx, core.NewCouncilConfig{
+		Topic: "Test topic",
+		Members: []core.MemberSpec{
+			{Provider: "okprov"},
+			{Provider: "otherprov"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create council: %v", err)
+	}
+	if _, err := eng.AskChairman(ctx, c.ID, "Too early?"); err == nil {
+		t.Fatal("expected a question to an unfinished council to be rejected")
+	}
+	if err := eng.RunCouncil(ctx, c); err != nil {
+		t.Fatalf("failed to run council: %v", err)
+	}
+	if _, err := eng.AskChairman(ctx, c.ID, "  "); err == nil {
+		t.Fatal("expected an empty question to be rejected")
+	}
+
+	answer, err := eng.AskChairman(ctx, c.ID, "Which member was most cautious?")
+	if err != nil {
+		t.Fatalf("failed to ask the chairman: %v", err)
+	}
+	if answer.ResponseType != core.ResponseTypeAnswer || answer.MemberID != c.Chairman.ID || answer.Round != 1 || answer.Content == "" {
+		t.Fatalf("unexpected answer: %+v", answer)
+	}
+
+	responses, _ := eng.storage.GetResponses(c.ID)
+	if len(responses) != 3 || responses[2].Question != "Which member was most cautious?" {
+		t.Fatalf("expected the answer to be saved with its question, got %d responses", len(responses))
+	}
+	stored, _ := eng.storage.GetCouncil(c.ID)
+	if stored.Status != core.StatusCompleted || len(stored.Syntheses) != 1 {
+		t.Fatalf("expected the council to stay completed with 1 synthesis, got %s with %d", stored.Status, len(stored.Syntheses))
+	}
+
+	// The chairman sees the record and its earlier answers
+	rankings, _ := eng.storage.GetRankings(c.ID)
+	prompt := eng.buildAskPrompt(stored, responses, rankings, "And now?")
+	for _, want := range []string{"Response from okprov", "Aggregate Rankings", "Synthesis content", "Q: Which member was most cautious?", `"And now?"`} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("prompt is missing %q", want)
+		}
+	}
+
+	// A later follow-up still starts a new round with every member
+	if err := eng.AddFollowUp(ctx, c.ID, core.FollowUp{Content: "Go on"}); err != nil {
+		t.Fatalf("failed to add follow-up: %v", err)
+	}
+	eng.WaitCouncil(ctx, c.ID)
+	if rankings, _ := eng.storage.GetRankings(c.ID); len(rankings) != 4 {
+		t.Fatalf("expected 4 rankings after the follow-up, got %d", len(rankings))
+	}
+}
@@ -0,0 +1,74 @@
+package council
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// roundMembers returns the members who answer round: those its user
+// follow-up addresses, or every member.
+func roundMembers(council *core.Council, responses []*core.Response, round int) []core.Agent {
+	for _, r := range responses {
+		if r.Round != round || r.MemberID != "user" || r.Targeting == nil {
+			continue
+		}
+		var members []core.Agent
+		for _, m := range council.Members {
+			if r.Targeting.Addresses(m.ID) {
+				members = append(members, m)
+			}
+		}
+		return members
+	}
+	return council.Members
+}
+
+// generateFollowUpSynthesis asks the chairman to update its conclusion with
+// the answers to a targeted follow-up.
+func (e *Engine) generateFollowUpSynthesis(ctx context.Context, council *core.Council, followUp *core.Response, responses []core.Response) (*core.CouncilSynthesis, error) {
+	return e.synthesize(ctx, council, e.buildFollowUpSynthesisPrompt(council, followUp, responses), followUp.Round)
+}
+
+func (e *Engine) buildFollowUpSynthesisPrompt(council *core.Council, followUp *core.Response, responses []core.Response) string {
+	memberNames := make(map[string]string)
+	for _, m := range council.Members {
+		memberNames[m.ID] = m.MaskedName
+	}
+
+	addressed := make([]string, 0, len(followUp.Targeting.AgentIDs))
+	for _, id := range followUp.Targeting.AgentIDs {
+		addressed = append(addressed, memberNames[id])
+	}
+
+	var responsesText strings.Builder
+	for _, r := range responses {
+		responsesText.WriteString(fmt.Sprintf("\n[%s]\n%s\n", memberNames[r.MemberID], r.Content))
+	}
+
+	var contextText strings.Builder
+	if len(council.Syntheses) > 0 {
+		latestSynthesis := council.Syntheses[len(council.Syntheses)-1]
+		contextText.WriteString(fmt.Sprintf("\nYour Previous Conclusion:\n%s\n", latestSynthesis.Content))
+	}
+
+	instructionBlock := ""
+	if instructions := formatProjectInstructions(council.ProjectInstructions); instructions != "" {
+		instructionBlock = "\n" + instructions
+	}
+
+	return fmt.Sprintf(`You are the Chairman of a council discussion.
+
+Topic: %s
+%s%s
+The user asked a follow-up addressed to %s only:
+"%s"
+
+Their answers:
+%s
+
+Your task as Chairman is to update your conclusion with these new answers. Keep what still holds from your previous conclusion, and make clear what the answers add or change.
+`, council.Topic, instructionBlock, contextText.String(), strings.Join(addressed, ", "), followUp.Content, responsesText.String())
+}
@@ -0,0 +1,214 @@
+package council
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// ForkCouncil copies a council up to round cfg.At into a new council linked
+// to its parent, applying the config's member swaps. Members get new IDs but
+// keep their masked names, so copied rankings and syntheses read the same.
+// The fork is completed if round At has a synthesis and paused otherwise, so
+// resuming it continues from the round's first unfinished stage.
+func (e *Engine) ForkCouncil(id string, cfg core.ForkConfig) (*core.Council, error) {
+	if cfg.Content != "" || cfg.Style != "" {
+		return nil, fmt.Errorf("councils can only be forked with member swaps")
+	}
+
+	parent, err := e.storage.GetCouncil(id)
+	if err != nil {
+		return nil, err
+	}
+	responses, err := e.storage.GetResponses(id)
+	if err != nil {
+		return nil, err
+	}
+	if len(responses) == 0 {
+		return nil, fmt.Errorf("council has no responses to fork from")
+	}
+
+	lastRound := responses[len(responses)-1].Round
+	at := cfg.At
+	if at == 0 {
+		at = lastRound
+	}
+	if at < 1 || at > lastRound {
+		return nil, fmt.Errorf("round %d not found (council has %d rounds)", at, lastRound)
+	}
+
+	e.ensureMaskedNames(parent)
+
+	// New IDs for every seat; copied rows are remapped to them
+	ids := map[string]string{"user": "user"}
+	members := make([]core.Agent, len(parent.Members))
+	for i, m := range parent.Members {
+		ids[m.ID] = core.GenerateID()
+		m.ID = ids[m.ID]
+		members[i] = m
+	}
+	chairman := parent.Chairman
+	ids[chairman.ID] = core.GenerateID()
+	chairman.ID = ids[chairman.ID]
+
+	for _, swap := range cfg.Swaps {
+		if swap.Seat < 0 || swap.Seat >= len(members) {
+			return nil, fmt.Errorf("invalid seat %d (council has %d members)", swap.Seat, len(members))
+		}
+		member, err := e.swapMember(members[swap.Seat], swap)
+		if err != nil {
+			return nil, fmt.Errorf("member %d: %w", swap.Seat+1, err)
+		}
+		members[swap.Seat] = member
+	}
+
+	now := time.Now()
+	fork := &core.Council{
+		ID:                  core.GenerateID(),
+		Title:               parent.Title + " (fork)",
+		Topic:               parent.Topic,
+		CWD:                 parent.CWD,
+		WorkspaceID:         parent.WorkspaceID,
+		ProjectID:           parent.ProjectID,
+		ProjectInstructions: parent.ProjectInstructions,
+		Members:             members,
+		Chairman:            chairman,
+		ParentID:            parent.ID,
+		ForkPoint:           at,
+		Status:              core.StatusPaused,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+	for _, s := range parent.Syntheses {
+		if s.Round > at {
+			continue
+		}
+		fork.Syntheses = append(fork.Syntheses, s)
+		if s.Round == at {
+			fork.Status = core.StatusCompleted
+			fork.CompletedAt = &now
+		}
+	}
+
+	if err := e.storage.CreateCouncil(fork); err != nil {
+		return nil, fmt.Errorf("failed to create fork: %w", err)
+	}
+
+	remap := func(id string) string {
+		if mapped, ok := ids[id]; ok {
+			return mapped
+		}
+		return id
+	}
+
+	for _, r := range responses {
+		if r.Round > at {
+			continue
+		}
+		copied := *r
+		ids[r.ID] = core.GenerateID()
+		copied.ID = ids[r.ID]
+		copied.CouncilID = fork.ID
+		copied.MemberID = remap(r.MemberID)
+		if r.Targeting != nil {
+			targeting := *r.Targeting
+			targeting.AgentIDs = make([]string, len(r.Targeting.AgentIDs))
+			for i, id := range r.Targeting.AgentIDs {
+				targeting.AgentIDs[i] = remap(id)
+			}
+			copied.Targeting = &targeting
+		}
+		if err := e.storage.AddResponse(&copied); err != nil {
+			return nil, fmt.Errorf("failed to copy response: %w", err)
+		}
+	}
+
+	rankings, err := e.storage.GetRankings(id)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rankings {
+		if r.Round > at {
+			continue
+		}
+		copied := *r
+		copied.ID = core.GenerateID()
+		copied.CouncilID = fork.ID
+		copied.ReviewerID = remap(r.ReviewerID)
+		copied.Rankings = make([]string, len(r.Rankings))
+		for i, responseID := range r.Rankings {
+			copied.Rankings[i] = remap(responseID)
+		}
+		if err := e.storage.AddRanking(&copied); err != nil {
+			return nil, fmt.Errorf("failed to copy ranking: %w", err)
+		}
+	}
+
+	return fork, nil
+}
+
+// swapMember applies a fork swap to a council member, validating the new
+// provider and persona the same way CreateCouncil does.
+func (e *Engine) swapMember(member core.Agent, swap core.AgentSwap) (core.Agent, error) {
+	if swap.Provider != "" && swap.Provider != member.Provider {
+		member.Provider = swap.Provider
+		member.Model = ""
+	}
+	if swap.Model != "" {
+		member.Model = swap.Model
+	}
+	if swap.Persona != "" {
+		member.Persona = swap.Persona
+	}
+	if member.Model == "" {
+		member.Model = core.DefaultModelForProvider[member.Provider]
+	}
+
+	prov, err := e.registry.Get(member.Provider)
+	if err != nil {
+		return member, fmt.Errorf("invalid provider: %w", err)
+	}
+	if !prov.Available() {
+		return member, fmt.Errorf("provider %s is not available (CLI not found)", member.Provider)
+	}
+	personaDef := e.getPersona(member.Persona)
+	if personaDef == nil {
+		return member, fmt.Errorf("invalid persona: %s", member.Persona)
+	}
+
+	member.Name = fmt.Sprintf("%s (%s) • %s", member.Provider, personaDef.Name, member.Model)
+	return member, nil
+}
+
+// CouncilForkTree returns the fork tree containing a council, rooted at its
+// oldest ancestor that still exists.
+func (e *Engine) CouncilForkTree(id string) (*core.ForkNode, error) {
+	root, err := e.storage.GetCouncil(id)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{root.ID: true}
+	for root.ParentID != "" && !seen[root.ParentID] {
+		parent, err := e.storage.GetCouncil(root.ParentID)
+		if err != nil {
+			// The parent was deleted; the oldest survivor is the root
+			break
+		}
+		seen[parent.ID] = true
+		root = parent
+	}
+
+	node := &core.ForkNode{
+		ID:        root.ID,
+		Title:     root.Title,
+		Status:    root.Status,
+		ForkPoint: root.ForkPoint,
+		CreatedAt: root.CreatedAt,
+	}
+	if err := node.ExpandForks(e.storage.ListCouncilForks); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
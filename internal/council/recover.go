@@ -0,0 +1,90 @@
+package council
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+	"github.com/alienxp03/conclave/internal/run"
+)
+
+// NextStage describes what a council does next when it runs, e.g.
+// "stage 2 (rankings) in round 1".
+func (e *Engine) NextStage(council *core.Council) string {
+	p := e.roundProgress(council)
+	switch {
+	case len(p.responses) == 0:
+		return fmt.Sprintf("stage 1 (responses) in round %d", p.round)
+	case p.targeting() != nil && !p.targeting().Synthesize:
+		return fmt.Sprintf("completion of round %d", p.round)
+	case p.targeting() != nil:
+		return fmt.Sprintf("stage 3 (synthesis) in round %d", p.round)
+	case len(p.rankings) == 0:
+		return fmt.Sprintf("stage 2 (rankings) in round %d", p.round)
+	}
+	return fmt.Sprintf("stage 3 (synthesis) in round %d", p.round)
+}
+
+// RecoverCouncils handles councils a previous process left in progress when
+// it died mid-run. Councils whose heartbeat is still fresh are running in
+// another process and are left alone. Depending on policy each other council
+// is resumed in the background from its first unfinished stage or marked
+// interrupted. It returns the number of councils recovered.
+func (e *Engine) RecoverCouncils(policy core.RecoveryPolicy) (int, error) {
+	ids, err := e.storage.ListCouncilIDsByStatus(core.StatusInProgress)
+	if err != nil {
+		return 0, err
+	}
+
+	recovered := 0
+	for _, id := range ids {
+		if e.runs.Running(id) || e.runningElsewhere(id) {
+			continue
+		}
+		council, err := e.storage.GetCouncil(id)
+		if err != nil || council == nil {
+			slog.Warn("Failed to load council for recovery", "council_id", id, "error", err)
+			continue
+		}
+		next := e.NextStage(council)
+
+		// A pause or cancel aimed at the dead run no longer applies
+		if err := e.storage.ClearStopRequest(id); err != nil {
+			slog.Warn("Failed to clear stop request", "council_id", id, "error", err)
+		}
+
+		if policy == core.RecoveryInterrupt {
+			council.Status = core.StatusInterrupted
+			if err := e.storage.UpdateCouncil(council); err != nil {
+				slog.Error("Failed to mark council interrupted", "council_id", id, "error", err)
+				continue
+			}
+			slog.Info("Marked council interrupted", "council_id", id, "next", next)
+		} else {
+			if err := e.StartCouncil(council); err != nil {
+				slog.Error("Failed to resume council", "council_id", id, "error", err)
+				continue
+			}
+			slog.Info("Resuming council", "council_id", id, "next", next)
+		}
+		recovered++
+	}
+	return recovered, nil
+}
+
+// runningElsewhere reports whether another live process is running the
+// council, judged by its heartbeat. When unsure it assumes so, since a
+// second run would duplicate stages.
+func (e *Engine) runningElsewhere(id string) bool {
+	owner, beatAt, err := e.storage.GetHeartbeat(id)
+	if err != nil {
+		slog.Warn("Failed to check council heartbeat", "council_id", id, "error", err)
+		return true
+	}
+	if run.Live(beatAt, time.Now()) {
+		slog.Info("Council is running in another process", "council_id", id, "owner", owner)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,165 @@
+package council
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+	"github.com/alienxp03/conclave/internal/run"
+)
+
+// RegenerateResponse re-executes a member's Stage 1 response with the prompt
+// context it originally had and selects the new version. Earlier versions
+// are kept as alternates. Rankings and a synthesis that already ran on the
+// old content are redone by RerunStages.
+func (e *Engine) RegenerateResponse(ctx context.Context, councilID, responseID string) (*core.Response, error) {
+	council, err := e.editableCouncil(councilID)
+	if err != nil {
+		return nil, err
+	}
+	e.ensureMaskedNames(council)
+
+	var regenerated *core.Response
+	err = e.runs.Run(ctx, councilID, func(ctx context.Context) error {
+		responses, err := e.storage.GetResponses(councilID)
+		if err != nil {
+			return err
+		}
+		response := findResponse(responses, responseID)
+		if response == nil {
+			return fmt.Errorf("response not found: %s", responseID)
+		}
+		if response.ResponseType != "" && response.ResponseType != core.ResponseTypeResponse {
+			return fmt.Errorf("only stage 1 responses can be regenerated")
+		}
+		var member *core.Agent
+		for i := range council.Members {
+			if council.Members[i].ID == response.MemberID {
+				member = &council.Members[i]
+			}
+		}
+		if member == nil {
+			return fmt.Errorf("response was not written by a council member")
+		}
+
+		// Rebuild the context of the response's round: earlier syntheses
+		// and the round's follow-up question
+		var history []*core.Response
+		for _, r := range responses {
+			if r.Round <= response.Round {
+				history = append(history, r)
+			}
+		}
+		earlier := *council
+		earlier.Syntheses = nil
+		for _, s := range council.Syntheses {
+			if s.Round < response.Round {
+				earlier.Syntheses = append(earlier.Syntheses, s)
+			}
+		}
+
+		generated, err := e.generateResponse(ctx, &earlier, *member, history, response.Round)
+		if err != nil {
+			return err
+		}
+		version := generated.CurrentVersion()
+		version.CreatedAt = time.Now()
+		response.PushVersion(version)
+		if err := e.storage.UpdateResponse(response); err != nil {
+			return err
+		}
+		regenerated = response
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return regenerated, nil
+}
+
+// SelectResponseVersion picks which version of a response later stages use.
+func (e *Engine) SelectResponseVersion(councilID, responseID string, version int) (*core.Response, error) {
+	if _, err := e.editableCouncil(councilID); err != nil {
+		return nil, err
+	}
+
+	responses, err := e.storage.GetResponses(councilID)
+	if err != nil {
+		return nil, err
+	}
+	response := findResponse(responses, responseID)
+	if response == nil {
+		return nil, fmt.Errorf("response not found: %s", responseID)
+	}
+
+	if err := response.SelectVersion(version); err != nil {
+		return nil, err
+	}
+	if err := e.storage.UpdateResponse(response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// RerunStages discards the latest round's rankings and synthesis and runs
+// the council again in the background, so Stages 2 and 3 use the selected
+// response versions.
+func (e *Engine) RerunStages(councilID string) error {
+	council, err := e.editableCouncil(councilID)
+	if err != nil {
+		return err
+	}
+
+	responses, err := e.storage.GetResponses(councilID)
+	if err != nil {
+		return err
+	}
+	if len(responses) == 0 {
+		return fmt.Errorf("council has no responses")
+	}
+	round := responses[len(responses)-1].Round
+
+	if err := e.storage.DeleteRankings(councilID, round); err != nil {
+		return err
+	}
+	var kept []*core.CouncilSynthesis
+	for _, s := range council.Syntheses {
+		if s.Round != round {
+			kept = append(kept, s)
+		}
+	}
+	council.Syntheses = kept
+	council.CompletedAt = nil
+	council.Status = core.StatusPaused
+	council.UpdatedAt = time.Now()
+	if err := e.storage.UpdateCouncil(council); err != nil {
+		return fmt.Errorf("failed to update council: %w", err)
+	}
+
+	return e.StartCouncil(council)
+}
+
+// editableCouncil loads a council whose responses are about to be
+// regenerated or reselected. It must not be running here or in another
+// process.
+func (e *Engine) editableCouncil(id string) (*core.Council, error) {
+	council, err := e.storage.GetCouncil(id)
+	if err != nil {
+		return nil, err
+	}
+	if e.runs.Running(id) || council.Status == core.StatusInProgress {
+		return nil, run.ErrAlreadyRunning
+	}
+	return council, nil
+}
+
+// findResponse returns the response with the given ID, or nil.
+func findResponse(responses []*core.Response, id string) *core.Response {
+	for _, r := range responses {
+		if r.ID == id {
+			return r
+		}
+	}
+	return nil
+}
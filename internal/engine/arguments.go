@@ -0,0 +1,168 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// maxClaimsPerTurn caps the claims kept from one turn.
+const maxClaimsPerTurn = 8
+
+// Arguments returns a debate's argument graph, first extracting the claims
+// of any debate turns not extracted yet. Turns are extracted in order so
+// rebuttals can link to earlier claims; if one fails, the graph built so
+// far is returned with the remaining turns counted as pending, along with
+// the error.
+func (e *Engine) Arguments(ctx context.Context, debateID string) (*core.ArgumentGraph, error) {
+	e.argumentsMu.Lock()
+	defer e.argumentsMu.Unlock()
+
+	debate, turns, err := e.GetDebateWithTurns(debateID)
+	if err != nil {
+		return nil, err
+	}
+	if debate == nil {
+		return nil, fmt.Errorf("debate not found: %s", debateID)
+	}
+	e.ensureMaskedNames(debate)
+
+	extracted, err := e.storage.GetDebateClaims(debateID)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := &core.ArgumentGraph{DebateID: debateID, Claims: []*core.Claim{}}
+	var extractErr error
+	for _, turn := range turns {
+		if !isDebateTurn(turn) {
+			continue
+		}
+		if _, ok := debate.AgentByID(turn.AgentID); !ok {
+			continue
+		}
+		if extractErr != nil {
+			graph.Pending++
+			continue
+		}
+
+		claims, ok := extracted[turn.ID]
+		if !ok {
+			claims, extractErr = e.extractClaims(ctx, debate, turn, graph.Claims)
+			if extractErr != nil {
+				extractErr = fmt.Errorf("failed to extract arguments from turn %d: %w", turn.Number, extractErr)
+				graph.Pending++
+				continue
+			}
+			if err := e.storage.SaveTurnClaims(turn, claims); err != nil {
+				return nil, err
+			}
+		}
+		graph.Claims = append(graph.Claims, claims...)
+	}
+	return graph, extractErr
+}
+
+// extractClaims asks the debate's reviewing model for the claims a turn
+// makes, their evidence and the earlier claims they rebut.
+func (e *Engine) extractClaims(ctx context.Context, debate *core.Debate, turn *core.Turn, earlier []*core.Claim) ([]*core.Claim, error) {
+	instructionBlock := ""
+	if instructions := formatProjectInstructions(debate.ProjectInstructions); instructions != "" {
+		instructionBlock = "\n\n" + instructions
+	}
+
+	names := maskedNamesByID(debate)
+	var known strings.Builder
+	for _, c := range earlier {
+		fmt.Fprintf(&known, "%s [%s]: %s\n", c.ID, names[c.AgentID], c.Text)
+	}
+	if known.Len() == 0 {
+		known.WriteString("(none yet)\n")
+	}
+
+	prompt := fmt.Sprintf(`You are mapping the arguments in a debate on: "%s"%s
+
+Claims made earlier in the debate:
+%s
+Turn %d by %s:
+%s
+
+List the distinct claims this turn makes (at most %d). For each, give the evidence offered for it (facts, data, examples or quotes; may be empty) and the IDs of earlier claims it rebuts or answers (may be empty).
+
+Respond with ONLY a JSON object in this exact shape:
+{"claims": [{"text": "<the claim in one sentence>", "evidence": ["<evidence>"], "rebuts": ["<earlier claim ID>"]}]}`,
+		debate.Topic, instructionBlock, known.String(), turn.Number, names[turn.AgentID], turn.Content, maxClaimsPerTurn)
+
+	ids := make(map[string]bool, len(earlier))
+	for _, c := range earlier {
+		ids[c.ID] = true
+	}
+
+	generate := e.consensusGenerator(debate)
+	ask := prompt
+	var parseErr error
+	for attempt := 1; attempt <= maxStructuredAttempts; attempt++ {
+		reply, err := generate(ctx, ask)
+		if err != nil {
+			return nil, err
+		}
+		claims, err := parseClaims(reply, turn, ids)
+		if err == nil {
+			return claims, nil
+		}
+		parseErr = err
+		ask = retryPrompt(prompt, err, reply)
+	}
+	return nil, fmt.Errorf("no valid reply after %d attempts: %w", maxStructuredAttempts, parseErr)
+}
+
+// parseClaims reads a {"claims": [...]} reply into the turn's claims.
+// Rebuttal links to unknown claims are dropped.
+func parseClaims(content string, turn *core.Turn, known map[string]bool) ([]*core.Claim, error) {
+	var reply struct {
+		Claims []struct {
+			Text     string   `json:"text"`
+			Evidence []string `json:"evidence"`
+			Rebuts   []string `json:"rebuts"`
+		} `json:"claims"`
+	}
+	if err := decodeRepairedJSON(content, &reply); err != nil {
+		return nil, err
+	}
+	if reply.Claims == nil {
+		return nil, fmt.Errorf("claims is required")
+	}
+
+	claims := []*core.Claim{}
+	for _, rc := range reply.Claims {
+		text := strings.TrimSpace(rc.Text)
+		if text == "" {
+			continue
+		}
+		claim := &core.Claim{
+			ID:         fmt.Sprintf("t%dc%d", turn.Number, len(claims)+1),
+			TurnID:     turn.ID,
+			TurnNumber: turn.Number,
+			AgentID:    turn.AgentID,
+			Text:       text,
+		}
+		for _, ev := range rc.Evidence {
+			if ev = strings.TrimSpace(ev); ev != "" {
+				claim.Evidence = append(claim.Evidence, ev)
+			}
+		}
+		for _, id := range rc.Rebuts {
+			if id = strings.ToLower(strings.TrimSpace(id)); known[id] && !slices.Contains(claim.Rebuts, id) {
+				claim.Rebuts = append(claim.Rebuts, id)
+			}
+		}
+		claims = append(claims, claim)
+		if len(claims) == maxClaimsPerTurn {
+			break
+		}
+	}
+	return claims, nil
+}
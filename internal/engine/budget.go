@@ -0,0 +1,39 @@
+package engine
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// budgetUsage totals what a debate has spent: the tokens and cost of every
+// turn, and the time since the current run started.
+func budgetUsage(turns []*core.Turn, started time.Time) core.Usage {
+	usage := core.Usage{Elapsed: time.Since(started)}
+	for _, t := range turns {
+		usage.AddTurn(t)
+	}
+	return usage
+}
+
+// finishOverBudget ends a run that reached its budget. The debate completes
+// with the turns it has, and its conclusion records why it stopped instead
+// of spending more on votes or a judge.
+func (e *Engine) finishOverBudget(debate *core.Debate, reason string, round int) error {
+	slog.Info("Debate reached its budget", "debate_id", debate.ID, "reason", reason)
+
+	now := time.Now()
+	debate.StopReason = reason
+	debate.Conclusions = append(debate.Conclusions, &core.Conclusion{
+		Round:   round,
+		Summary: fmt.Sprintf("Stopped early: %s. No conclusion was generated.", reason),
+	})
+	debate.Status = core.StatusCompleted
+	debate.CompletedAt = &now
+	if err := e.storage.UpdateDebate(debate); err != nil {
+		return fmt.Errorf("failed to update debate: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// StartDebate runs a debate in the background, detached from the caller's
+// context. It returns run.ErrAlreadyRunning if the debate is running here.
+func (e *Engine) StartDebate(id string, callback TurnCallback) error {
+	return e.runs.Start(id, func(ctx context.Context) error {
+		err := e.runDebate(ctx, id, callback)
+		if errors.Is(err, ErrAwaitingInput) {
+			return nil
+		}
+		return err
+	})
+}
+
+// PauseDebate stops a running debate; ResumeDebate continues it from the
+// next turn. Debates running in another process (such as the CLI) are
+// asked to pause before their next turn.
+func (e *Engine) PauseDebate(id string) error {
+	debate, err := e.controlledDebate(id)
+	if err != nil {
+		return err
+	}
+	if debate.Status != core.StatusInProgress {
+		return fmt.Errorf("debate is not running (status: %s)", debate.Status)
+	}
+
+	if e.runs.Pause(id) {
+		return nil
+	}
+	return e.storage.RequestStop(id, core.StatusPaused)
+}
+
+// ResumeDebate continues a paused or interrupted debate from its next turn
+// in the background.
+func (e *Engine) ResumeDebate(id string, callback TurnCallback) error {
+	debate, err := e.controlledDebate(id)
+	if err != nil {
+		return err
+	}
+	if !debate.Status.Resumable() {
+		return fmt.Errorf("debate is not paused (status: %s)", debate.Status)
+	}
+
+	if err := e.storage.ClearStopRequest(id); err != nil {
+		return err
+	}
+	return e.StartDebate(id, callback)
+}
+
+// CancelDebate stops a debate for good. Debates that are not running are
+// marked cancelled immediately.
+func (e *Engine) CancelDebate(id string) error {
+	debate, err := e.controlledDebate(id)
+	if err != nil {
+		return err
+	}
+
+	switch debate.Status {
+	case core.StatusCompleted, core.StatusCancelled:
+		return fmt.Errorf("debate is already %s", debate.Status)
+	case core.StatusInProgress:
+		if e.runs.Cancel(id) {
+			return nil
+		}
+		return e.storage.RequestStop(id, core.StatusCancelled)
+	}
+
+	debate.Status = core.StatusCancelled
+	return e.storage.UpdateDebate(debate)
+}
+
+// WaitDebate blocks until the debate's background run in this process ends.
+func (e *Engine) WaitDebate(ctx context.Context, id string) error {
+	return e.runs.Wait(ctx, id)
+}
+
+// IsRunning reports whether a debate is running in this process.
+func (e *Engine) IsRunning(id string) bool {
+	return e.runs.Running(id)
+}
+
+// controlledDebate loads a debate that is about to be paused, resumed, or cancelled.
+func (e *Engine) controlledDebate(id string) (*core.Debate, error) {
+	debate, err := e.storage.GetDebate(id)
+	if err != nil {
+		return nil, err
+	}
+	if debate == nil {
+		return nil, fmt.Errorf("debate not found: %s", id)
+	}
+	if debate.ReadOnly {
+		return nil, fmt.Errorf("debate is read-only")
+	}
+	return debate, nil
+}
+
+// stopRequested returns a pause or cancel requested from another process, if any.
+func (e *Engine) stopRequested(id string) core.DebateStatus {
+	status, err := e.storage.TakeStopRequest(id)
+	if err != nil {
+		slog.Warn("Failed to check stop request", "debate_id", id, "error", err)
+		return ""
+	}
+	return status
+}
+
+// stopDebate leaves an interrupted debate paused, cancelled, or failed.
+func (e *Engine) stopDebate(debate *core.Debate, status core.DebateStatus, cause error) error {
+	debate.Status = status
+	if err := e.storage.UpdateDebate(debate); err != nil {
+		slog.Error("Failed to save stopped debate", "debate_id", debate.ID, "error", err)
+	}
+	slog.Info("Debate stopped", "debate_id", debate.ID, "status", status)
+	return cause
+}
@@ -6,18 +6,22 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"math/rand"
 	"os"
 	"strings"
+	"sync"
 	"text/template"
 	"time"
 
+	"github.com/alienxp03/conclave/internal/coderef"
+	"github.com/alienxp03/conclave/internal/consensus"
 	"github.com/alienxp03/conclave/internal/core"
 	"github.com/alienxp03/conclave/internal/persona"
 	"github.com/alienxp03/conclave/internal/provider"
+	"github.com/alienxp03/conclave/internal/run"
 	"github.com/alienxp03/conclave/internal/storage"
 	"github.com/alienxp03/conclave/internal/style"
 	"github.com/alienxp03/conclave/internal/workspace"
+	baseprovider "github.com/alienxp03/conclave/provider"
 )
 
 // Engine orchestrates debate sessions.
@@ -25,6 +29,9 @@ type Engine struct {
 	storage    storage.Storage
 	registry   *provider.Registry
 	workspaces *workspace.Manager
+	runs       *run.Manager
+
+	argumentsMu sync.Mutex // Serializes argument extraction so each turn is extracted once
 }
 
 // New creates a new debate engine.
@@ -33,43 +40,86 @@ func New(store storage.Storage, registry *provider.Registry, workspaces *workspa
 		storage:    store,
 		registry:   registry,
 		workspaces: workspaces,
+		runs:       run.NewManager(store),
 	}
 }
 
 // CreateDebate creates a new debate session.
 func (e *Engine) CreateDebate(ctx context.Context, config core.NewDebateConfig) (*core.Debate, error) {
-	slog.Debug("Creating new debate", "topic", config.Topic, "agent_a", config.AgentAProvider, "agent_b", config.AgentBProvider)
-	// Validate providers
-	if config.AgentAProvider == "" {
-		return nil, fmt.Errorf("agent A provider is required")
+	slog.Debug("Creating new debate", "topic", config.Topic, "agent_a", config.AgentAProvider, "agent_b", config.AgentBProvider, "agents", len(config.Agents))
+
+	// Agents overrides the two-agent fields; missing personas are auto-assigned
+	specs := config.Agents
+	if len(specs) > 0 {
+		specs = core.AssignDefaultPersonas(specs)
+	} else {
+		specs = []core.MemberSpec{
+			{Provider: config.AgentAProvider, Model: config.AgentAModel, Persona: config.AgentAPersona, Stance: config.AgentAStance},
+			{Provider: config.AgentBProvider, Model: config.AgentBModel, Persona: config.AgentBPersona, Stance: config.AgentBStance},
+		}
 	}
-	providerA, err := e.registry.Get(config.AgentAProvider)
-	if err != nil {
-		return nil, fmt.Errorf("invalid provider for agent A: %w", err)
+	if len(specs) < 2 {
+		return nil, fmt.Errorf("a debate needs at least 2 agents, got %d", len(specs))
 	}
-	if !providerA.Available() {
-		return nil, fmt.Errorf("provider %s is not available (CLI not found)", config.AgentAProvider)
+	if !core.ValidSpeakingOrder(config.SpeakingOrder) {
+		return nil, fmt.Errorf("invalid speaking order: %s", config.SpeakingOrder)
 	}
-
-	if config.AgentBProvider == "" {
-		return nil, fmt.Errorf("agent B provider is required")
+	if c := config.Consensus; c != nil {
+		if !consensus.ValidMethod(c.Method) {
+			return nil, fmt.Errorf("invalid consensus method: %s", c.Method)
+		}
+		if c.Threshold < 0 || c.Threshold > 1 {
+			return nil, fmt.Errorf("consensus threshold must be between 0 and 1")
+		}
 	}
-	providerB, err := e.registry.Get(config.AgentBProvider)
-	if err != nil {
-		return nil, fmt.Errorf("invalid provider for agent B: %w", err)
+	if c := config.Stalemate; c != nil && (c.Threshold < 0 || c.Threshold > 1) {
+		return nil, fmt.Errorf("stalemate threshold must be between 0 and 1")
+	}
+	if err := validateSteelman(config.Steelman); err != nil {
+		return nil, err
 	}
-	if !providerB.Available() {
-		return nil, fmt.Errorf("provider %s is not available (CLI not found)", config.AgentBProvider)
+	if v := config.Vote; v != nil && len(v.Options) > 0 {
+		options, err := core.NormalizeVoteOptions(v.Options)
+		if err != nil {
+			return nil, err
+		}
+		config.Vote = &core.VoteConfig{Options: options}
 	}
 
-	// Validate personas (check builtin first, then storage)
-	personaADef := e.getPersona(config.AgentAPersona)
-	if personaADef == nil {
-		return nil, fmt.Errorf("invalid persona for agent A: %s", config.AgentAPersona)
+	// Validate providers and personas (check builtin first, then storage)
+	personaDefs := make([]*persona.Persona, len(specs))
+	summaryProvider, humans := "", 0
+	for i, spec := range specs {
+		label := agentLabel(i)
+		if spec.Provider == "" {
+			return nil, fmt.Errorf("%s provider is required", label)
+		}
+		if spec.Provider == core.ProviderHuman {
+			// A person holds this seat: no model or persona to check
+			humans++
+			continue
+		}
+		if summaryProvider == "" {
+			summaryProvider = spec.Provider
+		}
+		prov, err := e.registry.Get(spec.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("invalid provider for %s: %w", label, err)
+		}
+		if !prov.Available() {
+			return nil, fmt.Errorf("provider %s is not available (CLI not found)", spec.Provider)
+		}
+
+		personaDefs[i] = e.getPersona(spec.Persona)
+		if personaDefs[i] == nil {
+			return nil, fmt.Errorf("invalid persona for %s: %s", label, spec.Persona)
+		}
+	}
+	if humans > 1 {
+		return nil, fmt.Errorf("a debate can have at most one human participant, got %d", humans)
 	}
-	personaBDef := e.getPersona(config.AgentBPersona)
-	if personaBDef == nil {
-		return nil, fmt.Errorf("invalid persona for agent B: %s", config.AgentBPersona)
+	if summaryProvider == "" {
+		return nil, fmt.Errorf("a debate needs at least one model participant")
 	}
 
 	// Validate style (check builtin first, then storage)
@@ -78,29 +128,33 @@ func (e *Engine) CreateDebate(ctx context.Context, config core.NewDebateConfig)
 		return nil, fmt.Errorf("invalid debate style: %s", config.Style)
 	}
 
-	// Assign default models if empty
-	agentAModel := config.AgentAModel
-	if agentAModel == "" {
-		agentAModel = core.DefaultModelForProvider[config.AgentAProvider]
+	judge, err := e.buildJudge(config.Judge)
+	if err != nil {
+		return nil, err
 	}
-	agentBModel := config.AgentBModel
-	if agentBModel == "" {
-		agentBModel = core.DefaultModelForProvider[config.AgentBProvider]
+	moderator, err := e.buildModerator(config.Moderator)
+	if err != nil {
+		return nil, err
 	}
 
-	// Set defaults
+	stances := assignStances(specs, config.Style)
+
+	// Set defaults; a phased style decides its own turns per agent
 	maxTurns := config.MaxTurns
 	if maxTurns <= 0 {
 		maxTurns = 5
 	}
+	maxTurns = styleDef.TurnsPerAgent(maxTurns)
 
 	now := time.Now()
 	cwd, _ := os.Getwd()
 
 	// Generate masked names for blind evaluation
-	agentAID := core.GenerateID()
-	agentBID := core.GenerateID()
-	maskedNames := core.GenerateMaskedNamesForList([]string{agentAID, agentBID})
+	agentIDs := make([]string, len(specs))
+	for i := range specs {
+		agentIDs[i] = core.GenerateID()
+	}
+	maskedNames := core.GenerateMaskedNamesForList(agentIDs)
 
 	// Resolve workspace if specified
 	if config.WorkspaceID != "" {
@@ -116,6 +170,7 @@ func (e *Engine) CreateDebate(ctx context.Context, config core.NewDebateConfig)
 	}
 
 	var projectInstructions string
+	budget := config.Budget.WithDefaults(nil)
 	if config.ProjectID != "" {
 		project, err := e.storage.GetProject(config.ProjectID)
 		if err != nil {
@@ -125,6 +180,36 @@ func (e *Engine) CreateDebate(ctx context.Context, config core.NewDebateConfig)
 			return nil, fmt.Errorf("project not found")
 		}
 		projectInstructions = project.Instructions
+		budget = budget.WithDefaults(project.Budget)
+	}
+
+	agents := make([]core.Agent, len(specs))
+	for i, spec := range specs {
+		if spec.Provider == core.ProviderHuman {
+			agents[i] = core.Agent{
+				ID:         agentIDs[i],
+				Name:       "Human",
+				MaskedName: maskedNames[agentIDs[i]],
+				Provider:   core.ProviderHuman,
+				Stance:     stances[i],
+			}
+			continue
+		}
+
+		// Assign default models if empty
+		model := spec.Model
+		if model == "" {
+			model = core.DefaultModelForProvider[spec.Provider]
+		}
+		agents[i] = core.Agent{
+			ID:         agentIDs[i],
+			Name:       fmt.Sprintf("%s (%s) • %s", spec.Provider, personaDefs[i].Name, model),
+			MaskedName: maskedNames[agentIDs[i]],
+			Provider:   spec.Provider,
+			Model:      model,
+			Persona:    spec.Persona,
+			Stance:     stances[i],
+		}
 	}
 
 	debate := &core.Debate{
@@ -134,39 +219,60 @@ func (e *Engine) CreateDebate(ctx context.Context, config core.NewDebateConfig)
 		WorkspaceID:         config.WorkspaceID,
 		ProjectID:           config.ProjectID,
 		ProjectInstructions: projectInstructions,
-		AgentA: core.Agent{
-			ID:         agentAID,
-			Name:       fmt.Sprintf("%s (%s) • %s", config.AgentAProvider, personaADef.Name, agentAModel),
-			MaskedName: maskedNames[agentAID],
-			Provider:   config.AgentAProvider,
-			Model:      agentAModel,
-			Persona:    config.AgentAPersona,
-		},
-		AgentB: core.Agent{
-			ID:         agentBID,
-			Name:       fmt.Sprintf("%s (%s) • %s", config.AgentBProvider, personaBDef.Name, agentBModel),
-			MaskedName: maskedNames[agentBID],
-			Provider:   config.AgentBProvider,
-			Model:      agentBModel,
-			Persona:    config.AgentBPersona,
-		},
-		Style:     config.Style,
-		MaxTurns:  maxTurns,
-		Status:    core.StatusPending,
-		CreatedAt: now,
-		UpdatedAt: now,
-	}
+		SpeakingOrder:       config.SpeakingOrder,
+		Consensus:           config.Consensus,
+		Vote:                config.Vote,
+		Stalemate:           config.Stalemate,
+		Steelman:            config.Steelman,
+		Judge:               judge,
+		Moderator:           moderator,
+		Budget:              budget,
+		Style:               config.Style,
+		MaxTurns:            maxTurns,
+		Status:              core.StatusPending,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+	debate.SetParticipants(agents)
 
 	if err := e.storage.CreateDebate(debate); err != nil {
 		return nil, fmt.Errorf("failed to create debate: %w", err)
 	}
 
 	// Summarize topic in background
-	go e.AutoSummarize(debate.ID, config.Topic, config.AgentAProvider)
+	go e.AutoSummarize(debate.ID, config.Topic, summaryProvider)
 
 	return debate, nil
 }
 
+// assignStances returns each agent's normalized stance. Adversarial debates
+// without any stances alternate FOR and AGAINST so every agent knows its side.
+func assignStances(specs []core.MemberSpec, styleID string) []string {
+	stances := make([]string, len(specs))
+	assigned := false
+	for i, spec := range specs {
+		stances[i] = core.NormalizeStance(spec.Stance)
+		assigned = assigned || stances[i] != ""
+	}
+	if !assigned && styleID == "adversarial" {
+		for i := range stances {
+			stances[i] = core.StanceFor
+			if i%2 == 1 {
+				stances[i] = core.StanceAgainst
+			}
+		}
+	}
+	return stances
+}
+
+// agentLabel returns the human label used in validation errors ("agent A", "agent B", ...).
+func agentLabel(i int) string {
+	if i < 26 {
+		return fmt.Sprintf("agent %c", 'A'+i)
+	}
+	return fmt.Sprintf("agent %d", i+1)
+}
+
 // AutoSummarize generates a summary title and updates the debate.
 func (e *Engine) AutoSummarize(id, topic, providerName string) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -247,12 +353,15 @@ func (e *Engine) getStyle(id string) *style.Style {
 	}
 
 	return &style.Style{
-		ID:               stored.ID,
-		Name:             stored.Name,
-		Description:      stored.Description,
-		OpeningPrompt:    stored.OpeningPrompt,
-		ResponsePrompt:   stored.ResponsePrompt,
-		ConclusionPrompt: stored.ConclusionPrompt,
+		ID:                 stored.ID,
+		Name:               stored.Name,
+		Description:        stored.Description,
+		OpeningPrompt:      stored.OpeningPrompt,
+		ResponsePrompt:     stored.ResponsePrompt,
+		ConclusionPrompt:   stored.ConclusionPrompt,
+		ConsensusMethod:    stored.ConsensusMethod,
+		ConsensusThreshold: stored.ConsensusThreshold,
+		Phases:             stored.Phases,
 	}
 }
 
@@ -301,8 +410,16 @@ func (e *Engine) DeleteDebate(id string) error {
 // TurnCallback is called after each turn completes.
 type TurnCallback func(turn *core.Turn, debate *core.Debate)
 
-// RunDebate executes the entire debate from start to finish.
+// RunDebate executes the entire debate from start to finish. A paused debate
+// continues from its next turn. The run can be stopped with PauseDebate or
+// CancelDebate while it is in progress.
 func (e *Engine) RunDebate(ctx context.Context, debateID string, callback TurnCallback) error {
+	return e.runs.Run(ctx, debateID, func(ctx context.Context) error {
+		return e.runDebate(ctx, debateID, callback)
+	})
+}
+
+func (e *Engine) runDebate(ctx context.Context, debateID string, callback TurnCallback) error {
 	debate, err := e.storage.GetDebate(debateID)
 	if err != nil {
 		return fmt.Errorf("failed to get debate: %w", err)
@@ -314,52 +431,62 @@ func (e *Engine) RunDebate(ctx context.Context, debateID string, callback TurnCa
 	// Ensure masked names for backward compatibility
 	e.ensureMaskedNames(debate)
 
-	// Update status to in progress
+	// Update status to in progress; the budget's clock starts now
+	started := time.Now()
 	debate.Status = core.StatusInProgress
+	debate.StopReason = ""
 	if err := e.storage.UpdateDebate(debate); err != nil {
 		return fmt.Errorf("failed to update debate status: %w", err)
 	}
 
 	// Get current turns to determine progress
 	turns, _ := e.storage.GetTurns(debateID)
-	currentRound := 1
-	if len(turns) > 0 {
-		currentRound = turns[len(turns)-1].Round
-	}
+	currentRound, turnsInRound := debateProgress(turns)
+	debate.CompletedTurns, debate.FailedTurns = turnResults(turns)
 
-	// Count agent turns in current round
-	turnsInRound := 0
-	for _, t := range turns {
-		if t.Round == currentRound && t.AgentID != "user" {
-			turnsInRound++
-		}
+	// Decide the speaking order (consistent for the round)
+	agents := roundSpeakers(debate, currentRound)
+	targeting := roundTargeting(turns, currentRound)
+	if targeting != nil {
+		agents = targetedSpeakers(agents, targeting)
 	}
-
-	// Decide who starts the round (consistent for the round)
-	agents := []core.Agent{debate.AgentA, debate.AgentB}
-	// Use debate ID + round as seed
-	r := rand.New(rand.NewSource(int64(hashString(debate.ID) + uint32(currentRound))))
-	r.Shuffle(len(agents), func(i, j int) { agents[i], agents[j] = agents[j], agents[i] })
+	participantCount := len(agents)
 
 	// Execute remaining turns in round
-	totalTurnsInRound := debate.MaxTurns * 2
+	totalTurnsInRound := roundTurns(debate, turns, currentRound)
 	earlyConsensus := false
+	var lastConsensus *core.ConsensusResult
+	var stalemateResult *core.StalemateResult
 
 	for i := turnsInRound + 1; i <= totalTurnsInRound; i++ {
 		select {
 		case <-ctx.Done():
-			debate.Status = core.StatusFailed
-			e.storage.UpdateDebate(debate)
-			return ctx.Err()
+			return e.stopDebate(debate, run.StopStatus(ctx), context.Cause(ctx))
 		default:
 		}
+		if status := e.stopRequested(debate.ID); status != "" {
+			return e.stopDebate(debate, status, run.StatusError(status))
+		}
+		if reason := debate.Budget.Exceeded(budgetUsage(turns, started)); reason != "" {
+			return e.finishOverBudget(debate, reason, currentRound)
+		}
 
-		// Alternate between agents
-		currentAgent := agents[(i-1)%2]
+		// Rotate through agents (or let the moderator choose)
+		currentAgent := agents[(i-1)%participantCount]
+		if debate.SpeakingOrder == core.SpeakingOrderModerator && targeting == nil {
+			currentAgent = e.moderatorPick(ctx, debate, turns, currentRound)
+		}
+		if currentAgent.IsHuman() {
+			return e.awaitHuman(debate)
+		}
 		isLastTurn := i == totalTurnsInRound
 		turnNum := len(turns) + 1
 
 		turn, err := e.executeTurn(ctx, debate, currentAgent, turnNum, isLastTurn)
+		if err != nil && ctx.Err() != nil {
+			// Interrupted mid-turn: the turn is retried on resume
+			return e.stopDebate(debate, run.StopStatus(ctx), context.Cause(ctx))
+		}
 		if err != nil {
 			// Create failed turn record
 			failedTurn := &core.Turn{
@@ -413,18 +540,53 @@ func (e *Engine) RunDebate(ctx context.Context, debateID string, callback TurnCa
 			callback(turn, debate)
 		}
 
-		// Check for early consensus after each complete round (both agents spoke)
-		// Start checking after 2 turns in current round (1 round of discussion)
-		if i >= 2 && i%2 == 0 && i < totalTurnsInRound {
-			if e.checkEarlyConsensus(ctx, debate) {
-				earlyConsensus = true
+		// Check for early consensus after each complete rotation (every agent spoke)
+		// Start checking after one rotation in the current round
+		if i >= participantCount && i%participantCount == 0 && i < totalTurnsInRound {
+			if result := e.checkEarlyConsensus(ctx, debate); result != nil {
+				lastConsensus = result
+				if result.Reached {
+					earlyConsensus = true
+					break
+				}
+			}
+
+			// Agents going in circles end the round early
+			if result := e.checkStalemate(ctx, debate, currentRound); result != nil {
+				stalemateResult = result
 				break
 			}
+
+			// The moderator takes stock before the next rotation
+			if debate.Moderator != nil {
+				modTurn, err := e.moderate(ctx, debate, turns, currentRound)
+				if err != nil && ctx.Err() != nil {
+					return e.stopDebate(debate, run.StopStatus(ctx), context.Cause(ctx))
+				}
+				if err != nil {
+					slog.Warn("Moderator failed, continuing without a question", "debate_id", debate.ID, "error", err)
+				} else {
+					turns = append(turns, modTurn)
+					if callback != nil {
+						callback(modTurn, debate)
+					}
+				}
+			}
 		}
 	}
 
+	if status := e.stopRequested(debate.ID); status != "" {
+		return e.stopDebate(debate, status, run.StatusError(status))
+	}
+	if reason := debate.Budget.Exceeded(budgetUsage(turns, started)); reason != "" {
+		return e.finishOverBudget(debate, reason, currentRound)
+	}
+
 	// Generate conclusion
 	conclusion, err := e.generateConclusion(ctx, debate)
+	if err != nil && ctx.Err() != nil {
+		return e.stopDebate(debate, run.StopStatus(ctx), context.Cause(ctx))
+	}
 	if err != nil {
 		// Don't fail the whole debate, just log
 		conclusion = &core.Conclusion{
@@ -436,6 +598,11 @@ func (e *Engine) RunDebate(ctx context.Context, debateID string, callback TurnCa
 	if earlyConsensus {
 		conclusion.EarlyConsensus = true
 	}
+	conclusion.Consensus = lastConsensus
+	if stalemateResult != nil {
+		conclusion.Type = core.ConclusionStalemate
+		conclusion.Stalemate = stalemateResult
+	}
 
 	// Set round for conclusion
 	turns, _ = e.storage.GetTurns(debate.ID)
@@ -474,105 +641,127 @@ func (e *Engine) RunDebate(ctx context.Context, debateID string, callback TurnCa
 	return nil
 }
 
-// checkEarlyConsensus checks if both agents have reached agreement.
-func (e *Engine) checkEarlyConsensus(ctx context.Context, debate *core.Debate) bool {
-	turns, err := e.storage.GetTurns(debate.ID)
-	if err != nil || len(turns) < 2 {
-		return false
-	}
-
-	// Get last two turns (one from each agent)
-	lastTurn := turns[len(turns)-1]
-	prevTurn := turns[len(turns)-2]
-
-	// Check for consensus signals in recent responses
-	consensusSignals := []string{
-		"i agree",
-		"we agree",
-		"consensus",
-		"common ground",
-		"we've reached",
-		"i concur",
-		"you're right",
-		"you are right",
-		"that's a fair point",
-		"i accept",
-		"we can conclude",
-		"in agreement",
-	}
-
-	lastLower := strings.ToLower(lastTurn.Content)
-	prevLower := strings.ToLower(prevTurn.Content)
-
-	lastHasSignal := false
-	prevHasSignal := false
-
-	for _, signal := range consensusSignals {
-		if strings.Contains(lastLower, signal) {
-			lastHasSignal = true
-		}
-		if strings.Contains(prevLower, signal) {
-			prevHasSignal = true
-		}
+// consensusConfig resolves how a debate detects consensus: the debate's own
+// config wins, then its style's, then the default hybrid detector.
+func (e *Engine) consensusConfig(debate *core.Debate) core.ConsensusConfig {
+	if debate.Consensus != nil && debate.Consensus.Method != "" {
+		return *debate.Consensus
 	}
 
-	// If both recent turns show agreement signals, verify with a quick check
-	if lastHasSignal && prevHasSignal {
-		return e.verifyConsensus(ctx, debate)
+	cfg := core.ConsensusConfig{Method: core.ConsensusHybrid}
+	if styleDef := e.getStyle(debate.Style); styleDef != nil && styleDef.ConsensusMethod != "" {
+		cfg.Method = styleDef.ConsensusMethod
+		cfg.Threshold = styleDef.ConsensusThreshold
 	}
-
-	return false
+	if debate.Consensus != nil {
+		// A debate may only tune the threshold or keywords of its style's method
+		if debate.Consensus.Threshold > 0 {
+			cfg.Threshold = debate.Consensus.Threshold
+		}
+		cfg.Keywords = debate.Consensus.Keywords
+	}
+	return cfg
 }
 
-// verifyConsensus asks one agent to confirm if consensus has been reached.
-func (e *Engine) verifyConsensus(ctx context.Context, debate *core.Debate) bool {
-	prov, err := e.registry.Get(debate.AgentA.Provider)
+// checkEarlyConsensus runs the debate's consensus detector over the last
+// rotation of turns (one from each agent). It returns nil when there are
+// not enough turns or the detector fails.
+func (e *Engine) checkEarlyConsensus(ctx context.Context, debate *core.Debate) *core.ConsensusResult {
+	participants := debate.Participants()
+	turns, err := e.storage.GetTurns(debate.ID)
 	if err != nil {
-		return false
+		return nil
 	}
 
-	turns, _ := e.storage.GetTurns(debate.ID)
-	history := e.buildDebateHistory(debate, turns)
-
-	instructionBlock := ""
-	if instructions := formatProjectInstructions(debate.ProjectInstructions); instructions != "" {
-		instructionBlock = "\n\n" + instructions
+	var debateTurns []*core.Turn
+	for _, t := range turns {
+		if !isDebateTurn(t) {
+			continue
+		}
+		if _, ok := debate.AgentByID(t.AgentID); ok {
+			debateTurns = append(debateTurns, t)
+		}
+	}
+	if len(debateTurns) < len(participants) {
+		return nil
 	}
 
-	prompt := fmt.Sprintf(`You are reviewing a debate on: "%s"%s
-
-Recent discussion:
-%s
-
-Based on the last few exchanges, have both participants clearly reached a consensus or agreement on the main points?
-
-Answer with only YES or NO.`, debate.Topic, instructionBlock, history)
-
-	var response string
-	if debate.AgentA.Model != "" {
-		response, err = prov.GenerateWithDir(ctx, prompt, debate.AgentA.Model, debate.CWD)
-	} else {
-		response, err = prov.GenerateWithDir(ctx, prompt, prov.DefaultModel(), debate.CWD)
+	cfg := e.consensusConfig(debate)
+	detector, err := consensus.New(cfg, e.consensusGenerator(debate))
+	if err != nil {
+		slog.Warn("Failed to build consensus detector", "debate_id", debate.ID, "error", err)
+		return nil
 	}
 
+	result, err := consensus.Evaluate(ctx, detector, cfg.Threshold, consensus.Input{
+		Topic:        debate.Topic,
+		Agents:       participants,
+		Turns:        debateTurns[len(debateTurns)-len(participants):],
+		History:      e.buildDebateHistory(debate, turns),
+		Instructions: formatProjectInstructions(debate.ProjectInstructions),
+	})
 	if err != nil {
-		return false
+		slog.Warn("Consensus check failed", "debate_id", debate.ID, "method", cfg.Method, "error", err)
+		return nil
 	}
+	return result
+}
 
-	responseLower := strings.ToLower(strings.TrimSpace(response))
-	return strings.HasPrefix(responseLower, "yes")
+// consensusGenerator returns the model used by LLM-based consensus
+// detection and the other reviews of a debate (stalemate confirmation,
+// argument extraction): the judge if the debate has one, otherwise the
+// first model participant.
+func (e *Engine) consensusGenerator(debate *core.Debate) consensus.GenerateFunc {
+	agent := debate.ModelParticipants()[0]
+	if debate.Judge != nil {
+		agent = *debate.Judge
+	}
+	return e.agentGenerator(debate, agent)
+}
+
+// agentGenerator returns a GenerateFunc that prompts agent's model in the
+// debate's working directory.
+func (e *Engine) agentGenerator(debate *core.Debate, agent core.Agent) consensus.GenerateFunc {
+	return func(ctx context.Context, prompt string) (string, error) {
+		prov, err := e.registry.Get(agent.Provider)
+		if err != nil {
+			return "", err
+		}
+		model := agent.Model
+		if model == "" {
+			model = prov.DefaultModel()
+		}
+		return prov.GenerateWithDir(ctx, prompt, model, debate.CWD)
+	}
 }
 
 // executeTurn executes a single turn in the debate.
 func (e *Engine) executeTurn(ctx context.Context, debate *core.Debate, agent core.Agent, turnNum int, isLastTurn bool) (*core.Turn, error) {
-	// Get provider
-	prov, err := e.registry.Get(agent.Provider)
+	// Get previous turns
+	turns, err := e.storage.GetTurns(debate.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get previous turns
-	turns, err := e.storage.GetTurns(debate.ID)
+	turn, err := e.generateTurn(ctx, debate, agent, turns, turnNum, isLastTurn)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.storage.AddTurn(turn); err != nil {
+		return nil, fmt.Errorf("failed to save turn: %w", err)
+	}
+
+	slog.Debug("Turn execution completed", "turn_id", turn.ID, "agent", turn.AgentID,
+		"input_tokens", turn.InputTokens, "output_tokens", turn.OutputTokens)
+	return turn, nil
+}
+
+// generateTurn prompts an agent for turn turnNum given the turns before it
+// and returns the unsaved turn.
+func (e *Engine) generateTurn(ctx context.Context, debate *core.Debate, agent core.Agent, turns []*core.Turn, turnNum int, isLastTurn bool) (*core.Turn, error) {
+	// Get provider
+	prov, err := e.registry.Get(agent.Provider)
 	if err != nil {
 		return nil, err
 	}
@@ -589,7 +778,14 @@ func (e *Engine) executeTurn(ctx context.Context, debate *core.Debate, agent cor
 		model = prov.DefaultModel()
 	}
 
-	resp, err := prov.GenerateWithResponseDir(ctx, prompt, model, debate.CWD)
+	// Steelman debates restate the argument being answered before rebutting it
+	var resp *baseprovider.Response
+	var steelman *core.SteelmanScore
+	if target := steelmanTarget(debate, agent, turns); debate.Steelman != nil && target != nil {
+		resp, steelman, err = e.generateSteelmanTurn(ctx, debate, prov, model, prompt, target)
+	} else {
+		resp, err = prov.GenerateWithResponseDir(ctx, prompt, model, debate.CWD)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate response: %w", err)
 	}
@@ -611,6 +807,11 @@ func (e *Engine) executeTurn(ctx context.Context, debate *core.Debate, agent cor
 		TurnType:  core.TurnTypeDebate,
 		Model:     model,
 		Status:    "completed",
+		Novelty:   measureNovelty(agent.ID, resp.Content, turns),
+		Steelman:  steelman,
+
+		// Code the agent cites is checked against the workspace it ran in
+		References: coderef.Check(debate.CWD, resp.Content),
 	}
 
 	// Copy metadata from response
@@ -620,20 +821,11 @@ func (e *Engine) executeTurn(ctx context.Context, debate *core.Debate, agent cor
 		turn.TotalTokens = resp.Metadata.TotalTokens
 		turn.DurationMs = resp.Metadata.Duration.Milliseconds()
 		turn.StopReason = resp.Metadata.StopReason
+		turn.TokensEstimated = resp.Metadata.Estimated
+		turn.InputEstimated = resp.Metadata.InputEstimated
+		turn.OutputEstimated = resp.Metadata.OutputEstimated
+		turn.CostUSD = resp.Metadata.CostUSD
 	}
-
-	if err := e.storage.AddTurn(turn); err != nil {
-		return nil, fmt.Errorf("failed to save turn: %w", err)
-	}
-
-	// Check for consensus or max turns
-	if err := e.checkConclusion(ctx, debate, turns); err != nil {
-		// Log error but don't fail the turn
-		slog.Error("Failed to check conclusion", "error", err)
-	}
-
-	slog.Debug("Turn execution completed", "turn_id", turn.ID, "agent", turn.AgentID,
-		"input_tokens", turn.InputTokens, "output_tokens", turn.OutputTokens)
 	return turn, nil
 }
 
@@ -646,8 +838,22 @@ func (e *Engine) buildPrompt(debate *core.Debate, agent core.Agent, turns []*cor
 		return "", fmt.Errorf("invalid persona or style")
 	}
 
-	var promptTemplate string
-	if turnNum == 1 || (turnNum == 2 && len(turns) == 0) {
+	round, taken := debateProgress(turns)
+	var promptTemplate, phaseName, roleName string
+	var phaseTurn int
+	if len(styleDef.Phases) > 0 {
+		// Phased styles pick the prompt from the turn's position in the round
+		// and the agent's role from its position in the speaking order
+		var phase *core.Phase
+		phase, phaseTurn = styleDef.PhaseAt(taken+1, len(debate.Participants()), debate.MaxTurns)
+		phaseName, promptTemplate = phase.Name, phase.Prompt
+		if role := phase.RoleAt(speakingPosition(debate, agent, round)); role != nil {
+			roleName = role.Name
+			if role.Prompt != "" {
+				promptTemplate = role.Prompt
+			}
+		}
+	} else if turnNum == 1 || (turnNum == 2 && len(turns) == 0) {
 		// First turn for this agent
 		promptTemplate = styleDef.OpeningPrompt
 	} else if isLastTurn {
@@ -656,46 +862,62 @@ func (e *Engine) buildPrompt(debate *core.Debate, agent core.Agent, turns []*cor
 		promptTemplate = styleDef.ResponsePrompt
 	}
 
-	// Get the other agent
-	var otherAgent core.Agent
-	if agent.ID == debate.AgentA.ID {
-		otherAgent = debate.AgentB
-	} else {
-		otherAgent = debate.AgentA
+	// The other agent is whoever spoke last besides this agent
+	otherAgent := previousOtherSpeaker(debate, agent, turns)
+	var otherNames, participantNames, positions []string
+	for _, a := range debate.Participants() {
+		participantNames = append(participantNames, a.MaskedName)
+		if a.ID != agent.ID {
+			otherNames = append(otherNames, a.MaskedName)
+		}
+		if a.Stance != "" {
+			positions = append(positions, fmt.Sprintf("- %s: %s", a.MaskedName, core.DescribeStance(a.Stance)))
+		}
 	}
+	nameByID := maskedNamesByID(debate)
 
-	// Build previous argument
+	// Build previous argument (the moderator's summaries are not arguments)
 	var previousArgument string
-	if len(turns) > 0 {
-		previousArgument = turns[len(turns)-1].Content
+	for i := len(turns) - 1; i >= 0; i-- {
+		if turns[i].TurnType != core.TurnTypeModerator {
+			previousArgument = turns[i].Content
+			break
+		}
 	}
 
 	// Build debate history
 	var historyBuilder strings.Builder
 	for _, t := range turns {
-		var agentName string
-		if t.AgentID == debate.AgentA.ID {
-			agentName = debate.AgentA.MaskedName
-		} else if t.AgentID == debate.AgentB.ID {
-			agentName = debate.AgentB.MaskedName
-		} else if t.AgentID == "user" {
-			agentName = "User (Follow-up)"
-		} else {
-			agentName = "Unknown"
+		agentName, ok := nameByID[t.AgentID]
+		if !ok {
+			if t.AgentID == "user" {
+				agentName = followUpLabel(t.Targeting, nameByID)
+			} else {
+				agentName = "Unknown"
+			}
 		}
 		historyBuilder.WriteString(fmt.Sprintf("\n--- %s (Round %d, Turn %d) ---\n%s\n", agentName, t.Round, t.Number, t.Content))
 	}
 
 	// Template data - use masked names for blind evaluation
 	data := map[string]interface{}{
-		"Topic":            debate.Topic,
-		"AgentName":        agent.MaskedName,
-		"OtherAgentName":   otherAgent.MaskedName,
-		"PreviousArgument": previousArgument,
-		"DebateHistory":    historyBuilder.String(),
-		"TurnNumber":       turnNum,
-		"MaxTurns":         debate.MaxTurns * 2,
-		"IsQuestioner":     turnNum%2 == 1 && debate.Style == "socratic",
+		"Topic":             debate.Topic,
+		"AgentName":         agent.MaskedName,
+		"OtherAgentName":    otherAgent.MaskedName,
+		"OtherAgentNames":   strings.Join(otherNames, ", "),
+		"Participants":      participantNames,
+		"PreviousArgument":  previousArgument,
+		"DebateHistory":     historyBuilder.String(),
+		"TurnNumber":        turnNum,
+		"MaxTurns":          debate.TotalTurns(),
+		"IsQuestioner":      strings.EqualFold(roleName, "questioner"),
+		"Phase":             phaseName,
+		"PhaseTurn":         phaseTurn,
+		"Role":              roleName,
+		"Stance":            core.DescribeStance(agent.Stance),
+		"OtherAgentStance":  core.DescribeStance(otherAgent.Stance),
+		"Positions":         strings.Join(positions, "\n"),
+		"ModeratorQuestion": latestModeratorQuestion(turns, round),
 	}
 
 	// Parse and execute template
@@ -720,6 +942,54 @@ func (e *Engine) buildPrompt(debate *core.Debate, agent core.Agent, turns []*cor
 	return fullPrompt, nil
 }
 
+// speakingPosition returns an agent's 0-based position in a round's
+// speaking order, which decides its role in phased styles.
+func speakingPosition(debate *core.Debate, agent core.Agent, round int) int {
+	for i, a := range roundSpeakers(debate, round) {
+		if a.ID == agent.ID {
+			return i
+		}
+	}
+	return 0
+}
+
+// previousOtherSpeaker returns the most recent speaker other than agent.
+// Before anyone else has spoken it returns the next participant in declaration order.
+func previousOtherSpeaker(debate *core.Debate, agent core.Agent, turns []*core.Turn) core.Agent {
+	for i := len(turns) - 1; i >= 0; i-- {
+		if turns[i].AgentID == agent.ID {
+			continue
+		}
+		if other, ok := debate.AgentByID(turns[i].AgentID); ok {
+			return other
+		}
+	}
+
+	participants := debate.Participants()
+	for i, a := range participants {
+		if a.ID == agent.ID {
+			return participants[(i+1)%len(participants)]
+		}
+	}
+	return participants[0]
+}
+
+// maskedNamesByID maps each participant's ID (and the judge's and moderator's)
+// to its masked name.
+func maskedNamesByID(debate *core.Debate) map[string]string {
+	names := make(map[string]string)
+	for _, a := range debate.Participants() {
+		names[a.ID] = a.MaskedName
+	}
+	if debate.Judge != nil {
+		names[debate.Judge.ID] = debate.Judge.MaskedName
+	}
+	if debate.Moderator != nil {
+		names[debate.Moderator.ID] = debate.Moderator.MaskedName
+	}
+	return names
+}
+
 func formatProjectInstructions(instructions string) string {
 	trimmed := strings.TrimSpace(instructions)
 	if trimmed == "" {
@@ -735,25 +1005,77 @@ func (e *Engine) generateConclusion(ctx context.Context, debate *core.Debate) (*
 		return nil, err
 	}
 
+	// A conclusion interrupted by a pause or crash starts over rather than
+	// adding a second set of votes
+	if len(turns) > 0 {
+		if turns, err = e.discardConclusionTurns(turns, turns[len(turns)-1].Round); err != nil {
+			return nil, fmt.Errorf("failed to discard interrupted conclusion: %w", err)
+		}
+	}
+
 	// Build history
 	history := e.buildDebateHistory(debate, turns)
 
-	conclusion := &core.Conclusion{}
+	// How faithfully agents restated each other, for steelman debates
+	var steelman []*core.SteelmanSummary
+	if debate.Steelman != nil && len(turns) > 0 {
+		steelman = core.SummarizeSteelman(turns, turns[len(turns)-1].Round, debate.AgentIDs())
+	}
 
-	// Get votes from both agents
-	voteA, err := e.getAgentVote(ctx, debate, debate.AgentA, history)
-	if err == nil {
-		conclusion.AgentAVote = voteA
+	// An independent judge replaces self-voting when configured
+	if debate.Judge != nil {
+		conclusion, err := e.judgeConclusion(ctx, debate, history)
+		if err == nil {
+			conclusion.Steelman = steelman
+			return conclusion, nil
+		}
+		slog.Warn("Judge failed, falling back to agent votes", "debate_id", debate.ID, "error", err)
 	}
 
-	voteB, err := e.getAgentVote(ctx, debate, debate.AgentB, history)
-	if err == nil {
-		conclusion.AgentBVote = voteB
+	conclusion := &core.Conclusion{Steelman: steelman}
+
+	// Debates with a vote config choose between options instead
+	options, err := e.voteOptions(ctx, debate, history)
+	if err != nil {
+		slog.Warn("Falling back to agreement votes", "debate_id", debate.ID, "error", err)
 	}
 
-	// Determine consensus based on votes
-	if conclusion.AgentAVote != nil && conclusion.AgentBVote != nil {
-		conclusion.Agreed = conclusion.AgentAVote.Agrees && conclusion.AgentBVote.Agrees
+	// Get votes from every model participant; a human seat does not vote
+	participants := debate.ModelParticipants()
+	for _, agent := range participants {
+		var vote *core.Vote
+		if options != nil {
+			vote, err = e.getOptionVote(ctx, debate, agent, history, options)
+		} else {
+			vote, err = e.getAgentVote(ctx, debate, agent, history)
+		}
+		if err != nil {
+			slog.Warn("Failed to get agent vote", "agent", agent.Name, "error", err)
+			continue
+		}
+		conclusion.Votes = append(conclusion.Votes, vote)
+	}
+	if options != nil {
+		conclusion.Tally = core.TallyVotes(options, conclusion.Votes)
+		for _, vote := range conclusion.Votes {
+			vote.Agrees = conclusion.Tally.Winner != "" && vote.Option == conclusion.Tally.Winner
+		}
+	}
+	conclusion.AgentAVote = conclusion.VoteFor(debate.AgentA.ID)
+	conclusion.AgentBVote = conclusion.VoteFor(debate.AgentB.ID)
+
+	// Determine consensus based on votes (everyone must vote and agree,
+	// or pick the same option). With a human seat this is the models'
+	// agreement only.
+	_, conclusion.ModelVotesOnly = debate.HumanAgent()
+	if len(conclusion.Votes) == len(participants) {
+		conclusion.Agreed = true
+		for _, vote := range conclusion.Votes {
+			if !vote.Agrees {
+				conclusion.Agreed = false
+				break
+			}
+		}
 	}
 
 	// Generate summary
@@ -777,15 +1099,31 @@ func (e *Engine) generateConclusion(ctx context.Context, debate *core.Debate) (*
 	return conclusion, nil
 }
 
+// followUpLabel names a user follow-up in prompts, with the agents it
+// addresses if it is targeted.
+func followUpLabel(targeting *core.Targeting, nameByID map[string]string) string {
+	if targeting == nil {
+		return "User (Follow-up)"
+	}
+	names := make([]string, 0, len(targeting.AgentIDs))
+	for _, id := range targeting.AgentIDs {
+		names = append(names, nameByID[id])
+	}
+	return fmt.Sprintf("User (Follow-up to %s)", strings.Join(names, ", "))
+}
+
 // buildDebateHistory builds a formatted string of the debate history.
 func (e *Engine) buildDebateHistory(debate *core.Debate, turns []*core.Turn) string {
+	nameByID := maskedNamesByID(debate)
 	var historyBuilder strings.Builder
 	for _, t := range turns {
-		var agentName string
-		if t.AgentID == debate.AgentA.ID {
-			agentName = debate.AgentA.MaskedName
-		} else {
-			agentName = debate.AgentB.MaskedName
+		agentName, ok := nameByID[t.AgentID]
+		if !ok {
+			if t.AgentID == "user" {
+				agentName = followUpLabel(t.Targeting, nameByID)
+			} else {
+				agentName = "Unknown"
+			}
 		}
 		historyBuilder.WriteString(fmt.Sprintf("\n--- %s (Turn %d) ---\n%s\n", agentName, t.Number, t.Content))
 	}
@@ -804,12 +1142,17 @@ func (e *Engine) getAgentVote(ctx context.Context, debate *core.Debate, agent co
 		instructionBlock = "\n\n" + instructions
 	}
 
+	others := "your opponent"
+	if len(debate.Participants()) > 2 {
+		others = "the other participants"
+	}
+
 	votePrompt := fmt.Sprintf(`You participated in a debate on: "%s"%s
 
 Here is the full debate:
 %s
 
-Now it's time to conclude. Please vote on whether you and your opponent reached a meaningful consensus.
+Now it's time to conclude. Please vote on whether you and %s reached a meaningful consensus.
 
 Consider:
 - Did you find common ground on the main points?
@@ -818,7 +1161,7 @@ Consider:
 
 Respond in this exact format:
 VOTE: [AGREE/DISAGREE]
-REASONING: [Brief explanation of your vote - 1-2 sentences]`, debate.Topic, instructionBlock, history)
+REASONING: [Brief explanation of your vote - 1-2 sentences]`, debate.Topic, instructionBlock, history, others)
 
 	model := agent.Model
 	if model == "" {
@@ -831,34 +1174,7 @@ REASONING: [Brief explanation of your vote - 1-2 sentences]`, debate.Topic, inst
 	}
 
 	// Save vote as a turn for metadata tracking
-	turns, _ := e.storage.GetTurns(debate.ID)
-	turnNum := len(turns) + 1
-	round := 1
-	if len(turns) > 0 {
-		round = turns[len(turns)-1].Round
-	}
-
-	turn := &core.Turn{
-		ID:        core.GenerateID(),
-		DebateID:  debate.ID,
-		AgentID:   agent.ID,
-		Number:    turnNum,
-		Round:     round,
-		Content:   resp.Content,
-		CreatedAt: time.Now(),
-		TurnType:  core.TurnTypeVote,
-		Model:     model,
-	}
-	if resp.Metadata != nil {
-		turn.InputTokens = resp.Metadata.InputTokens
-		turn.OutputTokens = resp.Metadata.OutputTokens
-		turn.TotalTokens = resp.Metadata.TotalTokens
-		turn.DurationMs = resp.Metadata.Duration.Milliseconds()
-		turn.StopReason = resp.Metadata.StopReason
-	}
-	if err := e.storage.AddTurn(turn); err != nil {
-		slog.Warn("Failed to save vote turn", "error", err)
-	}
+	e.saveConclusionTurn(debate, agent.ID, core.TurnTypeVote, model, resp)
 
 	vote := &core.Vote{
 		AgentID: agent.ID,
@@ -883,16 +1199,34 @@ REASONING: [Brief explanation of your vote - 1-2 sentences]`, debate.Topic, inst
 	return vote, nil
 }
 
-// generateSummary generates the final summary of the debate.
+// generateSummary generates the final summary of the debate with the first
+// model participant, since a human seat has no model.
 func (e *Engine) generateSummary(ctx context.Context, debate *core.Debate, history string, conclusion *core.Conclusion) (string, error) {
-	prov, err := e.registry.Get(debate.AgentA.Provider)
+	agent := debate.ModelParticipants()[0]
+	prov, err := e.registry.Get(agent.Provider)
 	if err != nil {
 		return "", err
 	}
 
 	consensusStatus := "No consensus was reached."
 	if conclusion.Agreed {
-		consensusStatus = "Both agents agreed on a consensus."
+		switch models := len(debate.ModelParticipants()); {
+		case conclusion.ModelVotesOnly && models == 1:
+			consensusStatus = "The AI participant voted that a consensus was reached; the human participant did not vote."
+		case conclusion.ModelVotesOnly:
+			consensusStatus = "The AI participants agreed on a consensus; the human participant did not vote."
+		case len(debate.Participants()) > 2:
+			consensusStatus = "All participants agreed on a consensus."
+		default:
+			consensusStatus = "Both agents agreed on a consensus."
+		}
+	}
+
+	if conclusion.Tally != nil {
+		consensusStatus += " Votes by option: " + conclusion.Tally.String() + "."
+	}
+	if len(conclusion.Steelman) > 0 {
+		consensusStatus += " Steelman fidelity (how faithfully each agent restated the others before rebutting): " + steelmanStatus(debate, conclusion.Steelman) + "."
 	}
 
 	instructionBlock := ""
@@ -911,7 +1245,7 @@ Provide a brief, objective summary (2-3 sentences) of the key points discussed a
 
 Use Markdown format.`, debate.Topic, instructionBlock, history, consensusStatus)
 
-	model := debate.AgentA.Model
+	model := agent.Model
 	if model == "" {
 		model = prov.DefaultModel()
 	}
@@ -932,7 +1266,7 @@ Use Markdown format.`, debate.Topic, instructionBlock, history, consensusStatus)
 	turn := &core.Turn{
 		ID:        core.GenerateID(),
 		DebateID:  debate.ID,
-		AgentID:   debate.AgentA.ID, // Summary is generated by AgentA's provider
+		AgentID:   agent.ID, // Summary is generated by this agent's provider
 		Number:    turnNum,
 		Round:     round,
 		Content:   resp.Content,
@@ -946,6 +1280,10 @@ Use Markdown format.`, debate.Topic, instructionBlock, history, consensusStatus)
 		turn.TotalTokens = resp.Metadata.TotalTokens
 		turn.DurationMs = resp.Metadata.Duration.Milliseconds()
 		turn.StopReason = resp.Metadata.StopReason
+		turn.TokensEstimated = resp.Metadata.Estimated
+		turn.InputEstimated = resp.Metadata.InputEstimated
+		turn.OutputEstimated = resp.Metadata.OutputEstimated
+		turn.CostUSD = resp.Metadata.CostUSD
 	}
 	if err := e.storage.AddTurn(turn); err != nil {
 		slog.Warn("Failed to save summary turn", "error", err)
@@ -976,7 +1314,7 @@ func (e *Engine) ExecuteNextTurn(ctx context.Context, debateID string) (*core.Tu
 	}
 
 	currentTurnNum := len(turns) + 1
-	totalTurns := debate.MaxTurns * 2
+	totalTurns := debate.TotalTurns()
 
 	if currentTurnNum > totalTurns {
 		return nil, fmt.Errorf("all turns completed")
@@ -990,13 +1328,21 @@ func (e *Engine) ExecuteNextTurn(ctx context.Context, debateID string) (*core.Tu
 		}
 	}
 
-	// Determine starting order (consistent for the debate)
-	agents := []core.Agent{debate.AgentA, debate.AgentB}
+	// Determine speaking order (consistent for the debate)
 	// Use debate ID as seed for consistent ordering
-	r := rand.New(rand.NewSource(int64(hashString(debate.ID))))
-	r.Shuffle(len(agents), func(i, j int) { agents[i], agents[j] = agents[j], agents[i] })
+	agents := roundOrder(debate, int64(hashString(debate.ID)))
 
-	currentAgent := agents[(currentTurnNum-1)%2]
+	currentAgent := agents[(currentTurnNum-1)%len(agents)]
+	if debate.SpeakingOrder == core.SpeakingOrderModerator {
+		round := 1
+		if len(turns) > 0 {
+			round = turns[len(turns)-1].Round
+		}
+		currentAgent = e.moderatorPick(ctx, debate, turns, round)
+	}
+	if currentAgent.IsHuman() {
+		return nil, e.awaitHuman(debate)
+	}
 	isLastTurn := currentTurnNum == totalTurns
 
 	turn, err := e.executeTurn(ctx, debate, currentAgent, currentTurnNum, isLastTurn)
@@ -1015,25 +1361,33 @@ func (e *Engine) ExecuteNextTurn(ctx context.Context, debateID string) (*core.Tu
 		debate.Status = core.StatusCompleted
 		debate.CompletedAt = &now
 		e.storage.UpdateDebate(debate)
+	} else if currentTurnNum%len(agents) == 0 {
+		// Check for early consensus once every agent has spoken
+		if err := e.checkConclusion(ctx, debate, append(turns, turn)); err != nil {
+			// Log error but don't fail the turn
+			slog.Error("Failed to check conclusion", "error", err)
+		}
 	}
 
 	return turn, nil
 }
 
-// checkConclusion checks if the debate should conclude early.
+// checkConclusion checks if a step-by-step debate should conclude early.
+// runDebate does its own check at each rotation boundary.
 func (e *Engine) checkConclusion(ctx context.Context, debate *core.Debate, turns []*core.Turn) error {
-	// Only check if we have enough turns in the current round
-	if len(turns) < 4 {
+	// Only check if we have enough turns in the current round (two rotations)
+	if len(turns) < 2*len(debate.Participants()) {
 		return nil
 	}
 
 	// Check for early consensus
-	if e.checkEarlyConsensus(ctx, debate) {
+	if result := e.checkEarlyConsensus(ctx, debate); result != nil && result.Reached {
 		conclusion, err := e.generateConclusion(ctx, debate)
 		if err != nil {
 			return err
 		}
 		conclusion.EarlyConsensus = true
+		conclusion.Consensus = result
 		if len(turns) > 0 {
 			conclusion.Round = turns[len(turns)-1].Round
 		}
@@ -1048,8 +1402,13 @@ func (e *Engine) checkConclusion(ctx context.Context, debate *core.Debate, turns
 	return nil
 }
 
-// AddFollowUp adds a user follow-up question and resumes the debate.
-func (e *Engine) AddFollowUp(ctx context.Context, debateID string, content string) error {
+// AddFollowUp adds a user follow-up question and resumes the debate. A
+// follow-up addressed to some agents starts a round in which only they
+// answer, once each.
+func (e *Engine) AddFollowUp(ctx context.Context, debateID string, followUp core.FollowUp) error {
+	if followUp.Synthesize {
+		return fmt.Errorf("only council follow-ups can be synthesized")
+	}
 	debate, turns, err := e.GetDebateWithTurns(debateID)
 	if err != nil {
 		return err
@@ -1057,6 +1416,16 @@ func (e *Engine) AddFollowUp(ctx context.Context, debateID string, content strin
 	if debate == nil {
 		return fmt.Errorf("debate not found")
 	}
+	if e.runs.Running(debateID) {
+		return run.ErrAlreadyRunning
+	}
+	if debate.Status == core.StatusAwaitingInput {
+		return fmt.Errorf("debate is awaiting a human turn")
+	}
+	targets, err := core.ResolveTargets(debate.Participants(), followUp)
+	if err != nil {
+		return err
+	}
 
 	newRound := 1
 	if len(turns) > 0 {
@@ -1069,22 +1438,19 @@ func (e *Engine) AddFollowUp(ctx context.Context, debateID string, content strin
 		AgentID:   "user",
 		Number:    len(turns) + 1,
 		Round:     newRound,
-		Content:   content,
+		Content:   followUp.Content,
 		CreatedAt: time.Now(),
 	}
+	if targets != nil {
+		userTurn.Targeting = &core.Targeting{AgentIDs: targets}
+	}
 
 	if err := e.storage.AddTurn(userTurn); err != nil {
 		return fmt.Errorf("failed to save user turn: %w", err)
 	}
 
 	// Trigger background run
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
-		defer cancel()
-		e.RunDebate(ctx, debateID, nil)
-	}()
-
-	return nil
+	return e.StartDebate(debateID, nil)
 }
 
 // hashString creates a simple hash from a string.
@@ -1099,20 +1465,22 @@ func hashString(s string) uint32 {
 // ensureMaskedNames ensures that agents in a debate have masked names.
 // This provides backward compatibility for debates created before masked names were added.
 func (e *Engine) ensureMaskedNames(debate *core.Debate) {
-	if debate.AgentA.MaskedName == "" {
-		debate.AgentA.MaskedName = core.GenerateMaskedName(debate.AgentA.ID)
-	}
-	if debate.AgentB.MaskedName == "" {
-		debate.AgentB.MaskedName = core.GenerateMaskedName(debate.AgentB.ID)
+	agents := debate.Participants()
+	for i := range agents {
+		if agents[i].MaskedName == "" {
+			agents[i].MaskedName = core.GenerateMaskedName(agents[i].ID)
+		}
 	}
+	debate.SetParticipants(agents)
 }
 
 // buildNameMap creates a mapping from masked names to real names for display.
 func (e *Engine) buildNameMap(debate *core.Debate) map[string]string {
-	return map[string]string{
-		debate.AgentA.MaskedName: debate.AgentA.Name,
-		debate.AgentB.MaskedName: debate.AgentB.Name,
+	nameMap := make(map[string]string)
+	for _, a := range debate.Participants() {
+		nameMap[a.MaskedName] = a.Name
 	}
+	return nameMap
 }
 
 // replaceMaskedNamesInTurn replaces masked names with real names in turn content for display.
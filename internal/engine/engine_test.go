@@ -2,13 +2,16 @@ package engine
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/alienxp03/conclave/internal/core"
 	"github.com/alienxp03/conclave/internal/provider"
+	"github.com/alienxp03/conclave/internal/run"
 	"github.com/alienxp03/conclave/internal/storage"
 	extprovider "github.com/alienxp03/conclave/provider"
 )
@@ -18,6 +21,7 @@ type MockProvider struct {
 	name      string
 	available bool
 	responses []string
+	metadata  *extprovider.Metadata
 	callCount int
 }
 
@@ -32,6 +36,7 @@ func (m *MockProvider) Execute(ctx context.Context, req *extprovider.Request) (*
 		Content:  m.responses[idx],
 		Model:    "test-model",
 		Provider: m.name,
+		Metadata: m.metadata,
 	}, nil
 }
 
@@ -360,6 +365,319 @@ func TestRunDebate(t *testing.T) {
 	}
 }
 
+func TestRunDebateMultiAgent(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	config := core.NewDebateConfig{
+		Topic: "Test",
+		Agents: []core.MemberSpec{
+			{Provider: "mock", Persona: "optimist"},
+			{Provider: "mock", Persona: "skeptic"},
+			{Provider: "mock"}, // Persona auto-assigned
+		},
+		SpeakingOrder: core.SpeakingOrderRoundRobin,
+		Style:         "collaborative",
+		MaxTurns:      2, // 6 debate turns total (2 per agent)
+	}
+	debate, err := eng.CreateDebate(ctx, config)
+	if err != nil {
+		t.Fatalf("failed to create debate: %v", err)
+	}
+	if len(debate.Participants()) != 3 {
+		t.Fatalf("wrong participant count: got %d, want 3", len(debate.Participants()))
+	}
+	if debate.Agents[2].Persona != core.DefaultPersonaOrder[2] {
+		t.Errorf("persona not auto-assigned: got %q", debate.Agents[2].Persona)
+	}
+
+	if err := eng.RunDebate(ctx, debate.ID, nil); err != nil {
+		t.Fatalf("failed: %v", err)
+	}
+
+	final, turns, _ := eng.GetDebateWithTurns(debate.ID)
+
+	// debate (6) + vote (3) + conclusion (1) = 10
+	if len(turns) != 10 {
+		t.Errorf("wrong stored turn count: got %d, want 10", len(turns))
+	}
+
+	// Round-robin follows declaration order
+	ids := final.AgentIDs()
+	i := 0
+	for _, turn := range turns {
+		if turn.TurnType != core.TurnTypeDebate {
+			continue
+		}
+		if want := ids[i%len(ids)]; turn.AgentID != want {
+			t.Errorf("turn %d spoken by %s, want %s", turn.Number, turn.AgentID, want)
+		}
+		i++
+	}
+
+	if len(final.Conclusions) == 0 {
+		t.Fatal("conclusions is empty")
+	}
+	if got := len(final.Conclusions[0].Votes); got != 3 {
+		t.Errorf("wrong vote count: got %d, want 3", got)
+	}
+
+	stats := core.ComputeDebateStats(turns, final.AgentIDs()...)
+	for _, agentStats := range stats.Agents {
+		if agentStats.TurnCount != 2 {
+			t.Errorf("agent %s turn count: got %d, want 2", agentStats.AgentID, agentStats.TurnCount)
+		}
+	}
+}
+
+func TestRunDebateConsensusDetection(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	eng.registry.Register(&MockProvider{
+		name:      "echo",
+		available: true,
+		// Every turn and consensus check gets the same reply
+		responses: []string{"Yes, spaces keep indentation consistent across editors."},
+	})
+
+	if err := eng.storage.(*storage.SQLiteStorage).CreateStyle(&storage.Style{
+		ID:                 "converging",
+		Name:               "Converging",
+		OpeningPrompt:      "{{.Topic}}",
+		ResponsePrompt:     "{{.Topic}}",
+		ConclusionPrompt:   "{{.Topic}}",
+		ConsensusMethod:    core.ConsensusStance,
+		ConsensusThreshold: 0.9,
+	}); err != nil {
+		t.Fatalf("failed to create style: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		consensus   *core.ConsensusConfig
+		wantMethod  core.ConsensusMethod
+		wantReached bool
+		wantTurns   int
+	}{
+		// Identical turns pass the stance gate and the judge confirms, so the debate stops after one rotation
+		{"style method", nil, core.ConsensusStance, true, 2},
+		{"debate override", &core.ConsensusConfig{Method: core.ConsensusKeyword}, core.ConsensusKeyword, false, 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			debate, err := eng.CreateDebate(ctx, core.NewDebateConfig{
+				Topic:          "Tabs or spaces?",
+				AgentAProvider: "echo",
+				AgentAPersona:  "optimist",
+				AgentBProvider: "echo",
+				AgentBPersona:  "skeptic",
+				SpeakingOrder:  core.SpeakingOrderRoundRobin,
+				Style:          "converging",
+				MaxTurns:       3,
+				Consensus:      tt.consensus,
+			})
+			if err != nil {
+				t.Fatalf("failed to create debate: %v", err)
+			}
+			if err := eng.RunDebate(ctx, debate.ID, nil); err != nil {
+				t.Fatalf("failed: %v", err)
+			}
+
+			final, turns, _ := eng.GetDebateWithTurns(debate.ID)
+			debateTurns := 0
+			for _, turn := range turns {
+				if turn.TurnType == core.TurnTypeDebate {
+					debateTurns++
+				}
+			}
+			if debateTurns != tt.wantTurns {
+				t.Errorf("debate turns: got %d, want %d", debateTurns, tt.wantTurns)
+			}
+
+			if len(final.Conclusions) != 1 {
+				t.Fatalf("conclusions: got %d, want 1", len(final.Conclusions))
+			}
+			conclusion := final.Conclusions[0]
+			if conclusion.EarlyConsensus != tt.wantReached {
+				t.Errorf("early consensus: got %v, want %v", conclusion.EarlyConsensus, tt.wantReached)
+			}
+			result := conclusion.Consensus
+			if result == nil {
+				t.Fatal("consensus result not recorded")
+			}
+			if result.Method != tt.wantMethod || result.Reached != tt.wantReached || result.Rationale == "" {
+				t.Errorf("unexpected consensus result: %+v", result)
+			}
+		})
+	}
+
+	// Step-by-step debates check at rotation boundaries too
+	debate, err := eng.CreateDebate(ctx, core.NewDebateConfig{
+		Topic:          "Tabs or spaces?",
+		AgentAProvider: "echo",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "echo",
+		AgentBPersona:  "skeptic",
+		SpeakingOrder:  core.SpeakingOrderRoundRobin,
+		Style:          "converging",
+		MaxTurns:       3,
+	})
+	if err != nil {
+		t.Fatalf("failed to create debate: %v", err)
+	}
+	for i := 1; i <= 4; i++ {
+		if _, err := eng.ExecuteNextTurn(ctx, debate.ID); err != nil {
+			t.Fatalf("turn %d: %v", i, err)
+		}
+		d, _ := eng.GetDebate(debate.ID)
+		if completed := d.Status == core.StatusCompleted; completed != (i == 4) {
+			t.Fatalf("after turn %d: status %s", i, d.Status)
+		}
+	}
+	final, _ := eng.GetDebate(debate.ID)
+	if len(final.Conclusions) != 1 || !final.Conclusions[0].EarlyConsensus {
+		t.Errorf("step conclusions: got %+v, want one early consensus", final.Conclusions)
+	}
+}
+
+func TestRunDebateStalemate(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	// The same reply every time; it doubles as a confirmation that no new
+	// arguments were made
+	eng.registry.Register(&MockProvider{
+		name:      "looper",
+		available: true,
+		responses: []string{`Spaces keep indentation consistent across editors. {"new_arguments": false, "rationale": "Both keep restating the indentation point."}`},
+	})
+
+	tests := []struct {
+		name          string
+		stalemate     *core.StalemateConfig
+		wantTurns     int
+		wantConfirmed bool
+	}{
+		// Rotations 2 and 3 repeat rotation 1, so the debate stops after rotation 3
+		{"default", nil, 6, false},
+		{"confirmed", &core.StalemateConfig{Confirm: true}, 6, true},
+		{"disabled", &core.StalemateConfig{Disabled: true}, 10, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			debate, err := eng.CreateDebate(ctx, core.NewDebateConfig{
+				Topic:          "Tabs or spaces?",
+				AgentAProvider: "looper",
+				AgentAPersona:  "optimist",
+				AgentBProvider: "looper",
+				AgentBPersona:  "skeptic",
+				SpeakingOrder:  core.SpeakingOrderRoundRobin,
+				Style:          "collaborative",
+				MaxTurns:       5,
+				Consensus:      &core.ConsensusConfig{Method: core.ConsensusKeyword, Threshold: 1},
+				Stalemate:      tt.stalemate,
+			})
+			if err != nil {
+				t.Fatalf("CreateDebate() error = %v", err)
+			}
+			if err := eng.RunDebate(ctx, debate.ID, nil); err != nil {
+				t.Fatalf("RunDebate() error = %v", err)
+			}
+
+			final, turns, _ := eng.GetDebateWithTurns(debate.ID)
+			var debateTurns []*core.Turn
+			for _, turn := range turns {
+				if turn.TurnType == core.TurnTypeDebate {
+					debateTurns = append(debateTurns, turn)
+				}
+			}
+			if len(debateTurns) != tt.wantTurns {
+				t.Fatalf("debate turns: got %d, want %d", len(debateTurns), tt.wantTurns)
+			}
+			for i, turn := range debateTurns {
+				want := 0.0
+				if i < 2 {
+					want = 1 // Each agent's first turn is all new
+				}
+				if turn.Novelty == nil || turn.Novelty.Score != want {
+					t.Errorf("turn %d novelty = %+v, want score %v", turn.Number, turn.Novelty, want)
+				}
+			}
+
+			conclusion := final.Conclusions[0]
+			if tt.stalemate != nil && tt.stalemate.Disabled {
+				if conclusion.Type != core.ConclusionStandard || conclusion.Stalemate != nil {
+					t.Errorf("unexpected stalemate conclusion: %+v", conclusion)
+				}
+				return
+			}
+			result := conclusion.Stalemate
+			if conclusion.Type != core.ConclusionStalemate || result == nil {
+				t.Fatalf("expected a stalemate conclusion, got %+v", conclusion)
+			}
+			if len(result.Novelty) != 2 || result.Threshold != 0.3 || result.Confirmed != tt.wantConfirmed || result.Rationale == "" {
+				t.Errorf("unexpected stalemate result: %+v", result)
+			}
+		})
+	}
+
+	_, err := eng.CreateDebate(context.Background(), core.NewDebateConfig{
+		Topic:          "Test",
+		AgentAProvider: "mock",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "mock",
+		AgentBPersona:  "skeptic",
+		Style:          "collaborative",
+		Stalemate:      &core.StalemateConfig{Threshold: 2},
+	})
+	if err == nil {
+		t.Error("expected an error for a stalemate threshold above 1")
+	}
+}
+
+func TestCreateDebateInvalidConsensus(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	for _, cfg := range []*core.ConsensusConfig{
+		{Method: "vibes"},
+		{Method: core.ConsensusStance, Threshold: 1.5},
+	} {
+		_, err := eng.CreateDebate(context.Background(), core.NewDebateConfig{
+			Topic:          "Test",
+			AgentAProvider: "mock",
+			AgentAPersona:  "optimist",
+			AgentBProvider: "mock",
+			AgentBPersona:  "skeptic",
+			Consensus:      cfg,
+		})
+		if err == nil {
+			t.Errorf("expected error for consensus config %+v", cfg)
+		}
+	}
+}
+
+func TestCreateDebateRequiresTwoAgents(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	config := core.NewDebateConfig{
+		Topic:  "Test",
+		Agents: []core.MemberSpec{{Provider: "mock", Persona: "optimist"}},
+		Style:  "collaborative",
+	}
+	if _, err := eng.CreateDebate(context.Background(), config); err == nil {
+		t.Error("expected error for a single-agent debate")
+	}
+}
+
 func TestExecuteNextTurn(t *testing.T) {
 	eng, cleanup := setupTestEngine(t)
 	defer cleanup()
@@ -404,17 +722,1575 @@ func TestExecuteNextTurn(t *testing.T) {
 	}
 }
 
-func TestHashString(t *testing.T) {
-	// Same string should produce same hash
-	h1 := hashString("test")
-	h2 := hashString("test")
-	if h1 != h2 {
-		t.Error("same string produced different hashes")
+func TestPauseResumeDebate(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	config := core.NewDebateConfig{
+		Topic:          "Test",
+		AgentAProvider: "mock",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "mock",
+		AgentBPersona:  "skeptic",
+		Style:          "collaborative",
+		MaxTurns:       2,
 	}
+	debate, _ := eng.CreateDebate(ctx, config)
 
-	// Different strings should produce different hashes
-	h3 := hashString("different")
-	if h1 == h3 {
-		t.Error("different strings produced same hash")
+	// Pause after the second turn
+	turnCount := 0
+	err := eng.RunDebate(ctx, debate.ID, func(turn *core.Turn, d *core.Debate) {
+		turnCount++
+		if turnCount == 2 {
+			if err := eng.PauseDebate(d.ID); err != nil {
+				t.Errorf("PauseDebate() error = %v", err)
+			}
+		}
+	})
+	if !errors.Is(err, run.ErrPaused) {
+		t.Fatalf("RunDebate() error = %v, want ErrPaused", err)
+	}
+
+	paused, turns, _ := eng.GetDebateWithTurns(debate.ID)
+	if paused.Status != core.StatusPaused {
+		t.Errorf("wrong status: got %s, want paused", paused.Status)
+	}
+	if len(turns) != 2 {
+		t.Errorf("wrong turn count after pause: got %d, want 2", len(turns))
+	}
+	if err := eng.PauseDebate(debate.ID); err == nil {
+		t.Error("expected pausing a paused debate to fail")
+	}
+
+	// Resume continues from the third turn
+	var resumed []int
+	if err := eng.ResumeDebate(debate.ID, func(turn *core.Turn, d *core.Debate) {
+		resumed = append(resumed, turn.Number)
+	}); err != nil {
+		t.Fatalf("ResumeDebate() error = %v", err)
+	}
+	eng.WaitDebate(ctx, debate.ID)
+
+	final, _, _ := eng.GetDebateWithTurns(debate.ID)
+	if final.Status != core.StatusCompleted {
+		t.Errorf("wrong final status: got %s, want completed", final.Status)
+	}
+	if len(resumed) != 2 || resumed[0] != 3 {
+		t.Errorf("resumed turns = %v, want [3 4]", resumed)
+	}
+}
+
+func TestResumeInterruptedConclusion(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	debate, _ := eng.CreateDebate(ctx, core.NewDebateConfig{
+		Topic:          "Test",
+		AgentAProvider: "mock",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "mock",
+		AgentBPersona:  "skeptic",
+		Style:          "collaborative",
+		MaxTurns:       1,
+	})
+	err := eng.RunDebate(ctx, debate.ID, func(turn *core.Turn, d *core.Debate) {
+		eng.PauseDebate(d.ID)
+	})
+	if !errors.Is(err, run.ErrPaused) {
+		t.Fatalf("RunDebate() error = %v, want ErrPaused", err)
+	}
+
+	// Paused during the conclusion: the last turn and one vote were saved
+	eng.storage.AddTurn(&core.Turn{ID: core.GenerateID(), DebateID: debate.ID, AgentID: debate.AgentB.ID, Number: 2, Round: 1, Content: "Turn 2", TurnType: core.TurnTypeDebate, Status: "completed", CreatedAt: time.Now()})
+	stale := &core.Turn{ID: core.GenerateID(), DebateID: debate.ID, AgentID: debate.AgentA.ID, Number: 3, Round: 1, Content: "VOTE: AGREE", TurnType: core.TurnTypeVote, CreatedAt: time.Now()}
+	eng.storage.AddTurn(stale)
+
+	if err := eng.ResumeDebate(debate.ID, nil); err != nil {
+		t.Fatalf("ResumeDebate() error = %v", err)
+	}
+	eng.WaitDebate(ctx, debate.ID)
+
+	final, turns, _ := eng.GetDebateWithTurns(debate.ID)
+	if final.Status != core.StatusCompleted {
+		t.Errorf("wrong status: got %s, want completed", final.Status)
+	}
+	votes := 0
+	for _, turn := range turns {
+		if turn.ID == stale.ID {
+			t.Error("the interrupted conclusion's vote should be discarded")
+		}
+		if turn.TurnType == core.TurnTypeVote {
+			votes++
+		}
+	}
+	if votes != 2 {
+		t.Errorf("vote turns = %d, want 2", votes)
+	}
+}
+
+func TestCancelDebate(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	config := core.NewDebateConfig{
+		Topic:          "Test",
+		AgentAProvider: "mock",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "mock",
+		AgentBPersona:  "skeptic",
+		Style:          "collaborative",
+		MaxTurns:       2,
+	}
+
+	t.Run("StopRequest", func(t *testing.T) {
+		// A cancel requested by another process is honored at the next turn
+		debate, _ := eng.CreateDebate(ctx, config)
+		err := eng.RunDebate(ctx, debate.ID, func(turn *core.Turn, d *core.Debate) {
+			eng.storage.RequestStop(d.ID, core.StatusCancelled)
+		})
+		if !errors.Is(err, run.ErrCancelled) {
+			t.Fatalf("RunDebate() error = %v, want ErrCancelled", err)
+		}
+
+		d, turns, _ := eng.GetDebateWithTurns(debate.ID)
+		if d.Status != core.StatusCancelled {
+			t.Errorf("wrong status: got %s, want cancelled", d.Status)
+		}
+		if len(turns) != 1 {
+			t.Errorf("wrong turn count: got %d, want 1", len(turns))
+		}
+		if err := eng.CancelDebate(debate.ID); err == nil {
+			t.Error("expected cancelling a cancelled debate to fail")
+		}
+	})
+
+	t.Run("NotRunning", func(t *testing.T) {
+		debate, _ := eng.CreateDebate(ctx, config)
+		if err := eng.CancelDebate(debate.ID); err != nil {
+			t.Fatalf("CancelDebate() error = %v", err)
+		}
+		d, _ := eng.GetDebate(debate.ID)
+		if d.Status != core.StatusCancelled {
+			t.Errorf("wrong status: got %s, want cancelled", d.Status)
+		}
+		if err := eng.ResumeDebate(debate.ID, nil); err == nil {
+			t.Error("expected resuming a cancelled debate to fail")
+		}
+	})
+}
+
+func TestRecoverDebates(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	config := core.NewDebateConfig{
+		Topic:          "Test",
+		AgentAProvider: "mock",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "mock",
+		AgentBPersona:  "skeptic",
+		Style:          "collaborative",
+		MaxTurns:       2,
+	}
+
+	// A debate left in progress after its first turn, as if the server died
+	crashed := func() *core.Debate {
+		debate, _ := eng.CreateDebate(ctx, config)
+		if _, err := eng.ExecuteNextTurn(ctx, debate.ID); err != nil {
+			t.Fatalf("ExecuteNextTurn() error = %v", err)
+		}
+		d, turns, _ := eng.GetDebateWithTurns(debate.ID)
+		if next := NextStep(d, turns); next != "turn 2 of 4 in round 1" {
+			t.Errorf("NextStep() = %q, want turn 2 of 4 in round 1", next)
+		}
+		return d
+	}
+
+	t.Run("Interrupt", func(t *testing.T) {
+		debate := crashed()
+		n, err := eng.RecoverDebates(core.RecoveryInterrupt)
+		if err != nil || n != 1 {
+			t.Fatalf("RecoverDebates() = %d, %v; want 1", n, err)
+		}
+		d, _ := eng.GetDebate(debate.ID)
+		if d.Status != core.StatusInterrupted {
+			t.Errorf("wrong status: got %s, want interrupted", d.Status)
+		}
+
+		if err := eng.ResumeDebate(debate.ID, nil); err != nil {
+			t.Fatalf("ResumeDebate() error = %v", err)
+		}
+		eng.WaitDebate(ctx, debate.ID)
+		d, turns, _ := eng.GetDebateWithTurns(debate.ID)
+		if d.Status != core.StatusCompleted {
+			t.Errorf("wrong status after resume: got %s, want completed", d.Status)
+		}
+		if _, taken := debateProgress(turns); taken != 4 {
+			t.Errorf("debate turns = %d, want 4", taken)
+		}
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		debate := crashed()
+		n, err := eng.RecoverDebates(core.RecoveryResume)
+		if err != nil || n != 1 {
+			t.Fatalf("RecoverDebates() = %d, %v; want 1", n, err)
+		}
+		eng.WaitDebate(ctx, debate.ID)
+		d, _ := eng.GetDebate(debate.ID)
+		if d.Status != core.StatusCompleted {
+			t.Errorf("wrong status: got %s, want completed", d.Status)
+		}
+	})
+
+	t.Run("RunningElsewhere", func(t *testing.T) {
+		// A live CLI run keeps its heartbeat fresh; the server must not start a second run
+		debate := crashed()
+		eng.storage.Heartbeat(debate.ID, "cli-host:42")
+		n, err := eng.RecoverDebates(core.RecoveryResume)
+		if err != nil || n != 0 {
+			t.Fatalf("RecoverDebates() = %d, %v; want 0", n, err)
+		}
+		if eng.IsRunning(debate.ID) {
+			t.Error("debate should not be started here")
+		}
+		d, _ := eng.GetDebate(debate.ID)
+		if d.Status != core.StatusInProgress {
+			t.Errorf("wrong status: got %s, want in_progress", d.Status)
+		}
+		eng.storage.ClearHeartbeat(debate.ID)
+	})
+}
+
+func TestDebateProgress(t *testing.T) {
+	turns := []*core.Turn{
+		{Round: 1, AgentID: "a", TurnType: core.TurnTypeDebate},
+		{Round: 1, AgentID: "b", TurnType: core.TurnTypeDebate},
+		{Round: 1, AgentID: "a", TurnType: core.TurnTypeVote},
+	}
+	debate := &core.Debate{AgentA: core.Agent{ID: "a"}, AgentB: core.Agent{ID: "b"}, MaxTurns: 1}
+
+	// A crash mid-conclusion leaves vote turns; the conclusion runs again
+	if round, taken := debateProgress(turns); round != 1 || taken != 2 {
+		t.Errorf("debateProgress() = %d, %d; want 1, 2", round, taken)
+	}
+	if next := NextStep(debate, turns); next != "conclusion for round 1" {
+		t.Errorf("NextStep() = %q, want conclusion for round 1", next)
+	}
+
+	turns = append(turns, &core.Turn{Round: 2, AgentID: "user", TurnType: core.TurnTypeUser})
+	if round, taken := debateProgress(turns); round != 2 || taken != 0 {
+		t.Errorf("after follow-up: debateProgress() = %d, %d; want 2, 0", round, taken)
+	}
+}
+
+func TestHumanParticipant(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	config := core.NewDebateConfig{
+		Topic:          "Test",
+		AgentAProvider: "mock",
+		AgentAPersona:  "skeptic",
+		AgentBProvider: core.ProviderHuman,
+		Style:          "collaborative",
+		MaxTurns:       2,
+	}
+	debate, err := eng.CreateDebate(ctx, config)
+	if err != nil {
+		t.Fatalf("CreateDebate() error = %v", err)
+	}
+	human, ok := debate.HumanAgent()
+	if !ok {
+		t.Fatal("expected a human participant")
+	}
+
+	submitted := 0
+	err = eng.RunDebate(ctx, debate.ID, nil)
+	for errors.Is(err, ErrAwaitingInput) && submitted < 4 {
+		awaiting, _ := eng.AwaitingInput(debate.ID)
+		if awaiting == nil || awaiting.AgentID != human.ID || awaiting.MaskedName != human.MaskedName {
+			t.Fatalf("AwaitingInput() = %+v, want the human seat", awaiting)
+		}
+		if d, _ := eng.GetDebate(debate.ID); d.Status != core.StatusAwaitingInput {
+			t.Errorf("wrong status while waiting: got %s", d.Status)
+		}
+
+		if _, err := eng.SubmitHumanTurn(debate.ID, "Spaces are a relic."); err != nil {
+			t.Fatalf("SubmitHumanTurn() error = %v", err)
+		}
+		submitted++
+		err = eng.RunDebate(ctx, debate.ID, nil)
+	}
+	if err != nil {
+		t.Fatalf("RunDebate() error = %v", err)
+	}
+	if submitted != 2 {
+		t.Errorf("human turns submitted = %d, want 2", submitted)
+	}
+
+	final, turns, _ := eng.GetDebateWithTurns(debate.ID)
+	if final.Status != core.StatusCompleted {
+		t.Errorf("wrong status: got %s, want completed", final.Status)
+	}
+	if _, taken := debateProgress(turns); taken != 4 {
+		t.Errorf("debate turns = %d, want 4", taken)
+	}
+	// Only the model participant votes
+	if votes := final.Conclusions[0].Votes; len(votes) != 1 || votes[0].AgentID == human.ID {
+		t.Errorf("votes = %+v, want one vote from the model", votes)
+	}
+	if _, err := eng.SubmitHumanTurn(debate.ID, "Too late."); err == nil {
+		t.Error("expected submitting to a completed debate to fail")
+	}
+
+	// The model sees the human's turn under the human's masked name
+	ai := final.ModelParticipants()[0]
+	stored, _ := eng.storage.GetTurns(debate.ID)
+	prompt, err := eng.buildPrompt(final, ai, stored, len(stored)+1, true)
+	if err != nil {
+		t.Fatalf("buildPrompt() error = %v", err)
+	}
+	if !strings.Contains(prompt, "--- "+human.MaskedName) {
+		t.Errorf("prompt should attribute human turns to %s", human.MaskedName)
+	}
+}
+
+func TestHumanParticipantSeatA(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	debate, err := eng.CreateDebate(ctx, core.NewDebateConfig{
+		Topic:          "Test",
+		AgentAProvider: core.ProviderHuman,
+		AgentBProvider: "mock",
+		AgentBPersona:  "skeptic",
+		Style:          "collaborative",
+		MaxTurns:       1,
+	})
+	if err != nil {
+		t.Fatalf("CreateDebate() error = %v", err)
+	}
+
+	err = eng.RunDebate(ctx, debate.ID, nil)
+	for i := 0; errors.Is(err, ErrAwaitingInput) && i < 2; i++ {
+		if _, err := eng.SubmitHumanTurn(debate.ID, "Tabs are clearer."); err != nil {
+			t.Fatalf("SubmitHumanTurn() error = %v", err)
+		}
+		err = eng.RunDebate(ctx, debate.ID, nil)
+	}
+	if err != nil {
+		t.Fatalf("RunDebate() error = %v", err)
+	}
+
+	// The summary comes from the model seat, not the human in seat A
+	final, turns, _ := eng.GetDebateWithTurns(debate.ID)
+	conclusion := final.Conclusions[0]
+	if conclusion.Summary == "Debate concluded." {
+		t.Error("summary should be generated by the model participant")
+	}
+	if !conclusion.ModelVotesOnly {
+		t.Error("agreement should be reported as the model's only")
+	}
+	ai := final.ModelParticipants()[0]
+	for _, turn := range turns {
+		if turn.TurnType == core.TurnTypeConclusion && turn.AgentID != ai.ID {
+			t.Errorf("summary turn credited to %s, want %s", turn.AgentID, ai.ID)
+		}
+	}
+}
+
+func TestCreateDebateHumanValidation(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	tests := []struct {
+		name   string
+		agents []core.MemberSpec
+	}{
+		{"two humans", []core.MemberSpec{{Provider: "mock", Persona: "optimist"}, {Provider: core.ProviderHuman}, {Provider: core.ProviderHuman}}},
+		{"no model", []core.MemberSpec{{Provider: core.ProviderHuman}, {Provider: core.ProviderHuman, Persona: "skeptic"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := core.NewDebateConfig{Topic: "Test", Agents: tt.agents, Style: "collaborative"}
+			if _, err := eng.CreateDebate(context.Background(), config); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestForkDebate(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	config := core.NewDebateConfig{
+		Topic:          "Test",
+		AgentAProvider: "mock",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "mock",
+		AgentBPersona:  "skeptic",
+		Style:          "collaborative",
+		MaxTurns:       2,
+	}
+	parent, _ := eng.CreateDebate(ctx, config)
+	if err := eng.RunDebate(ctx, parent.ID, nil); err != nil {
+		t.Fatalf("RunDebate() error = %v", err)
+	}
+	_, parentTurns, _ := eng.GetDebateWithTurns(parent.ID)
+
+	// Rewrite turn 2 with agent B as an analyst
+	fork, err := eng.ForkDebate(parent.ID, core.ForkConfig{
+		At:      2,
+		Content: "What if we did nothing?",
+		Swaps:   []core.AgentSwap{{Seat: 1, Persona: "analyst"}},
+	})
+	if err != nil {
+		t.Fatalf("ForkDebate() error = %v", err)
+	}
+	if fork.ParentID != parent.ID || fork.ForkPoint != 2 {
+		t.Errorf("wrong lineage: parent %q at %d", fork.ParentID, fork.ForkPoint)
+	}
+	if fork.Status != core.StatusPaused {
+		t.Errorf("wrong status: got %s, want paused", fork.Status)
+	}
+	if fork.AgentB.Persona != "analyst" || fork.AgentB.ID != parent.AgentB.ID {
+		t.Errorf("agent B not swapped in place: %+v", fork.AgentB)
+	}
+
+	_, turns, _ := eng.GetDebateWithTurns(fork.ID)
+	if len(turns) != 2 {
+		t.Fatalf("wrong turn count: got %d, want 2", len(turns))
+	}
+	if turns[1].Content != "What if we did nothing?" || turns[0].Content != parentTurns[0].Content {
+		t.Errorf("wrong copied turns: %q, %q", turns[0].Content, turns[1].Content)
+	}
+
+	// Resuming the fork continues from turn 3
+	if err := eng.ResumeDebate(fork.ID, nil); err != nil {
+		t.Fatalf("ResumeDebate() error = %v", err)
+	}
+	eng.WaitDebate(ctx, fork.ID)
+	resumed, _ := eng.GetDebate(fork.ID)
+	if resumed.Status != core.StatusCompleted || len(resumed.Conclusions) != 1 {
+		t.Errorf("fork did not complete: status %s, %d conclusions", resumed.Status, len(resumed.Conclusions))
+	}
+
+	// Forking everything carries the conclusion over
+	full, err := eng.ForkDebate(parent.ID, core.ForkConfig{})
+	if err != nil {
+		t.Fatalf("ForkDebate() error = %v", err)
+	}
+	if full.Status != core.StatusCompleted || len(full.Conclusions) != 1 {
+		t.Errorf("full fork: status %s, %d conclusions", full.Status, len(full.Conclusions))
+	}
+	if _, turns, _ := eng.GetDebateWithTurns(full.ID); len(turns) != len(parentTurns) {
+		t.Errorf("full fork copied %d turns, want %d", len(turns), len(parentTurns))
+	}
+
+	// The tree is rooted at the parent from any member
+	tree, err := eng.DebateForkTree(full.ID)
+	if err != nil {
+		t.Fatalf("DebateForkTree() error = %v", err)
+	}
+	if tree.ID != parent.ID || len(tree.Forks) != 2 || tree.Forks[0].ID != fork.ID {
+		t.Errorf("wrong fork tree: %+v", tree)
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		tests := []struct {
+			name string
+			cfg  core.ForkConfig
+		}{
+			{"missing turn", core.ForkConfig{At: 99}},
+			{"bad seat", core.ForkConfig{At: 1, Swaps: []core.AgentSwap{{Seat: 5, Persona: "optimist"}}}},
+			{"bad persona", core.ForkConfig{At: 1, Swaps: []core.AgentSwap{{Seat: 0, Persona: "nobody"}}}},
+			{"bad style", core.ForkConfig{At: 1, Style: "nope"}},
+			{"rewrite conclusion", core.ForkConfig{At: parentTurns[len(parentTurns)-1].Number, Content: "x"}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := eng.ForkDebate(parent.ID, tt.cfg); err == nil {
+					t.Error("expected an error")
+				}
+			})
+		}
+	})
+}
+
+func TestRegenerateTurn(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	config := core.NewDebateConfig{
+		Topic:          "Test",
+		AgentAProvider: "mock",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "mock",
+		AgentBPersona:  "skeptic",
+		Style:          "collaborative",
+		MaxTurns:       2,
+	}
+	debate, _ := eng.CreateDebate(ctx, config)
+	if err := eng.RunDebate(ctx, debate.ID, nil); err != nil {
+		t.Fatalf("RunDebate() error = %v", err)
+	}
+	_, turns, _ := eng.GetDebateWithTurns(debate.ID)
+	original := turns[0].Content
+
+	turn, err := eng.RegenerateTurn(ctx, debate.ID, turns[0].ID)
+	if err != nil {
+		t.Fatalf("RegenerateTurn() error = %v", err)
+	}
+	if len(turn.Versions) != 2 || turn.SelectedVersion != 1 {
+		t.Fatalf("wrong versions: %d, selected %d", len(turn.Versions), turn.SelectedVersion)
+	}
+	if turn.Versions[0].Content != original {
+		t.Errorf("original not kept: %q", turn.Versions[0].Content)
+	}
+
+	// Selecting the original restores its content on the stored turn
+	if _, err := eng.SelectTurnVersion(debate.ID, turn.ID, 0); err != nil {
+		t.Fatalf("SelectTurnVersion() error = %v", err)
+	}
+	_, turns, _ = eng.GetDebateWithTurns(debate.ID)
+	if turns[0].Content != original || turns[0].SelectedVersion != 0 || len(turns[0].Versions) != 2 {
+		t.Errorf("version not selected: %q, selected %d", turns[0].Content, turns[0].SelectedVersion)
+	}
+	if _, err := eng.SelectTurnVersion(debate.ID, turn.ID, 5); err == nil {
+		t.Error("expected an error selecting a missing version")
+	}
+
+	// Only debate turns can be regenerated
+	last := turns[len(turns)-1]
+	if _, err := eng.RegenerateTurn(ctx, debate.ID, last.ID); err == nil {
+		t.Errorf("expected an error regenerating a %s turn", last.TurnType)
+	}
+
+	// Rerunning the conclusion replaces it rather than adding another
+	if err := eng.RerunConclusion(debate.ID, nil); err != nil {
+		t.Fatalf("RerunConclusion() error = %v", err)
+	}
+	eng.WaitDebate(ctx, debate.ID)
+	rerun, rerunTurns, _ := eng.GetDebateWithTurns(debate.ID)
+	if rerun.Status != core.StatusCompleted || len(rerun.Conclusions) != 1 {
+		t.Errorf("rerun did not complete: status %s, %d conclusions", rerun.Status, len(rerun.Conclusions))
+	}
+	if len(rerunTurns) != len(turns) {
+		t.Errorf("rerun has %d turns, want %d", len(rerunTurns), len(turns))
+	}
+}
+
+func TestDebateStances(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	config := core.NewDebateConfig{
+		Topic:          "Test",
+		AgentAProvider: "mock",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "mock",
+		AgentBPersona:  "skeptic",
+		Style:          "adversarial",
+		MaxTurns:       1,
+	}
+
+	// Adversarial debates without stances argue FOR and AGAINST
+	debate, err := eng.CreateDebate(ctx, config)
+	if err != nil {
+		t.Fatalf("CreateDebate() error = %v", err)
+	}
+	if debate.AgentA.Stance != core.StanceFor || debate.AgentB.Stance != core.StanceAgainst {
+		t.Errorf("wrong default stances: %q, %q", debate.AgentA.Stance, debate.AgentB.Stance)
+	}
+	prompt, err := eng.buildPrompt(debate, debate.AgentB, nil, 1, false)
+	if err != nil {
+		t.Fatalf("buildPrompt() error = %v", err)
+	}
+	if !strings.Contains(prompt, "Your assigned position: AGAINST the topic") {
+		t.Errorf("prompt does not state the agent's side:\n%s", prompt)
+	}
+
+	// Explicit stances are normalized and kept as free text
+	config.AgentAStance = "for"
+	config.AgentBStance = "Only with a carbon tax"
+	debate, err = eng.CreateDebate(ctx, config)
+	if err != nil {
+		t.Fatalf("CreateDebate() error = %v", err)
+	}
+	if debate.AgentA.Stance != core.StanceFor || debate.AgentB.Stance != "Only with a carbon tax" {
+		t.Errorf("wrong stances: %q, %q", debate.AgentA.Stance, debate.AgentB.Stance)
+	}
+
+	// Other styles have no stances unless given
+	config.Style = "collaborative"
+	config.AgentAStance, config.AgentBStance = "", ""
+	if plain, _ := eng.CreateDebate(ctx, config); plain.AgentA.Stance != "" {
+		t.Errorf("collaborative debate got stance %q", plain.AgentA.Stance)
+	}
+
+	// Free-text stances swap too
+	if _, err := eng.SwapSides(debate.ID); err != nil {
+		t.Fatalf("SwapSides() error = %v", err)
+	}
+}
+
+func TestSwapSides(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	config := core.NewDebateConfig{
+		Topic:          "Test",
+		AgentAProvider: "mock",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "mock",
+		AgentBPersona:  "skeptic",
+		Style:          "adversarial",
+		MaxTurns:       1,
+	}
+	original, _ := eng.CreateDebate(ctx, config)
+
+	swapped, err := eng.SwapSides(original.ID)
+	if err != nil {
+		t.Fatalf("SwapSides() error = %v", err)
+	}
+	if swapped.SwapOf != original.ID || swapped.Status != core.StatusPending {
+		t.Errorf("wrong rerun: swap of %q, status %s", swapped.SwapOf, swapped.Status)
+	}
+	if swapped.AgentA.Persona != "optimist" || swapped.AgentA.Stance != core.StanceAgainst || swapped.AgentB.Stance != core.StanceFor {
+		t.Errorf("stances not swapped in place: %+v, %+v", swapped.AgentA, swapped.AgentB)
+	}
+
+	// Unfinished runs are inconclusive
+	cmp, err := eng.CompareSides(original.ID)
+	if err != nil {
+		t.Fatalf("CompareSides() error = %v", err)
+	}
+	if cmp.Swapped.DebateID != swapped.ID || cmp.DependsOn != core.DependsOnInconclusive {
+		t.Errorf("wrong comparison: %+v", cmp)
+	}
+
+	// finish completes a run with a judge verdict for the agent in seat
+	finish := func(d *core.Debate, seat int) {
+		d.Status = core.StatusCompleted
+		d.Conclusions = []*core.Conclusion{{Round: 1, Verdict: &core.JudgeVerdict{
+			Outcome:  core.VerdictWinner,
+			WinnerID: d.Participants()[seat].ID,
+		}}}
+		if err := eng.storage.UpdateDebate(d); err != nil {
+			t.Fatalf("UpdateDebate() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name         string
+		originalSeat int
+		swappedSeat  int
+		want         core.SideDependence
+	}{
+		{"same agent wins", 0, 0, core.DependsOnProvider},
+		{"same side wins", 0, 1, core.DependsOnSide},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			finish(original, tt.originalSeat)
+			finish(swapped, tt.swappedSeat)
+			cmp, err := eng.CompareSides(swapped.ID)
+			if err != nil {
+				t.Fatalf("CompareSides() error = %v", err)
+			}
+			if cmp.DependsOn != tt.want {
+				t.Errorf("got %s, want %s", cmp.DependsOn, tt.want)
+			}
+		})
+	}
+
+	// Only debates with opposing stances can be swapped
+	config.Style = "collaborative"
+	plain, _ := eng.CreateDebate(ctx, config)
+	if _, err := eng.SwapSides(plain.ID); err == nil {
+		t.Error("expected an error swapping a debate without stances")
+	}
+}
+
+func TestPhasedStyle(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	config := core.NewDebateConfig{
+		Topic:          "Test",
+		AgentAProvider: "mock",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "mock",
+		AgentBPersona:  "skeptic",
+		Style:          "formal",
+		MaxTurns:       5,
+	}
+
+	// Formal: opening 1, cross-examination 1, rebuttal flexible, closing 1
+	debate, err := eng.CreateDebate(ctx, config)
+	if err != nil {
+		t.Fatalf("CreateDebate() error = %v", err)
+	}
+	if debate.MaxTurns != 5 || debate.TotalTurns() != 10 {
+		t.Errorf("MaxTurns = %d, TotalTurns = %d; want 5, 10", debate.MaxTurns, debate.TotalTurns())
+	}
+
+	var turns []*core.Turn
+	tests := []struct {
+		turn int
+		want string
+	}{
+		{1, "OPENING STATEMENTS"},
+		{3, "CROSS-EXAMINATION"},
+		{9, "CLOSING STATEMENT"},
+	}
+	for _, tt := range tests {
+		for len(turns) < tt.turn-1 {
+			turns = append(turns, &core.Turn{Round: 1, AgentID: debate.AgentA.ID, TurnType: core.TurnTypeDebate, Content: "x"})
+		}
+		prompt, err := eng.buildPrompt(debate, debate.AgentA, turns, tt.turn, false)
+		if err != nil {
+			t.Fatalf("buildPrompt() error = %v", err)
+		}
+		if !strings.Contains(prompt, tt.want) {
+			t.Errorf("turn %d prompt does not contain %q:\n%s", tt.turn, tt.want, prompt)
+		}
+	}
+
+	// Socratic roles follow the round's speaking order
+	config.Style = "socratic"
+	config.MaxTurns = 3
+	debate, err = eng.CreateDebate(ctx, config)
+	if err != nil {
+		t.Fatalf("CreateDebate() error = %v", err)
+	}
+	speakers := roundSpeakers(debate, 1)
+	first, err := eng.buildPrompt(debate, speakers[0], nil, 1, false)
+	if err != nil {
+		t.Fatalf("buildPrompt() error = %v", err)
+	}
+	if !strings.Contains(first, "QUESTIONER") {
+		t.Errorf("first speaker is not the questioner:\n%s", first)
+	}
+	opening := []*core.Turn{{Round: 1, AgentID: speakers[0].ID, TurnType: core.TurnTypeDebate, Content: "Why?"}}
+	second, err := eng.buildPrompt(debate, speakers[1], opening, 1, false)
+	if err != nil {
+		t.Fatalf("buildPrompt() error = %v", err)
+	}
+	if !strings.Contains(second, "RESPONDER") {
+		t.Errorf("second speaker is not the responder:\n%s", second)
+	}
+}
+
+func TestRunDebateWithModerator(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	eng.registry.Register(&MockProvider{
+		name:      "mockmod",
+		available: true,
+		responses: []string{"SUMMARY: Both sides repeat themselves.\nUNSUPPORTED: none\n**QUESTION:** What would change your mind?"},
+	})
+
+	ctx := context.Background()
+
+	config := core.NewDebateConfig{
+		Topic:          "Test",
+		AgentAProvider: "mock",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "mock",
+		AgentBPersona:  "skeptic",
+		Style:          "adversarial",
+		MaxTurns:       2,
+		Consensus:      &core.ConsensusConfig{Method: core.ConsensusKeyword, Threshold: 1},
+		Moderator:      &core.MemberSpec{Provider: "mockmod", Persona: "analyst"},
+	}
+	debate, err := eng.CreateDebate(ctx, config)
+	if err != nil {
+		t.Fatalf("CreateDebate() error = %v", err)
+	}
+	if debate.Moderator == nil || debate.Moderator.MaskedName != "Moderator" {
+		t.Fatalf("moderator not set: %+v", debate.Moderator)
+	}
+
+	var callbackTypes []core.TurnType
+	if err := eng.RunDebate(ctx, debate.ID, func(turn *core.Turn, d *core.Debate) {
+		callbackTypes = append(callbackTypes, turn.TurnType)
+	}); err != nil {
+		t.Fatalf("RunDebate() error = %v", err)
+	}
+
+	// The moderator speaks between the two rotations, not after the last one
+	final, turns, _ := eng.GetDebateWithTurns(debate.ID)
+	var moderatorTurns []*core.Turn
+	for _, turn := range turns {
+		if turn.TurnType == core.TurnTypeModerator {
+			moderatorTurns = append(moderatorTurns, turn)
+		}
+	}
+	if len(moderatorTurns) != 1 || moderatorTurns[0].Number != 3 || !final.IsModerator(moderatorTurns[0].AgentID) {
+		t.Fatalf("expected one moderator turn after the first rotation, got %+v", moderatorTurns)
+	}
+	if len(callbackTypes) != 5 || callbackTypes[2] != core.TurnTypeModerator {
+		t.Errorf("wrong callbacks: %v", callbackTypes)
+	}
+	if completed, _ := turnResults(turns); completed != 4 {
+		t.Errorf("moderator turn counted as a debate turn: %d completed", completed)
+	}
+	if _, taken := debateProgress(turns[:3]); taken != 2 {
+		t.Errorf("debateProgress() counted the moderator: %d taken", taken)
+	}
+
+	stats := core.ComputeDebateStats(turns, final.AgentIDs()...)
+	if stats.ModeratorTurnCount != 1 || stats.AgentATurnCount != 2 || stats.AgentBTurnCount != 2 {
+		t.Errorf("wrong stats: moderator %d, A %d, B %d", stats.ModeratorTurnCount, stats.AgentATurnCount, stats.AgentBTurnCount)
+	}
+
+	// The next rotation's prompts carry the question, and the previous
+	// argument is the last debater's, not the moderator's summary
+	prompt, err := eng.buildPrompt(final, final.AgentA, turns[:3], 4, false)
+	if err != nil {
+		t.Fatalf("buildPrompt() error = %v", err)
+	}
+	if !strings.Contains(prompt, "The moderator asks: What would change your mind?") {
+		t.Errorf("prompt does not include the moderator's question:\n%s", prompt)
+	}
+	if !strings.Contains(prompt, "---\n"+turns[1].Content+"\n---") {
+		t.Errorf("previous argument is not the last debate turn:\n%s", prompt)
+	}
+	if prompt, _ := eng.buildPrompt(final, final.AgentA, turns[:2], 3, false); strings.Contains(prompt, "moderator asks") {
+		t.Error("prompt includes a question before the moderator spoke")
+	}
+}
+
+func TestParseModeratorQuestion(t *testing.T) {
+	tests := []struct {
+		content string
+		want    string
+	}{
+		{"SUMMARY: x\nQUESTION: Why now?", "Why now?"},
+		{"SUMMARY: x\n**QUESTION:** Why now?", "Why now?"},
+		{"SUMMARY: x\n**Question**: *Why now?*", "Why now?"},
+		{"SUMMARY: the question: is open", ""},
+		{"No format at all", ""},
+	}
+	for _, tt := range tests {
+		if got := parseModeratorQuestion(tt.content); got != tt.want {
+			t.Errorf("parseModeratorQuestion(%q) = %q, want %q", tt.content, got, tt.want)
+		}
+	}
+}
+
+func TestHashString(t *testing.T) {
+	// Same string should produce same hash
+	h1 := hashString("test")
+	h2 := hashString("test")
+	if h1 != h2 {
+		t.Error("same string produced different hashes")
+	}
+
+	// Different strings should produce different hashes
+	h3 := hashString("different")
+	if h1 == h3 {
+		t.Error("different strings produced same hash")
+	}
+}
+
+func TestRunDebateWithJudge(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	judgeProv := &MockProvider{
+		name:      "judge",
+		available: true,
+		responses: []string{"placeholder"},
+	}
+	eng.registry.Register(judgeProv)
+
+	ctx := context.Background()
+
+	config := core.NewDebateConfig{
+		Topic:          "Test",
+		AgentAProvider: "mock",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "mock",
+		AgentBPersona:  "skeptic",
+		Style:          "collaborative",
+		MaxTurns:       1,
+		Judge:          &core.MemberSpec{Provider: "judge", Persona: "analyst"},
+	}
+	debate, err := eng.CreateDebate(ctx, config)
+	if err != nil {
+		t.Fatalf("failed to create debate: %v", err)
+	}
+	if debate.Judge == nil {
+		t.Fatal("judge not set")
+	}
+
+	// Score agent B higher so the verdict has a winner
+	judgeProv.responses = []string{
+		"SCORE: " + debate.AgentA.MaskedName + " | reasoning=6 | evidence=5 | rebuttal=6 | clarity=7 | FEEDBACK: Solid but thin.\n" +
+			"SCORE: " + debate.AgentB.MaskedName + " | reasoning=8 | evidence=8 | rebuttal=7 | clarity=8 | FEEDBACK: Well supported.\n" +
+			"OUTCOME: WINNER " + debate.AgentB.MaskedName + "\n" +
+			"SUMMARY: Agent B made the stronger case.",
+	}
+
+	if err := eng.RunDebate(ctx, debate.ID, nil); err != nil {
+		t.Fatalf("failed: %v", err)
+	}
+
+	final, turns, _ := eng.GetDebateWithTurns(debate.ID)
+	if final.Judge == nil || final.Judge.ID != debate.Judge.ID {
+		t.Fatalf("judge not persisted: %+v", final.Judge)
+	}
+
+	// debate (2) + judge (1), no self-votes
+	var judgeTurns, voteTurns int
+	for _, turn := range turns {
+		switch turn.TurnType {
+		case core.TurnTypeJudge:
+			judgeTurns++
+		case core.TurnTypeVote:
+			voteTurns++
+		}
+	}
+	if judgeTurns != 1 || voteTurns != 0 {
+		t.Errorf("got %d judge turns and %d vote turns, want 1 and 0", judgeTurns, voteTurns)
+	}
+
+	if len(final.Conclusions) != 1 {
+		t.Fatalf("wrong conclusion count: got %d, want 1", len(final.Conclusions))
+	}
+	verdict := final.Conclusions[0].Verdict
+	if verdict == nil {
+		t.Fatal("verdict is nil")
+	}
+	if verdict.Outcome != core.VerdictWinner || verdict.WinnerID != debate.AgentB.ID {
+		t.Errorf("wrong verdict: outcome=%s winner=%s", verdict.Outcome, verdict.WinnerID)
+	}
+	if score := verdict.ScoreFor(debate.AgentB.ID); score == nil || score.Total != 31 {
+		t.Errorf("wrong score for agent B: %+v", score)
+	}
+	if final.Conclusions[0].Summary != "Agent B made the stronger case." {
+		t.Errorf("wrong summary: %q", final.Conclusions[0].Summary)
+	}
+}
+
+func TestParseVerdict(t *testing.T) {
+	agents := []core.Agent{
+		{ID: "a", MaskedName: "Agent A"},
+		{ID: "b", MaskedName: "Agent B"},
+	}
+
+	tests := []struct {
+		name       string
+		content    string
+		outcome    core.VerdictOutcome
+		winnerID   string
+		scoreCount int
+		summary    string
+	}{
+		{
+			name:       "consensus",
+			content:    "SCORE: Agent A | reasoning=7 | clarity=8\nSCORE: Agent B | reasoning=7 | clarity=8\nOUTCOME: CONSENSUS\nSUMMARY: Both converged.\nThey agree on tests.",
+			outcome:    core.VerdictConsensus,
+			scoreCount: 2,
+			summary:    "Both converged.\nThey agree on tests.",
+		},
+		{
+			name:       "winner derived from scores",
+			content:    "SCORE: Agent A | reasoning=9 | evidence=9\nSCORE: Agent B | reasoning=4 | evidence=5",
+			outcome:    core.VerdictWinner,
+			winnerID:   "a",
+			scoreCount: 2,
+		},
+		{
+			name:       "tie without outcome is a draw",
+			content:    "SCORE: Agent A | reasoning=5\nSCORE: Agent B | reasoning=5",
+			outcome:    core.VerdictDraw,
+			scoreCount: 2,
+		},
+		{
+			name:       "scores are clamped and unknown criteria ignored",
+			content:    "score: agent b | reasoning=42 | charisma=10\nOUTCOME: winner Agent B",
+			outcome:    core.VerdictWinner,
+			winnerID:   "b",
+			scoreCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, summary := parseVerdict(tt.content, agents, core.DefaultRubric)
+			if verdict.Outcome != tt.outcome {
+				t.Errorf("outcome: got %s, want %s", verdict.Outcome, tt.outcome)
+			}
+			if verdict.WinnerID != tt.winnerID {
+				t.Errorf("winner: got %q, want %q", verdict.WinnerID, tt.winnerID)
+			}
+			if len(verdict.Scores) != tt.scoreCount {
+				t.Errorf("score count: got %d, want %d", len(verdict.Scores), tt.scoreCount)
+			}
+			if summary != tt.summary {
+				t.Errorf("summary: got %q, want %q", summary, tt.summary)
+			}
+		})
+	}
+
+	verdict, _ := parseVerdict("SCORE: Agent B | reasoning=42", agents, core.DefaultRubric)
+	if got := verdict.ScoreFor("b").Scores["reasoning"]; got != 10 {
+		t.Errorf("score not clamped: got %d, want 10", got)
+	}
+}
+
+func TestDebateBudget(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	eng.registry.Register(&MockProvider{
+		name:      "mockpaid",
+		available: true,
+		responses: []string{"A costly argument."},
+		metadata:  &extprovider.Metadata{TotalTokens: 100, CostUSD: 0.01},
+	})
+
+	ctx := context.Background()
+
+	now := time.Now()
+	project := &core.Project{ID: "budget-project", Name: "Budgeted", Budget: &core.Budget{MaxTokens: 250, MaxCostUSD: 1}, CreatedAt: now, UpdatedAt: now}
+	if err := eng.storage.CreateProject(project); err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+
+	config := core.NewDebateConfig{
+		Topic:          "Test",
+		ProjectID:      project.ID,
+		AgentAProvider: "mockpaid",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "mockpaid",
+		AgentBPersona:  "skeptic",
+		Style:          "adversarial",
+		MaxTurns:       3,
+		Consensus:      &core.ConsensusConfig{Method: core.ConsensusKeyword, Threshold: 1},
+		Budget:         &core.Budget{MaxCostUSD: 5},
+	}
+	debate, err := eng.CreateDebate(ctx, config)
+	if err != nil {
+		t.Fatalf("CreateDebate() error = %v", err)
+	}
+	// Limits set on the debate win; unset ones come from the project
+	if debate.Budget == nil || debate.Budget.MaxTokens != 250 || debate.Budget.MaxCostUSD != 5 {
+		t.Fatalf("wrong budget: %+v", debate.Budget)
+	}
+
+	if err := eng.RunDebate(ctx, debate.ID, nil); err != nil {
+		t.Fatalf("RunDebate() error = %v", err)
+	}
+
+	final, turns, _ := eng.GetDebateWithTurns(debate.ID)
+	if len(turns) != 3 {
+		t.Errorf("expected the debate to stop after 3 turns (300 tokens), got %d", len(turns))
+	}
+	if final.Status != core.StatusCompleted {
+		t.Errorf("Status = %s, want completed", final.Status)
+	}
+	if !strings.Contains(final.StopReason, "token budget of 250 reached") {
+		t.Errorf("StopReason = %q", final.StopReason)
+	}
+	if len(final.Conclusions) != 1 || !strings.HasPrefix(final.Conclusions[0].Summary, "Stopped early:") {
+		t.Errorf("expected a stopped-early conclusion, got %+v", final.Conclusions)
+	}
+}
+
+func TestRunDebateWithOptionVotes(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	// Each provider gives the same reply to every prompt, so it serves as
+	// debate turn, extracted options and vote alike
+	eng.registry.Register(&MockProvider{
+		name:      "voter1",
+		available: true,
+		responses: []string{`{"options": ["Adopt now", "Wait a year"], "option": "adopt now", "confidence": 0.9, "rationale": "It pays off."}`},
+	})
+	eng.registry.Register(&MockProvider{
+		name:      "voter2",
+		available: true,
+		responses: []string{"```json\n{\"options\": [\"Adopt now\", \"Wait a year\"], \"option\": 2, \"confidence\": \"70%\", \"rationale\": \"Too risky.\",}\n```"},
+	})
+
+	ctx := context.Background()
+	debate, err := eng.CreateDebate(ctx, core.NewDebateConfig{
+		Topic:          "Should we adopt GraphQL?",
+		AgentAProvider: "voter1",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "voter2",
+		AgentBPersona:  "skeptic",
+		Style:          "collaborative",
+		MaxTurns:       1,
+		Consensus:      &core.ConsensusConfig{Method: core.ConsensusKeyword, Threshold: 1},
+		Vote:           &core.VoteConfig{},
+	})
+	if err != nil {
+		t.Fatalf("CreateDebate() error = %v", err)
+	}
+	if err := eng.RunDebate(ctx, debate.ID, nil); err != nil {
+		t.Fatalf("RunDebate() error = %v", err)
+	}
+
+	final, _ := eng.GetDebate(debate.ID)
+	if final.Vote == nil || len(final.Conclusions) != 1 {
+		t.Fatalf("expected a vote config and one conclusion, got %+v", final)
+	}
+	conclusion := final.Conclusions[0]
+	tally := conclusion.Tally
+	if tally == nil || len(tally.Options) != 2 {
+		t.Fatalf("expected a tally over 2 options, got %+v", tally)
+	}
+	// One vote each; the higher confidence wins the tie
+	if tally.Winner != "Adopt now" || tally.Options[0].Votes != 1 || tally.Options[1].Confidence != 0.7 {
+		t.Errorf("unexpected tally: %+v %+v winner=%q", tally.Options[0], tally.Options[1], tally.Winner)
+	}
+	voteA, voteB := conclusion.VoteFor(debate.AgentA.ID), conclusion.VoteFor(debate.AgentB.ID)
+	if voteA == nil || voteA.Option != "Adopt now" || !voteA.Agrees || voteA.Reasoning != "It pays off." {
+		t.Errorf("unexpected agent A vote: %+v", voteA)
+	}
+	if voteB == nil || voteB.Option != "Wait a year" || voteB.Agrees {
+		t.Errorf("unexpected agent B vote: %+v", voteB)
+	}
+	if conclusion.Agreed {
+		t.Error("split vote should not be a consensus")
+	}
+}
+
+func TestOptionVotesWithHumanSeatA(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	eng.registry.Register(&MockProvider{
+		name:      "voter",
+		available: true,
+		responses: []string{`{"options": ["Adopt now", "Wait a year"], "option": 1, "confidence": 0.8, "rationale": "It pays off."}`},
+	})
+
+	ctx := context.Background()
+	debate, err := eng.CreateDebate(ctx, core.NewDebateConfig{
+		Topic:          "Should we adopt GraphQL?",
+		AgentAProvider: core.ProviderHuman,
+		AgentBProvider: "voter",
+		AgentBPersona:  "skeptic",
+		Style:          "collaborative",
+		MaxTurns:       1,
+		Vote:           &core.VoteConfig{},
+	})
+	if err != nil {
+		t.Fatalf("CreateDebate() error = %v", err)
+	}
+
+	err = eng.RunDebate(ctx, debate.ID, nil)
+	for i := 0; errors.Is(err, ErrAwaitingInput) && i < 2; i++ {
+		if _, err := eng.SubmitHumanTurn(debate.ID, "Adopt it now."); err != nil {
+			t.Fatalf("SubmitHumanTurn() error = %v", err)
+		}
+		err = eng.RunDebate(ctx, debate.ID, nil)
+	}
+	if err != nil {
+		t.Fatalf("RunDebate() error = %v", err)
+	}
+
+	// Options are extracted by the model seat, not the human in seat A
+	final, _ := eng.GetDebate(debate.ID)
+	tally := final.Conclusions[0].Tally
+	if tally == nil || len(tally.Options) != 2 || tally.Winner != "Adopt now" {
+		t.Fatalf("expected a tally over the extracted options, got %+v", tally)
+	}
+}
+
+func TestGetOptionVoteReasks(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	flaky := &MockProvider{
+		name:      "flaky",
+		available: true,
+		responses: []string{"I vote for the first one!", `{"option": 1, "confidence": 0.6, "rationale": "Cheaper."}`},
+	}
+	eng.registry.Register(flaky)
+
+	ctx := context.Background()
+	debate, err := eng.CreateDebate(ctx, core.NewDebateConfig{
+		Topic:          "Test",
+		AgentAProvider: "mock",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "flaky",
+		AgentBPersona:  "skeptic",
+		Style:          "collaborative",
+		MaxTurns:       1,
+		Vote:           &core.VoteConfig{Options: []string{" Buy ", "Build", ""}},
+	})
+	if err != nil {
+		t.Fatalf("CreateDebate() error = %v", err)
+	}
+	if len(debate.Vote.Options) != 2 || debate.Vote.Options[0] != "Buy" {
+		t.Errorf("options not normalized: %q", debate.Vote.Options)
+	}
+
+	vote, err := eng.getOptionVote(ctx, debate, debate.AgentB, "", debate.Vote.Options)
+	if err != nil {
+		t.Fatalf("getOptionVote() error = %v", err)
+	}
+	if vote.Option != "Buy" || vote.Confidence != 0.6 || flaky.callCount != 2 {
+		t.Errorf("got %+v after %d calls, want Buy after a re-ask", vote, flaky.callCount)
+	}
+	if turns, _ := eng.storage.GetTurns(debate.ID); len(turns) != 2 {
+		t.Errorf("expected both replies saved as vote turns, got %d", len(turns))
+	}
+
+	// Still invalid after the re-ask
+	flaky.responses, flaky.callCount = []string{`{"option": 3, "confidence": 0.5, "rationale": "?"}`}, 0
+	if _, err := eng.getOptionVote(ctx, debate, debate.AgentB, "", debate.Vote.Options); err == nil || !strings.Contains(err.Error(), "not one of the 2 options") {
+		t.Errorf("expected an invalid option error, got %v", err)
+	}
+
+	if _, err := eng.CreateDebate(ctx, core.NewDebateConfig{Topic: "T", AgentAProvider: "mock", AgentAPersona: "optimist", AgentBProvider: "mock", AgentBPersona: "skeptic", Vote: &core.VoteConfig{Options: []string{"Yes", "yes"}}}); err == nil || !strings.Contains(err.Error(), "duplicate vote option") {
+		t.Errorf("expected duplicate options to be rejected, got %v", err)
+	}
+}
+
+func TestParseOptionVote(t *testing.T) {
+	options := []string{"Monolith", "Microservices", "Modular monolith"}
+	tests := []struct {
+		name       string
+		content    string
+		option     string
+		confidence float64
+		wantErr    string
+	}{
+		{"number", `{"option": 2, "confidence": 0.8, "rationale": "Scale."}`, "Microservices", 0.8, ""},
+		{"text", `Here is my vote: {"option": "modular monolith", "confidence": 1, "rationale": "Both."} Thanks.`, "Modular monolith", 1, ""},
+		{"numbered text", `{"option": "3. Modular monolith", "confidence": 0.5, "rationale": "Both."}`, "Modular monolith", 0.5, ""},
+		{"percentage", `{"option": "Option 1", "confidence": 75, "rationale": "Simple."}`, "Monolith", 0.75, ""},
+		{"repaired", "```\n{“option”: 1, “confidence”: “40%”, “rationale”: “Simple.”,}\n```", "Monolith", 0.4, ""},
+		{"no json", "Monolith, definitely.", "", 0, "no JSON object"},
+		{"unknown option", `{"option": "Serverless", "confidence": 0.9, "rationale": "Cheap."}`, "", 0, "not one of the 3 options"},
+		{"missing confidence", `{"option": 1, "rationale": "Simple."}`, "", 0, "confidence is required"},
+		{"bad confidence", `{"option": 1, "confidence": 150, "rationale": "Simple."}`, "", 0, "not between 0 and 1"},
+		{"missing rationale", `{"option": 1, "confidence": 0.5}`, "", 0, "rationale is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vote, err := parseOptionVote(tt.content, options)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("error = %v, want it to mention %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseOptionVote() error = %v", err)
+			}
+			if vote.Option != tt.option || vote.Confidence != tt.confidence {
+				t.Errorf("got %q at %v, want %q at %v", vote.Option, vote.Confidence, tt.option, tt.confidence)
+			}
+		})
+	}
+}
+
+func TestTallyVotes(t *testing.T) {
+	options := []string{"A", "B", "C"}
+	tally := core.TallyVotes(options, []*core.Vote{
+		{AgentID: "1", Option: "B", Confidence: 0.5},
+		{AgentID: "2", Option: "B", Confidence: 0.5},
+		{AgentID: "3", Option: "A", Confidence: 1},
+		{AgentID: "4", Option: "D", Confidence: 1},
+		nil,
+	})
+	if tally.Winner != "B" || tally.Options[1].Votes != 2 || tally.Options[1].Confidence != 1 || tally.Options[2].Votes != 0 {
+		t.Errorf("unexpected tally: %s", tally)
+	}
+
+	tied := core.TallyVotes(options, []*core.Vote{{Option: "A", Confidence: 0.5}, {Option: "C", Confidence: 0.5}})
+	if tied.Winner != "" {
+		t.Errorf("expected no winner on a tie, got %q", tied.Winner)
+	}
+}
+
+func TestArguments(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	// Every turn and extraction gets the same reply; the rebuttal only
+	// resolves once t1c1 exists
+	eng.registry.Register(&MockProvider{
+		name:      "arguer",
+		available: true,
+		responses: []string{`{"claims": [{"text": "Spaces render the same everywhere.", "evidence": ["Editors disagree on tab width", " "], "rebuts": ["T1C1", "t9c9"]}, {"text": " "}]}`},
+	})
+
+	ctx := context.Background()
+	debate, err := eng.CreateDebate(ctx, core.NewDebateConfig{
+		Topic:          "Tabs or spaces?",
+		AgentAProvider: "arguer",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "arguer",
+		AgentBPersona:  "skeptic",
+		SpeakingOrder:  core.SpeakingOrderRoundRobin,
+		Style:          "collaborative",
+		MaxTurns:       1,
+		Consensus:      &core.ConsensusConfig{Method: core.ConsensusKeyword, Threshold: 1},
+	})
+	if err != nil {
+		t.Fatalf("CreateDebate() error = %v", err)
+	}
+	if err := eng.RunDebate(ctx, debate.ID, nil); err != nil {
+		t.Fatalf("RunDebate() error = %v", err)
+	}
+
+	graph, err := eng.Arguments(ctx, debate.ID)
+	if err != nil {
+		t.Fatalf("Arguments() error = %v", err)
+	}
+	if len(graph.Claims) != 2 || graph.Pending != 0 {
+		t.Fatalf("expected one claim per turn, got %+v", graph)
+	}
+	first, second := graph.Claims[0], graph.Claims[1]
+	if first.ID != "t1c1" || len(first.Rebuts) != 0 || len(first.Evidence) != 1 {
+		t.Errorf("unexpected first claim: %+v", first)
+	}
+	if second.ID != "t2c1" || len(second.Rebuts) != 1 || second.Rebuts[0] != "t1c1" || second.AgentID == first.AgentID {
+		t.Errorf("unexpected second claim: %+v", second)
+	}
+	if unanswered := graph.Unanswered(); len(unanswered) != 1 || unanswered[0].ID != "t2c1" {
+		t.Errorf("unanswered = %+v, want only t2c1", unanswered)
+	}
+
+	// Extracted turns are stored, so the model is not asked again
+	eng.registry.Register(&MockProvider{name: "arguer", available: true, responses: []string{"not JSON"}})
+	again, err := eng.Arguments(ctx, debate.ID)
+	if err != nil || len(again.Claims) != 2 {
+		t.Fatalf("Arguments() from storage = %+v, %v", again, err)
+	}
+
+	// Changing a turn's version discards its claims and later ones; a failed
+	// extraction returns what it has with the rest pending
+	turns, _ := eng.storage.GetTurns(debate.ID)
+	turns[1].PushVersion(&core.Version{Content: "Tabs are more accessible.", Status: "completed"})
+	eng.storage.UpdateTurn(turns[1])
+	if _, err := eng.SelectTurnVersion(debate.ID, turns[1].ID, 1); err != nil {
+		t.Fatalf("SelectTurnVersion() error = %v", err)
+	}
+	partial, err := eng.Arguments(ctx, debate.ID)
+	if err == nil || partial == nil || len(partial.Claims) != 1 || partial.Pending != 1 {
+		t.Errorf("Arguments() after a failure = %+v, %v", partial, err)
+	}
+}
+
+func TestParseClaims(t *testing.T) {
+	turn := &core.Turn{ID: "turn-3", Number: 3, AgentID: "a"}
+	known := map[string]bool{"t1c1": true}
+
+	if _, err := parseClaims(`{"summary": "no claims key"}`, turn, known); err == nil {
+		t.Error("expected an error without a claims list")
+	}
+	claims, err := parseClaims("```json\n{\"claims\": []}\n```", turn, known)
+	if err != nil || len(claims) != 0 {
+		t.Errorf("empty claims = %+v, %v", claims, err)
+	}
+
+	var many strings.Builder
+	many.WriteString(`{"claims": [`)
+	for i := 0; i < maxClaimsPerTurn+2; i++ {
+		if i > 0 {
+			many.WriteString(",")
+		}
+		many.WriteString(`{"text": "Claim", "rebuts": ["t1c1", "t1c1"]}`)
+	}
+	many.WriteString("]}")
+	claims, err = parseClaims(many.String(), turn, known)
+	if err != nil || len(claims) != maxClaimsPerTurn {
+		t.Fatalf("got %d claims (%v), want %d", len(claims), err, maxClaimsPerTurn)
+	}
+	if last := claims[len(claims)-1]; last.ID != "t3c8" || last.TurnID != "turn-3" || len(last.Rebuts) != 1 {
+		t.Errorf("unexpected claim: %+v", last)
+	}
+}
+
+func TestSteelmanTurns(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	// The judge rates B's restatement 3 (retried), then 9
+	eng.registry.Register(&MockProvider{
+		name:      "opener",
+		available: true,
+		responses: []string{"Tabs let every reader pick their own indent width."},
+	})
+	eng.registry.Register(&MockProvider{
+		name:      "rater",
+		available: true,
+		responses: []string{
+			`{"score": 3, "rationale": "Misses the accessibility point."}`,
+			`{"score": 9, "rationale": "Faithful."}`,
+		},
+	})
+	eng.registry.Register(&MockProvider{
+		name:      "answerer",
+		available: true,
+		responses: []string{"## Steelman\nTabs adapt to each reader.\n\n## Response\nSpaces keep alignment intact."},
+	})
+
+	ctx := context.Background()
+	debate, err := eng.CreateDebate(ctx, core.NewDebateConfig{
+		Topic:          "Tabs or spaces?",
+		AgentAProvider: "opener",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "answerer",
+		AgentBPersona:  "skeptic",
+		SpeakingOrder:  core.SpeakingOrderRoundRobin,
+		Style:          "collaborative",
+		MaxTurns:       1,
+		Consensus:      &core.ConsensusConfig{Method: core.ConsensusKeyword, Threshold: 1},
+		Judge:          &core.MemberSpec{Provider: "rater"},
+		Steelman:       &core.SteelmanConfig{},
+	})
+	if err != nil {
+		t.Fatalf("CreateDebate() error = %v", err)
+	}
+	if err := eng.RunDebate(ctx, debate.ID, nil); err != nil {
+		t.Fatalf("RunDebate() error = %v", err)
+	}
+
+	turns, _ := eng.storage.GetTurns(debate.ID)
+	if turns[0].Steelman != nil {
+		t.Errorf("opening turn has nothing to restate, got %+v", turns[0].Steelman)
+	}
+	score := turns[1].Steelman
+	if score == nil {
+		t.Fatal("expected the answering turn to be rated")
+	}
+	if score.Score != 9 || !score.Passed || score.Attempts != 2 || score.TargetTurn != 1 {
+		t.Errorf("unexpected score: %+v", score)
+	}
+	if score.TargetID != debate.AgentA.ID || score.RaterID != debate.Judge.ID || score.Restatement != "Tabs adapt to each reader." {
+		t.Errorf("unexpected target, rater or restatement: %+v", score)
+	}
+
+	debate, _ = eng.GetDebate(debate.ID)
+	summaries := debate.Conclusions[0].Steelman
+	if len(summaries) != 1 || summaries[0].AgentID != debate.AgentB.ID || summaries[0].Average != 9 || summaries[0].Retries != 1 {
+		t.Errorf("unexpected conclusion summary: %+v", summaries)
+	}
+
+	if _, err := eng.CreateDebate(ctx, core.NewDebateConfig{
+		Topic: "x", AgentAProvider: "opener", AgentAPersona: "optimist", AgentBProvider: "answerer", AgentBPersona: "skeptic",
+		Style: "collaborative", Steelman: &core.SteelmanConfig{MinScore: 11},
+	}); err == nil {
+		t.Error("expected an error for a minimum score above 10")
+	}
+}
+
+func TestParseSteelman(t *testing.T) {
+	if got := extractRestatement("Intro\n### Steelman of Agent A\nThey argue X.\nAnd Y.\n## Response\nNo."); got != "They argue X.\nAnd Y." {
+		t.Errorf("extractRestatement() = %q", got)
+	}
+	if got := extractRestatement("No sections here."); got != "" {
+		t.Errorf("expected no restatement, got %q", got)
+	}
+
+	if score, _, err := parseSteelmanRating("```json\n{\"score\": 7.6, \"rationale\": \"ok\"}\n```"); err != nil || score != 8 {
+		t.Errorf("parseSteelmanRating() = %d, %v", score, err)
+	}
+	for _, reply := range []string{`{"rationale": "no score"}`, `{"score": 0}`, `{"score": 12}`} {
+		if _, _, err := parseSteelmanRating(reply); err == nil {
+			t.Errorf("expected an error for %s", reply)
+		}
+	}
+}
+
+func TestTargetedFollowUp(t *testing.T) {
+	eng, cleanup := setupTestEngine(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	debate, err := eng.CreateDebate(ctx, core.NewDebateConfig{
+		Topic:          "Test",
+		AgentAProvider: "mock",
+		AgentAPersona:  "optimist",
+		AgentBProvider: "mock",
+		AgentBPersona:  "skeptic",
+		Style:          "collaborative",
+		MaxTurns:       1,
+	})
+	if err != nil {
+		t.Fatalf("CreateDebate() error = %v", err)
+	}
+	if err := eng.RunDebate(ctx, debate.ID, nil); err != nil {
+		t.Fatalf("RunDebate() error = %v", err)
+	}
+
+	if err := eng.AddFollowUp(ctx, debate.ID, core.FollowUp{Content: "Why?", Synthesize: true}); err == nil {
+		t.Error("expected a debate follow-up synthesis to be rejected")
+	}
+	if err := eng.AddFollowUp(ctx, debate.ID, core.FollowUp{Content: "Why?", Targets: []string{"nobody"}}); err == nil {
+		t.Error("expected an unknown target to be rejected")
+	}
+
+	if err := eng.AddFollowUp(ctx, debate.ID, core.FollowUp{Content: "@Skeptic, expand on the security risk"}); err != nil {
+		t.Fatalf("AddFollowUp() error = %v", err)
+	}
+	eng.WaitDebate(ctx, debate.ID)
+
+	final, turns, _ := eng.GetDebateWithTurns(debate.ID)
+	if final.Status != core.StatusCompleted {
+		t.Errorf("wrong status: got %s, want completed", final.Status)
+	}
+	skeptic := final.Participants()[1]
+
+	var followUp *core.Turn
+	var answers []*core.Turn
+	for _, turn := range turns {
+		if turn.Round != 2 {
+			continue
+		}
+		if turn.AgentID == "user" {
+			followUp = turn
+		} else if isDebateTurn(turn) {
+			answers = append(answers, turn)
+		}
+	}
+	if followUp == nil || followUp.Targeting == nil || len(followUp.Targeting.AgentIDs) != 1 || followUp.Targeting.AgentIDs[0] != skeptic.ID {
+		t.Fatalf("follow-up targeting = %+v, want the skeptic", followUp)
+	}
+	if len(answers) != 1 || answers[0].AgentID != skeptic.ID {
+		t.Errorf("round 2 answers = %d, want one from the skeptic", len(answers))
+	}
+	if got := NextStep(final, turns); got != "conclusion for round 2" {
+		t.Errorf("NextStep() = %q", got)
 	}
 }
@@ -0,0 +1,264 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// ForkDebate copies a debate up to turn cfg.At into a new debate linked to
+// its parent, applying the config's content, style and agent swaps. Agents
+// keep their IDs and masked names so the copied history reads the same. The
+// fork is completed if it ends on a concluded round and paused otherwise, so
+// resuming it continues from the turn after the fork point.
+func (e *Engine) ForkDebate(id string, cfg core.ForkConfig) (*core.Debate, error) {
+	parent, err := e.storage.GetDebate(id)
+	if err != nil {
+		return nil, err
+	}
+	if parent == nil {
+		return nil, fmt.Errorf("debate not found: %s", id)
+	}
+	turns, err := e.storage.GetTurns(id)
+	if err != nil {
+		return nil, err
+	}
+	if len(turns) == 0 {
+		return nil, fmt.Errorf("debate has no turns to fork from")
+	}
+
+	at := cfg.At
+	if at == 0 {
+		at = turns[len(turns)-1].Number
+	}
+	var forkTurn *core.Turn
+	for _, t := range turns {
+		if t.Number == at {
+			forkTurn = t
+		}
+	}
+	if forkTurn == nil {
+		return nil, fmt.Errorf("turn %d not found (debate has %d turns)", at, len(turns))
+	}
+
+	content := strings.TrimSpace(cfg.Content)
+	if content != "" {
+		switch forkTurn.TurnType {
+		case core.TurnTypeVote, core.TurnTypeConclusion, core.TurnTypeJudge, core.TurnTypeModerator:
+			return nil, fmt.Errorf("turn %d is a %s turn and cannot be rewritten", at, forkTurn.TurnType)
+		}
+	}
+
+	e.ensureMaskedNames(parent)
+	agents := parent.Participants()
+	for _, swap := range cfg.Swaps {
+		if swap.Seat < 0 || swap.Seat >= len(agents) {
+			return nil, fmt.Errorf("invalid seat %d (debate has %d agents)", swap.Seat, len(agents))
+		}
+		agent, err := e.swapAgent(agents[swap.Seat], swap)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", agentLabel(swap.Seat), err)
+		}
+		agents[swap.Seat] = agent
+	}
+
+	styleID, maxTurns := parent.Style, parent.MaxTurns
+	if cfg.Style != "" {
+		styleDef := e.getStyle(cfg.Style)
+		if styleDef == nil {
+			return nil, fmt.Errorf("invalid debate style: %s", cfg.Style)
+		}
+		styleID, maxTurns = cfg.Style, styleDef.TurnsPerAgent(parent.MaxTurns)
+	}
+
+	// The fork round's conclusion only carries over if the round finished
+	// within the fork point and nothing in it was rewritten
+	round := forkTurn.Round
+	roundDone := content == ""
+	for _, t := range turns {
+		if t.Round == round && t.Number > at {
+			roundDone = false
+		}
+	}
+
+	now := time.Now()
+	fork := &core.Debate{
+		ID:                  core.GenerateID(),
+		Title:               parent.Title + " (fork)",
+		Topic:               parent.Topic,
+		CWD:                 parent.CWD,
+		WorkspaceID:         parent.WorkspaceID,
+		ProjectID:           parent.ProjectID,
+		ProjectInstructions: parent.ProjectInstructions,
+		SpeakingOrder:       parent.SpeakingOrder,
+		Judge:               parent.Judge,
+		Moderator:           parent.Moderator,
+		Consensus:           parent.Consensus,
+		Vote:                parent.Vote,
+		Stalemate:           parent.Stalemate,
+		Steelman:            parent.Steelman,
+		ParentID:            parent.ID,
+		ForkPoint:           at,
+		Style:               styleID,
+		MaxTurns:            maxTurns,
+		Status:              core.StatusPaused,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+	fork.SetParticipants(agents)
+	if err := validateHumans(fork); err != nil {
+		return nil, err
+	}
+
+	for _, c := range parent.Conclusions {
+		if c.Round < round || (c.Round == round && roundDone) {
+			fork.Conclusions = append(fork.Conclusions, c)
+		}
+	}
+	if roundDone && len(fork.Conclusions) > 0 && fork.Conclusions[len(fork.Conclusions)-1].Round == round {
+		fork.Status = core.StatusCompleted
+		fork.CompletedAt = &now
+	}
+
+	if err := e.storage.CreateDebate(fork); err != nil {
+		return nil, fmt.Errorf("failed to create fork: %w", err)
+	}
+
+	for _, t := range turns {
+		if t.Number > at {
+			continue
+		}
+		if t.Round == round && !roundDone {
+			// An unfinished conclusion is regenerated when the fork runs
+			switch t.TurnType {
+			case core.TurnTypeVote, core.TurnTypeConclusion, core.TurnTypeJudge:
+				continue
+			}
+		}
+		copied := *t
+		copied.ID = core.GenerateID()
+		copied.DebateID = fork.ID
+		if t.Number == at && content != "" {
+			copied = core.Turn{
+				ID:        copied.ID,
+				DebateID:  fork.ID,
+				AgentID:   t.AgentID,
+				Number:    t.Number,
+				Round:     t.Round,
+				Content:   content,
+				CreatedAt: now,
+				TurnType:  t.TurnType,
+				Status:    "completed",
+				Targeting: t.Targeting,
+			}
+		}
+		if err := e.storage.AddTurn(&copied); err != nil {
+			return nil, fmt.Errorf("failed to copy turn %d: %w", t.Number, err)
+		}
+	}
+
+	return fork, nil
+}
+
+// swapAgent applies a fork swap to an agent, validating the new provider and
+// persona the same way CreateDebate does.
+func (e *Engine) swapAgent(agent core.Agent, swap core.AgentSwap) (core.Agent, error) {
+	if swap.Provider == core.ProviderHuman {
+		return core.Agent{
+			ID:         agent.ID,
+			Name:       "Human",
+			MaskedName: agent.MaskedName,
+			Provider:   core.ProviderHuman,
+		}, nil
+	}
+
+	if swap.Provider != "" && swap.Provider != agent.Provider {
+		agent.Provider = swap.Provider
+		agent.Model = ""
+		if agent.Persona == "" {
+			// Swapping a human seat for a model
+			agent.Persona = core.AssignDefaultPersonas([]core.MemberSpec{{Provider: swap.Provider}})[0].Persona
+		}
+	}
+	if swap.Model != "" {
+		agent.Model = swap.Model
+	}
+	if swap.Persona != "" {
+		agent.Persona = swap.Persona
+	}
+	if agent.Model == "" {
+		agent.Model = core.DefaultModelForProvider[agent.Provider]
+	}
+
+	prov, err := e.registry.Get(agent.Provider)
+	if err != nil {
+		return agent, fmt.Errorf("invalid provider: %w", err)
+	}
+	if !prov.Available() {
+		return agent, fmt.Errorf("provider %s is not available (CLI not found)", agent.Provider)
+	}
+	personaDef := e.getPersona(agent.Persona)
+	if personaDef == nil {
+		return agent, fmt.Errorf("invalid persona: %s", agent.Persona)
+	}
+
+	agent.Name = fmt.Sprintf("%s (%s) • %s", agent.Provider, personaDef.Name, agent.Model)
+	return agent, nil
+}
+
+// validateHumans applies CreateDebate's participant rules: at most one human
+// and at least one model.
+func validateHumans(debate *core.Debate) error {
+	humans := 0
+	for _, a := range debate.Participants() {
+		if a.IsHuman() {
+			humans++
+		}
+	}
+	if humans > 1 {
+		return fmt.Errorf("a debate can have at most one human participant, got %d", humans)
+	}
+	if len(debate.ModelParticipants()) == 0 {
+		return fmt.Errorf("a debate needs at least one model participant")
+	}
+	return nil
+}
+
+// DebateForkTree returns the fork tree containing a debate, rooted at its
+// oldest ancestor that still exists.
+func (e *Engine) DebateForkTree(id string) (*core.ForkNode, error) {
+	root, err := e.storage.GetDebate(id)
+	if err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return nil, fmt.Errorf("debate not found: %s", id)
+	}
+
+	seen := map[string]bool{root.ID: true}
+	for root.ParentID != "" && !seen[root.ParentID] {
+		parent, err := e.storage.GetDebate(root.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			break
+		}
+		seen[parent.ID] = true
+		root = parent
+	}
+
+	node := &core.ForkNode{
+		ID:        root.ID,
+		Title:     root.Title,
+		Status:    root.Status,
+		ForkPoint: root.ForkPoint,
+		CreatedAt: root.CreatedAt,
+	}
+	if err := node.ExpandForks(e.storage.ListDebateForks); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
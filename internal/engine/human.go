@@ -0,0 +1,114 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/coderef"
+	"github.com/alienxp03/conclave/internal/core"
+	"github.com/alienxp03/conclave/internal/run"
+)
+
+// ErrAwaitingInput is returned by RunDebate when the next turn belongs to
+// the debate's human participant. Submit it with SubmitHumanTurn, then run
+// the debate again.
+var ErrAwaitingInput = errors.New("debate is awaiting human input")
+
+// awaitHuman leaves the debate waiting for its human participant's turn.
+func (e *Engine) awaitHuman(debate *core.Debate) error {
+	debate.Status = core.StatusAwaitingInput
+	if err := e.storage.UpdateDebate(debate); err != nil {
+		return fmt.Errorf("failed to update debate status: %w", err)
+	}
+	slog.Info("Debate awaiting human input", "debate_id", debate.ID)
+	return ErrAwaitingInput
+}
+
+// AwaitingInput describes the human turn a debate is waiting for, or
+// returns nil if it is not waiting.
+func (e *Engine) AwaitingInput(debateID string) (*core.AwaitingInput, error) {
+	debate, err := e.storage.GetDebate(debateID)
+	if err != nil {
+		return nil, err
+	}
+	if debate == nil || debate.Status != core.StatusAwaitingInput {
+		return nil, nil
+	}
+	human, ok := debate.HumanAgent()
+	if !ok {
+		return nil, nil
+	}
+	e.ensureMaskedNames(debate)
+	human, _ = debate.AgentByID(human.ID)
+
+	turns, err := e.storage.GetTurns(debateID)
+	if err != nil {
+		return nil, err
+	}
+	round, taken := debateProgress(turns)
+
+	return &core.AwaitingInput{
+		AgentID:    human.ID,
+		MaskedName: human.MaskedName,
+		Round:      round,
+		TurnNumber: taken + 1,
+		TotalTurns: roundTurns(debate, turns, round),
+	}, nil
+}
+
+// SubmitHumanTurn records the human participant's turn in a debate that is
+// awaiting input. The debate is left in progress; run it again with
+// RunDebate or StartDebate to continue.
+func (e *Engine) SubmitHumanTurn(debateID, content string) (*core.Turn, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, fmt.Errorf("content is required")
+	}
+
+	debate, err := e.controlledDebate(debateID)
+	if err != nil {
+		return nil, err
+	}
+	if debate.Status != core.StatusAwaitingInput {
+		return nil, fmt.Errorf("debate is not awaiting input (status: %s)", debate.Status)
+	}
+	if e.runs.Running(debateID) {
+		return nil, run.ErrAlreadyRunning
+	}
+	human, ok := debate.HumanAgent()
+	if !ok {
+		return nil, fmt.Errorf("debate has no human participant")
+	}
+
+	turns, err := e.storage.GetTurns(debateID)
+	if err != nil {
+		return nil, err
+	}
+	round, _ := debateProgress(turns)
+
+	turn := &core.Turn{
+		ID:        core.GenerateID(),
+		DebateID:  debate.ID,
+		AgentID:   human.ID,
+		Number:    len(turns) + 1,
+		Round:     round,
+		Content:   content,
+		CreatedAt: time.Now(),
+		TurnType:  core.TurnTypeDebate,
+		Status:    "completed",
+		// People cite code too; check it like any other turn
+		References: coderef.Check(debate.CWD, content),
+	}
+	if err := e.storage.AddTurn(turn); err != nil {
+		return nil, fmt.Errorf("failed to save turn: %w", err)
+	}
+
+	debate.Status = core.StatusInProgress
+	if err := e.storage.UpdateDebate(debate); err != nil {
+		return nil, fmt.Errorf("failed to update debate: %w", err)
+	}
+	return turn, nil
+}
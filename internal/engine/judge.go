@@ -0,0 +1,286 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// judgeMaskedName is how the judge is referred to in prompts and history.
+const judgeMaskedName = "Judge"
+
+// buildJudge validates a judge spec and turns it into an agent.
+// A nil spec means the debate has no judge.
+func (e *Engine) buildJudge(spec *core.MemberSpec) (*core.Agent, error) {
+	return e.buildObserver(spec, judgeMaskedName)
+}
+
+// buildObserver turns the spec of a non-debating agent (judge or moderator)
+// into an agent known to the debaters as maskedName.
+func (e *Engine) buildObserver(spec *core.MemberSpec, maskedName string) (*core.Agent, error) {
+	if spec == nil || spec.Provider == "" {
+		return nil, nil
+	}
+	role := strings.ToLower(maskedName)
+
+	prov, err := e.registry.Get(spec.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("invalid provider for %s: %w", role, err)
+	}
+	if !prov.Available() {
+		return nil, fmt.Errorf("provider %s is not available (CLI not found)", spec.Provider)
+	}
+
+	name := spec.Provider
+	if spec.Persona != "" {
+		personaDef := e.getPersona(spec.Persona)
+		if personaDef == nil {
+			return nil, fmt.Errorf("invalid persona for %s: %s", role, spec.Persona)
+		}
+		name = fmt.Sprintf("%s (%s)", spec.Provider, personaDef.Name)
+	}
+
+	model := spec.Model
+	if model == "" {
+		model = core.DefaultModelForProvider[spec.Provider]
+	}
+
+	return &core.Agent{
+		ID:         core.GenerateID(),
+		Name:       fmt.Sprintf("%s: %s • %s", maskedName, name, model),
+		MaskedName: maskedName,
+		Provider:   spec.Provider,
+		Model:      model,
+		Persona:    spec.Persona,
+	}, nil
+}
+
+// judgeConclusion asks the debate's judge to score the round against the
+// rubric, declare a winner or consensus, and write the summary.
+func (e *Engine) judgeConclusion(ctx context.Context, debate *core.Debate, history string) (*core.Conclusion, error) {
+	judge := debate.Judge
+	prov, err := e.registry.Get(judge.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	turns, _ := e.storage.GetTurns(debate.ID)
+	round := 1
+	if len(turns) > 0 {
+		round = turns[len(turns)-1].Round
+	}
+
+	prompt := e.buildJudgePrompt(debate, history, round)
+
+	model := judge.Model
+	if model == "" {
+		model = prov.DefaultModel()
+	}
+
+	resp, err := prov.GenerateWithResponseDir(ctx, prompt, model, debate.CWD)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save verdict as a turn for metadata tracking
+	turn := &core.Turn{
+		ID:        core.GenerateID(),
+		DebateID:  debate.ID,
+		AgentID:   judge.ID,
+		Number:    len(turns) + 1,
+		Round:     round,
+		Content:   resp.Content,
+		CreatedAt: time.Now(),
+		TurnType:  core.TurnTypeJudge,
+		Model:     model,
+	}
+	if resp.Metadata != nil {
+		turn.InputTokens = resp.Metadata.InputTokens
+		turn.OutputTokens = resp.Metadata.OutputTokens
+		turn.TotalTokens = resp.Metadata.TotalTokens
+		turn.DurationMs = resp.Metadata.Duration.Milliseconds()
+		turn.StopReason = resp.Metadata.StopReason
+		turn.TokensEstimated = resp.Metadata.Estimated
+		turn.InputEstimated = resp.Metadata.InputEstimated
+		turn.OutputEstimated = resp.Metadata.OutputEstimated
+		turn.CostUSD = resp.Metadata.CostUSD
+	}
+	if err := e.storage.AddTurn(turn); err != nil {
+		slog.Warn("Failed to save judge turn", "error", err)
+	}
+
+	verdict, summary := parseVerdict(resp.Content, debate.Participants(), core.DefaultRubric)
+	if len(verdict.Scores) == 0 {
+		return nil, fmt.Errorf("judge response contained no scores")
+	}
+	verdict.JudgeID = judge.ID
+	if summary == "" {
+		summary = "Debate concluded."
+	}
+
+	return &core.Conclusion{
+		Agreed:  verdict.Outcome == core.VerdictConsensus,
+		Summary: summary,
+		Verdict: verdict,
+	}, nil
+}
+
+// buildJudgePrompt constructs the scoring prompt for a round.
+func (e *Engine) buildJudgePrompt(debate *core.Debate, history string, round int) string {
+	var sections []string
+	if debate.Judge.Persona != "" {
+		if personaDef := e.getPersona(debate.Judge.Persona); personaDef != nil {
+			sections = append(sections, personaDef.SystemPrompt)
+		}
+	}
+	if instructions := formatProjectInstructions(debate.ProjectInstructions); instructions != "" {
+		sections = append(sections, instructions)
+	}
+
+	var names []string
+	for _, a := range debate.Participants() {
+		names = append(names, a.MaskedName)
+	}
+
+	var rubric, scoreFields strings.Builder
+	for _, c := range core.DefaultRubric {
+		rubric.WriteString(fmt.Sprintf("- %s (%s): %s\n", c.Name, c.ID, c.Description))
+		scoreFields.WriteString(fmt.Sprintf(" | %s=<1-10>", c.ID))
+	}
+
+	sections = append(sections, fmt.Sprintf(`You are an impartial judge for a debate on: "%s"
+You did not take part in the debate. Participants: %s
+
+Here is the full debate:
+%s
+
+Score each participant's contributions in round %d against this rubric (1 = poor, 10 = excellent):
+%s
+Then decide the round: a single WINNER if one participant argued best, CONSENSUS if the participants converged on a shared position, or DRAW otherwise.
+
+Respond in this exact format:
+SCORE: <participant name>%s | FEEDBACK: <one sentence>
+(one SCORE line per participant)
+OUTCOME: [WINNER <participant name>/CONSENSUS/DRAW]
+SUMMARY: <objective 2-3 sentence summary of the key points and the outcome, in Markdown>`,
+		debate.Topic, strings.Join(names, ", "), history, round, rubric.String(), scoreFields.String()))
+
+	return strings.Join(sections, "\n\n")
+}
+
+var scoreFieldPattern = regexp.MustCompile(`^\s*([a-zA-Z_]+)\s*[=:]\s*(\d+)`)
+
+// parseVerdict extracts rubric scores, outcome, and summary from a judge's response.
+// Missing outcomes are derived from the scores: the highest unique total wins.
+func parseVerdict(content string, agents []core.Agent, rubric []core.RubricCriterion) (*core.JudgeVerdict, string) {
+	verdict := &core.JudgeVerdict{}
+	valid := make(map[string]bool, len(rubric))
+	for _, c := range rubric {
+		valid[c.ID] = true
+	}
+
+	// Longest masked names first so a name never matches inside another
+	byName := append([]core.Agent(nil), agents...)
+	sort.SliceStable(byName, func(i, j int) bool { return len(byName[i].MaskedName) > len(byName[j].MaskedName) })
+	findAgent := func(text string) *core.Agent {
+		lower := strings.ToLower(text)
+		for i := range byName {
+			if strings.Contains(lower, strings.ToLower(byName[i].MaskedName)) {
+				return &byName[i]
+			}
+		}
+		return nil
+	}
+
+	var summary []string
+	inSummary := false
+	outcome := ""
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		upper := strings.ToUpper(trimmed)
+		switch {
+		case strings.HasPrefix(upper, "SCORE:"):
+			inSummary = false
+			fields := strings.Split(strings.TrimSpace(trimmed[len("SCORE:"):]), "|")
+			agent := findAgent(fields[0])
+			if agent == nil || verdict.ScoreFor(agent.ID) != nil {
+				continue
+			}
+			score := &core.JudgeScore{AgentID: agent.ID, Scores: make(map[string]int)}
+			for _, field := range fields[1:] {
+				field = strings.TrimSpace(field)
+				if strings.HasPrefix(strings.ToUpper(field), "FEEDBACK:") {
+					score.Feedback = strings.TrimSpace(field[len("FEEDBACK:"):])
+					continue
+				}
+				m := scoreFieldPattern.FindStringSubmatch(field)
+				if m == nil || !valid[strings.ToLower(m[1])] {
+					continue
+				}
+				n, _ := strconv.Atoi(m[2])
+				n = min(max(n, 1), 10)
+				score.Scores[strings.ToLower(m[1])] = n
+				score.Total += n
+			}
+			if len(score.Scores) > 0 {
+				verdict.Scores = append(verdict.Scores, score)
+			}
+		case strings.HasPrefix(upper, "OUTCOME:"):
+			inSummary = false
+			outcome = strings.TrimSpace(trimmed[len("OUTCOME:"):])
+		case strings.HasPrefix(upper, "SUMMARY:"):
+			inSummary = true
+			if rest := strings.TrimSpace(trimmed[len("SUMMARY:"):]); rest != "" {
+				summary = append(summary, rest)
+			}
+		case inSummary:
+			summary = append(summary, line)
+		}
+	}
+
+	upperOutcome := strings.ToUpper(outcome)
+	switch {
+	case strings.HasPrefix(upperOutcome, "CONSENSUS"):
+		verdict.Outcome = core.VerdictConsensus
+	case strings.HasPrefix(upperOutcome, "DRAW"):
+		verdict.Outcome = core.VerdictDraw
+	case strings.HasPrefix(upperOutcome, "WINNER"):
+		if winner := findAgent(outcome); winner != nil {
+			verdict.Outcome = core.VerdictWinner
+			verdict.WinnerID = winner.ID
+		}
+	}
+	if verdict.Outcome == "" {
+		verdict.Outcome = core.VerdictDraw
+		if winner := topScorer(verdict.Scores); winner != "" {
+			verdict.Outcome = core.VerdictWinner
+			verdict.WinnerID = winner
+		}
+	}
+
+	return verdict, strings.TrimSpace(strings.Join(summary, "\n"))
+}
+
+// topScorer returns the agent with the highest total, or "" on a tie.
+func topScorer(scores []*core.JudgeScore) string {
+	best, winner, tied := -1, "", false
+	for _, s := range scores {
+		switch {
+		case s.Total > best:
+			best, winner, tied = s.Total, s.AgentID, false
+		case s.Total == best:
+			tied = true
+		}
+	}
+	if tied {
+		return ""
+	}
+	return winner
+}
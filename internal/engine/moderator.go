@@ -0,0 +1,142 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// moderatorMaskedName is how the moderator is referred to in prompts and history.
+const moderatorMaskedName = "Moderator"
+
+// buildModerator validates a moderator spec and turns it into an agent.
+// A nil spec means the debate has no moderator.
+func (e *Engine) buildModerator(spec *core.MemberSpec) (*core.Agent, error) {
+	return e.buildObserver(spec, moderatorMaskedName)
+}
+
+// moderate asks the debate's moderator to take stock after a rotation and
+// saves its response as a moderator turn. The question it poses is handed
+// to the next rotation's prompts (see latestModeratorQuestion).
+func (e *Engine) moderate(ctx context.Context, debate *core.Debate, turns []*core.Turn, round int) (*core.Turn, error) {
+	moderator := debate.Moderator
+	prov, err := e.registry.Get(moderator.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	model := moderator.Model
+	if model == "" {
+		model = prov.DefaultModel()
+	}
+
+	resp, err := prov.GenerateWithResponseDir(ctx, e.buildModeratorPrompt(debate, turns, round), model, debate.CWD)
+	if err != nil {
+		return nil, err
+	}
+
+	turn := &core.Turn{
+		ID:        core.GenerateID(),
+		DebateID:  debate.ID,
+		AgentID:   moderator.ID,
+		Number:    len(turns) + 1,
+		Round:     round,
+		Content:   resp.Content,
+		CreatedAt: time.Now(),
+		TurnType:  core.TurnTypeModerator,
+		Model:     model,
+		Status:    "completed",
+	}
+	if resp.Metadata != nil {
+		turn.InputTokens = resp.Metadata.InputTokens
+		turn.OutputTokens = resp.Metadata.OutputTokens
+		turn.TotalTokens = resp.Metadata.TotalTokens
+		turn.DurationMs = resp.Metadata.Duration.Milliseconds()
+		turn.StopReason = resp.Metadata.StopReason
+		turn.TokensEstimated = resp.Metadata.Estimated
+		turn.InputEstimated = resp.Metadata.InputEstimated
+		turn.OutputEstimated = resp.Metadata.OutputEstimated
+		turn.CostUSD = resp.Metadata.CostUSD
+	}
+	if err := e.storage.AddTurn(turn); err != nil {
+		return nil, fmt.Errorf("failed to save moderator turn: %w", err)
+	}
+	return turn, nil
+}
+
+// buildModeratorPrompt constructs the prompt for a moderator turn.
+func (e *Engine) buildModeratorPrompt(debate *core.Debate, turns []*core.Turn, round int) string {
+	var sections []string
+	if debate.Moderator.Persona != "" {
+		if personaDef := e.getPersona(debate.Moderator.Persona); personaDef != nil {
+			sections = append(sections, personaDef.SystemPrompt)
+		}
+	}
+	if instructions := formatProjectInstructions(debate.ProjectInstructions); instructions != "" {
+		sections = append(sections, instructions)
+	}
+
+	var names, positions []string
+	for _, a := range debate.Participants() {
+		names = append(names, a.MaskedName)
+		if a.Stance != "" {
+			positions = append(positions, fmt.Sprintf("- %s: %s", a.MaskedName, core.DescribeStance(a.Stance)))
+		}
+	}
+	positionBlock := ""
+	if len(positions) > 0 {
+		positionBlock = "\nAssigned positions:\n" + strings.Join(positions, "\n") + "\n"
+	}
+	previousBlock := ""
+	if question := latestModeratorQuestion(turns, round); question != "" {
+		previousBlock = fmt.Sprintf("\nYour previous question was: %s\nDo not ask it again.\n", question)
+	}
+
+	sections = append(sections, fmt.Sprintf(`You are the moderator of a debate on: "%s"
+You do not take sides. Participants: %s
+%s
+Here is the debate so far:
+%s
+%s
+Take stock before the participants speak again:
+1. Summarize where each participant stands and where they actually disagree.
+2. Flag claims made without evidence or reasoning to back them, naming who made them.
+3. Pose ONE sharpened question that gets to the heart of the remaining disagreement. The participants will answer it next.
+
+Respond in this exact format:
+SUMMARY: <2-3 sentences, in Markdown>
+UNSUPPORTED: <the unsupported claims and who made them, or "none">
+QUESTION: <one question>`,
+		debate.Topic, strings.Join(names, ", "), positionBlock, e.buildDebateHistory(debate, turns), previousBlock))
+
+	return strings.Join(sections, "\n\n")
+}
+
+var moderatorQuestionPattern = regexp.MustCompile(`(?im)^[\s*_#]*QUESTION[*_]*\s*:[*_]*\s*(.+)$`)
+
+// parseModeratorQuestion extracts the QUESTION line from a moderator's
+// response. It returns "" when the moderator did not pose one.
+func parseModeratorQuestion(content string) string {
+	match := moderatorQuestionPattern.FindStringSubmatch(content)
+	if match == nil {
+		return ""
+	}
+	return strings.TrimSpace(strings.Trim(strings.TrimSpace(match[1]), "*_"))
+}
+
+// latestModeratorQuestion returns the question from the last moderator turn
+// of the round, or "" if the moderator has not spoken yet.
+func latestModeratorQuestion(turns []*core.Turn, round int) string {
+	for i := len(turns) - 1; i >= 0; i-- {
+		t := turns[i]
+		if t.Round != round || t.TurnType != core.TurnTypeModerator || t.Status == "failed" {
+			continue
+		}
+		return parseModeratorQuestion(t.Content)
+	}
+	return ""
+}
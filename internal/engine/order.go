@@ -0,0 +1,162 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"strings"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// roundOrder returns the participants in the order they speak for a round.
+// Random order (the default) shuffles with the given seed so a round is
+// reproducible when a debate is resumed.
+func roundOrder(debate *core.Debate, seed int64) []core.Agent {
+	agents := append([]core.Agent(nil), debate.Participants()...)
+	if debate.SpeakingOrder == core.SpeakingOrderRoundRobin {
+		return agents
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	r.Shuffle(len(agents), func(i, j int) { agents[i], agents[j] = agents[j], agents[i] })
+	return agents
+}
+
+// roundSpeakers returns the speaking order of a debate round, seeded with
+// the debate ID and round.
+func roundSpeakers(debate *core.Debate, round int) []core.Agent {
+	return roundOrder(debate, int64(hashString(debate.ID)+uint32(round)))
+}
+
+// eligibleSpeakers returns agents that have turns left in the round, in
+// declaration order. The previous speaker is skipped when anyone else can speak.
+func eligibleSpeakers(debate *core.Debate, turns []*core.Turn, round int) []core.Agent {
+	spoken := make(map[string]int)
+	lastSpeaker := ""
+	for _, t := range turns {
+		if t.Round != round {
+			continue
+		}
+		switch t.TurnType {
+		case core.TurnTypeVote, core.TurnTypeConclusion, core.TurnTypeJudge, core.TurnTypeModerator:
+			continue
+		}
+		spoken[t.AgentID]++
+		lastSpeaker = t.AgentID
+	}
+
+	var eligible []core.Agent
+	for _, a := range debate.Participants() {
+		if spoken[a.ID] < debate.MaxTurns {
+			eligible = append(eligible, a)
+		}
+	}
+	if len(eligible) > 1 {
+		for i, a := range eligible {
+			if a.ID == lastSpeaker {
+				eligible = append(eligible[:i], eligible[i+1:]...)
+				break
+			}
+		}
+	}
+	if len(eligible) == 0 {
+		return debate.Participants()
+	}
+	return eligible
+}
+
+// moderatorPick asks a moderator (the first model participant's provider) which
+// agent should speak next. If the moderator fails or names nobody eligible,
+// the first eligible agent speaks.
+func (e *Engine) moderatorPick(ctx context.Context, debate *core.Debate, turns []*core.Turn, round int) core.Agent {
+	eligible := eligibleSpeakers(debate, turns, round)
+	if len(eligible) == 1 {
+		return eligible[0]
+	}
+
+	// The debate's moderator picks when it has one, otherwise the first model participant
+	moderator := debate.ModelParticipants()[0]
+	if debate.Moderator != nil {
+		moderator = *debate.Moderator
+	}
+	prov, err := e.registry.Get(moderator.Provider)
+	if err != nil {
+		return eligible[0]
+	}
+
+	names := make([]string, len(eligible))
+	for i, a := range eligible {
+		names[i] = a.MaskedName
+	}
+
+	instructionBlock := ""
+	if instructions := formatProjectInstructions(debate.ProjectInstructions); instructions != "" {
+		instructionBlock = "\n\n" + instructions
+	}
+
+	prompt := fmt.Sprintf(`You are moderating a debate on: "%s"%s
+
+Discussion so far:
+%s
+
+Choose who should speak next to move the discussion forward. Prefer a participant whose view has not been addressed yet.
+
+Candidates: %s
+
+Respond with ONLY the candidate's name.`, debate.Topic, instructionBlock, e.buildDebateHistory(debate, turns), strings.Join(names, ", "))
+
+	model := moderator.Model
+	if model == "" {
+		model = prov.DefaultModel()
+	}
+
+	response, err := prov.GenerateWithDir(ctx, prompt, model, debate.CWD)
+	if err != nil {
+		slog.Warn("Moderator failed to pick next speaker", "debate_id", debate.ID, "error", err)
+		return eligible[0]
+	}
+
+	responseLower := strings.ToLower(response)
+	for _, a := range eligible {
+		if strings.Contains(responseLower, strings.ToLower(a.MaskedName)) {
+			return a
+		}
+	}
+
+	slog.Debug("Moderator response named no candidate", "debate_id", debate.ID, "response", response)
+	return eligible[0]
+}
+
+// roundTargeting returns the targeting of the user follow-up that opened
+// round, or nil if the round is open to every agent.
+func roundTargeting(turns []*core.Turn, round int) *core.Targeting {
+	for _, t := range turns {
+		if t.Round == round && t.AgentID == "user" {
+			return t.Targeting
+		}
+	}
+	return nil
+}
+
+// targetedSpeakers keeps the agents a targeted follow-up addresses, in
+// their speaking order.
+func targetedSpeakers(agents []core.Agent, targeting *core.Targeting) []core.Agent {
+	var targeted []core.Agent
+	for _, a := range agents {
+		if targeting.Addresses(a.ID) {
+			targeted = append(targeted, a)
+		}
+	}
+	return targeted
+}
+
+// roundTurns returns how many debate turns round has: one per addressed
+// agent after a targeted follow-up, otherwise the debate's full count.
+func roundTurns(debate *core.Debate, turns []*core.Turn, round int) int {
+	if targeting := roundTargeting(turns, round); targeting != nil {
+		return len(targetedSpeakers(debate.Participants(), targeting))
+	}
+	return debate.TotalTurns()
+}
@@ -0,0 +1,147 @@
+package engine
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+	"github.com/alienxp03/conclave/internal/run"
+)
+
+// debateProgress returns the current round and how many of its debate turns
+// have been taken. Vote and conclusion turns from an interrupted conclusion
+// are not counted, so the conclusion step runs again on resume and replaces
+// them.
+func debateProgress(turns []*core.Turn) (round, taken int) {
+	round = 1
+	if len(turns) > 0 {
+		round = turns[len(turns)-1].Round
+	}
+
+	for _, t := range turns {
+		if t.Round != round || t.AgentID == "user" {
+			continue
+		}
+		switch t.TurnType {
+		case core.TurnTypeVote, core.TurnTypeConclusion, core.TurnTypeJudge, core.TurnTypeModerator:
+			continue
+		}
+		taken++
+	}
+	return round, taken
+}
+
+// discardConclusionTurns deletes the vote, judge and summary turns of the
+// round's conclusion, such as those a paused or crashed conclusion left
+// behind, and returns the remaining turns.
+func (e *Engine) discardConclusionTurns(turns []*core.Turn, round int) ([]*core.Turn, error) {
+	kept := turns[:0:0]
+	for _, t := range turns {
+		if t.Round == round {
+			switch t.TurnType {
+			case core.TurnTypeVote, core.TurnTypeConclusion, core.TurnTypeJudge:
+				if err := e.storage.DeleteTurn(t.ID); err != nil {
+					return nil, err
+				}
+				continue
+			}
+		}
+		kept = append(kept, t)
+	}
+	return kept, nil
+}
+
+// turnResults counts the debate's completed and failed turns. The counters
+// are not stored, so a resumed run rebuilds them from its turns.
+func turnResults(turns []*core.Turn) (completed, failed int) {
+	for _, t := range turns {
+		if t.AgentID == "user" {
+			continue
+		}
+		switch t.TurnType {
+		case core.TurnTypeVote, core.TurnTypeConclusion, core.TurnTypeJudge, core.TurnTypeModerator:
+			continue
+		}
+		if t.Status == "failed" {
+			failed++
+		} else {
+			completed++
+		}
+	}
+	return completed, failed
+}
+
+// NextStep describes what a debate does next when it runs, e.g. "turn 3 of 6
+// in round 1" or "conclusion for round 2".
+func NextStep(debate *core.Debate, turns []*core.Turn) string {
+	round, taken := debateProgress(turns)
+	if total := roundTurns(debate, turns, round); taken < total {
+		return fmt.Sprintf("turn %d of %d in round %d", taken+1, total, round)
+	}
+	return fmt.Sprintf("conclusion for round %d", round)
+}
+
+// RecoverDebates handles debates a previous process left in progress when it
+// died mid-run. Debates whose heartbeat is still fresh are running in another
+// process, such as the CLI, and are left alone. Depending on policy each
+// other debate is resumed in the background from its next step or marked
+// interrupted. It returns the number of debates recovered.
+func (e *Engine) RecoverDebates(policy core.RecoveryPolicy) (int, error) {
+	ids, err := e.storage.ListDebateIDsByStatus(core.StatusInProgress)
+	if err != nil {
+		return 0, err
+	}
+
+	recovered := 0
+	for _, id := range ids {
+		if e.runs.Running(id) || e.runningElsewhere(id) {
+			continue
+		}
+		debate, err := e.storage.GetDebate(id)
+		if err != nil || debate == nil {
+			slog.Warn("Failed to load debate for recovery", "debate_id", id, "error", err)
+			continue
+		}
+		turns, _ := e.storage.GetTurns(id)
+		next := NextStep(debate, turns)
+
+		// A pause or cancel aimed at the dead run no longer applies
+		if err := e.storage.ClearStopRequest(id); err != nil {
+			slog.Warn("Failed to clear stop request", "debate_id", id, "error", err)
+		}
+
+		if policy == core.RecoveryInterrupt {
+			debate.Status = core.StatusInterrupted
+			if err := e.storage.UpdateDebate(debate); err != nil {
+				slog.Error("Failed to mark debate interrupted", "debate_id", id, "error", err)
+				continue
+			}
+			slog.Info("Marked debate interrupted", "debate_id", id, "next", next)
+		} else {
+			if err := e.StartDebate(id, nil); err != nil {
+				slog.Error("Failed to resume debate", "debate_id", id, "error", err)
+				continue
+			}
+			slog.Info("Resuming debate", "debate_id", id, "next", next)
+		}
+		recovered++
+	}
+	return recovered, nil
+}
+
+// runningElsewhere reports whether another live process is running the
+// debate, judged by its heartbeat. When unsure it assumes so, since a
+// second run would duplicate turns.
+func (e *Engine) runningElsewhere(id string) bool {
+	owner, beatAt, err := e.storage.GetHeartbeat(id)
+	if err != nil {
+		slog.Warn("Failed to check debate heartbeat", "debate_id", id, "error", err)
+		return true
+	}
+	if run.Live(beatAt, time.Now()) {
+		slog.Info("Debate is running in another process", "debate_id", id, "owner", owner)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+	"github.com/alienxp03/conclave/internal/run"
+)
+
+// RegenerateTurn re-executes an agent's turn with the prompt context it
+// originally had, the turns before it, and selects the new version. Earlier
+// versions are kept as alternates. Steps that already ran on the old
+// content, such as the round's conclusion, are redone by RerunConclusion.
+func (e *Engine) RegenerateTurn(ctx context.Context, debateID, turnID string) (*core.Turn, error) {
+	debate, err := e.editableDebate(debateID)
+	if err != nil {
+		return nil, err
+	}
+	e.ensureMaskedNames(debate)
+
+	var regenerated *core.Turn
+	err = e.runs.Run(ctx, debateID, func(ctx context.Context) error {
+		turns, err := e.storage.GetTurns(debateID)
+		if err != nil {
+			return err
+		}
+		idx := turnIndex(turns, turnID)
+		if idx < 0 {
+			return fmt.Errorf("turn not found: %s", turnID)
+		}
+		turn := turns[idx]
+
+		switch turn.TurnType {
+		case core.TurnTypeVote, core.TurnTypeConclusion, core.TurnTypeJudge, core.TurnTypeModerator:
+			return fmt.Errorf("only debate turns can be regenerated, turn %d is a %s turn", turn.Number, turn.TurnType)
+		}
+		agent, ok := debate.AgentByID(turn.AgentID)
+		if !ok || agent.IsHuman() {
+			return fmt.Errorf("turn %d was not written by a model", turn.Number)
+		}
+
+		// The turn closed its round if it used up the round's turns
+		_, taken := debateProgress(turns[:idx+1])
+		isLastTurn := taken == roundTurns(debate, turns, turn.Round)
+
+		generated, err := e.generateTurn(ctx, debate, agent, turns[:idx], turn.Number, isLastTurn)
+		if err != nil {
+			return err
+		}
+		version := generated.CurrentVersion()
+		version.CreatedAt = time.Now()
+		turn.PushVersion(version)
+		if err := e.storage.UpdateTurn(turn); err != nil {
+			return err
+		}
+		if err := e.storage.DeleteDebateClaims(debateID, turn.Number); err != nil {
+			return err
+		}
+		regenerated = turn
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return regenerated, nil
+}
+
+// SelectTurnVersion picks which version of a turn later steps use.
+func (e *Engine) SelectTurnVersion(debateID, turnID string, version int) (*core.Turn, error) {
+	if _, err := e.editableDebate(debateID); err != nil {
+		return nil, err
+	}
+
+	turns, err := e.storage.GetTurns(debateID)
+	if err != nil {
+		return nil, err
+	}
+	idx := turnIndex(turns, turnID)
+	if idx < 0 {
+		return nil, fmt.Errorf("turn not found: %s", turnID)
+	}
+	turn := turns[idx]
+
+	if err := turn.SelectVersion(version); err != nil {
+		return nil, err
+	}
+	if err := e.storage.UpdateTurn(turn); err != nil {
+		return nil, err
+	}
+	// Claims from this turn on may quote or rebut the old version
+	if err := e.storage.DeleteDebateClaims(debateID, turn.Number); err != nil {
+		return nil, err
+	}
+	return turn, nil
+}
+
+// RerunConclusion discards the latest round's conclusion and runs the debate
+// again in the background, so the conclusion is regenerated from the
+// selected turn versions. A round that was cut short continues its turns.
+func (e *Engine) RerunConclusion(debateID string, callback TurnCallback) error {
+	debate, err := e.editableDebate(debateID)
+	if err != nil {
+		return err
+	}
+
+	turns, err := e.storage.GetTurns(debateID)
+	if err != nil {
+		return err
+	}
+	round, _ := debateProgress(turns)
+	if _, err := e.discardConclusionTurns(turns, round); err != nil {
+		return err
+	}
+
+	var kept []*core.Conclusion
+	for _, c := range debate.Conclusions {
+		if c.Round != round {
+			kept = append(kept, c)
+		}
+	}
+	debate.Conclusions = kept
+	debate.CompletedAt = nil
+	debate.Status = core.StatusPaused
+	if err := e.storage.UpdateDebate(debate); err != nil {
+		return fmt.Errorf("failed to update debate: %w", err)
+	}
+
+	return e.StartDebate(debateID, callback)
+}
+
+// editableDebate loads a debate whose turns are about to be regenerated or
+// reselected. It must not be running here or in another process.
+func (e *Engine) editableDebate(id string) (*core.Debate, error) {
+	debate, err := e.controlledDebate(id)
+	if err != nil {
+		return nil, err
+	}
+	if e.runs.Running(id) || debate.Status == core.StatusInProgress {
+		return nil, run.ErrAlreadyRunning
+	}
+	return debate, nil
+}
+
+// turnIndex returns the position of a turn in turns, or -1.
+func turnIndex(turns []*core.Turn, id string) int {
+	for i, t := range turns {
+		if t.ID == id {
+			return i
+		}
+	}
+	return -1
+}
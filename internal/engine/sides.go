@@ -0,0 +1,166 @@
+package engine
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// SwapSides creates a rerun of a debate with the agents' stances rotated one
+// seat (swapped for two agents). Providers, models and personas stay in
+// their seats, so comparing the two outcomes with CompareSides shows whether
+// the result follows the side or the agent arguing it. The rerun is pending
+// and starts like a new debate.
+func (e *Engine) SwapSides(id string) (*core.Debate, error) {
+	original, err := e.storage.GetDebate(id)
+	if err != nil {
+		return nil, err
+	}
+	if original == nil {
+		return nil, fmt.Errorf("debate not found: %s", id)
+	}
+	e.ensureMaskedNames(original)
+
+	seats := original.Participants()
+	distinct := map[string]bool{}
+	for _, a := range seats {
+		distinct[a.Stance] = true
+	}
+	if len(distinct) < 2 {
+		return nil, fmt.Errorf("debate has no opposing stances to swap")
+	}
+
+	agents := make([]core.Agent, len(seats))
+	for i, a := range seats {
+		a.ID = core.GenerateID()
+		a.Stance = seats[(i+len(seats)-1)%len(seats)].Stance
+		agents[i] = a
+	}
+
+	var judge, moderator *core.Agent
+	if original.Judge != nil {
+		j := *original.Judge
+		j.ID = core.GenerateID()
+		judge = &j
+	}
+	if original.Moderator != nil {
+		m := *original.Moderator
+		m.ID = core.GenerateID()
+		moderator = &m
+	}
+
+	now := time.Now()
+	swapped := &core.Debate{
+		ID:                  core.GenerateID(),
+		Title:               original.Title + " (sides swapped)",
+		Topic:               original.Topic,
+		CWD:                 original.CWD,
+		WorkspaceID:         original.WorkspaceID,
+		ProjectID:           original.ProjectID,
+		ProjectInstructions: original.ProjectInstructions,
+		SpeakingOrder:       original.SpeakingOrder,
+		Judge:               judge,
+		Moderator:           moderator,
+		Consensus:           original.Consensus,
+		Vote:                original.Vote,
+		Stalemate:           original.Stalemate,
+		Steelman:            original.Steelman,
+		SwapOf:              original.ID,
+		Style:               original.Style,
+		MaxTurns:            original.MaxTurns,
+		Status:              core.StatusPending,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+	swapped.SetParticipants(agents)
+
+	if err := e.storage.CreateDebate(swapped); err != nil {
+		return nil, fmt.Errorf("failed to create swapped debate: %w", err)
+	}
+	return swapped, nil
+}
+
+// CompareSides compares a debate with its swap-sides rerun. id may be either
+// the original debate or the rerun. Only a judge picks a winner, so debates
+// without one compare as inconclusive unless both runs agreed.
+func (e *Engine) CompareSides(id string) (*core.SideComparison, error) {
+	debate, err := e.storage.GetDebate(id)
+	if err != nil {
+		return nil, err
+	}
+	if debate == nil {
+		return nil, fmt.Errorf("debate not found: %s", id)
+	}
+
+	var original, swapped *core.Debate
+	if debate.SwapOf != "" {
+		swapped = debate
+		original, err = e.storage.GetDebate(debate.SwapOf)
+		if err != nil {
+			return nil, err
+		}
+		if original == nil {
+			return nil, fmt.Errorf("original debate not found: %s", debate.SwapOf)
+		}
+	} else {
+		original = debate
+		swapped, err = e.storage.GetSwappedDebate(id)
+		if err != nil {
+			return nil, err
+		}
+		if swapped == nil {
+			return nil, fmt.Errorf("debate has no swap-sides rerun")
+		}
+	}
+
+	cmp := &core.SideComparison{
+		Original:  sideResult(original),
+		Swapped:   sideResult(swapped),
+		DependsOn: core.DependsOnInconclusive,
+	}
+	a, b := cmp.Original, cmp.Swapped
+	switch {
+	case a.Status != core.StatusCompleted || b.Status != core.StatusCompleted:
+		// Unfinished runs can't be compared yet
+	case a.Outcome == core.VerdictWinner && b.Outcome == core.VerdictWinner:
+		if a.WinnerStance == b.WinnerStance {
+			cmp.DependsOn = core.DependsOnSide
+		} else if a.WinnerSeat == b.WinnerSeat {
+			cmp.DependsOn = core.DependsOnProvider
+		}
+	case a.Outcome != "" && a.Outcome == b.Outcome:
+		cmp.DependsOn = core.DependsOnNeither
+	case a.Outcome == "" && b.Outcome == "" && a.Agreed && b.Agreed:
+		cmp.DependsOn = core.DependsOnNeither
+	}
+	return cmp, nil
+}
+
+// sideResult summarizes the final conclusion of one run of a comparison.
+func sideResult(debate *core.Debate) *core.SideResult {
+	result := &core.SideResult{
+		DebateID:   debate.ID,
+		Status:     debate.Status,
+		WinnerSeat: -1,
+	}
+	if len(debate.Conclusions) == 0 {
+		return result
+	}
+	conclusion := debate.Conclusions[len(debate.Conclusions)-1]
+	result.Agreed = conclusion.Agreed
+
+	verdict := conclusion.Verdict
+	if verdict == nil {
+		return result
+	}
+	result.Outcome = verdict.Outcome
+	for i, a := range debate.Participants() {
+		if verdict.Outcome == core.VerdictWinner && a.ID == verdict.WinnerID {
+			result.Winner = a.Name
+			result.WinnerStance = a.Stance
+			result.WinnerSeat = i
+		}
+	}
+	return result
+}
@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/alienxp03/conclave/internal/core"
+	"github.com/alienxp03/conclave/internal/stalemate"
+)
+
+// measureNovelty scores content against the agent's earlier debate turns.
+func measureNovelty(agentID, content string, turns []*core.Turn) *core.Novelty {
+	var earlier []string
+	for _, t := range turns {
+		if t.AgentID == agentID && isDebateTurn(t) {
+			earlier = append(earlier, t.Content)
+		}
+	}
+	novelty := stalemate.Measure(content, earlier)
+	return &novelty
+}
+
+// isDebateTurn reports whether a turn is a successful debate turn, as
+// opposed to a vote, conclusion, judge or moderator turn.
+func isDebateTurn(t *core.Turn) bool {
+	return (t.TurnType == "" || t.TurnType == core.TurnTypeDebate) && t.Status != "failed"
+}
+
+// checkStalemate reports whether the last two rotations of the round added
+// nothing new: every turn in them scored below the novelty threshold and,
+// if the debate asks for it, a model confirmed it. It returns nil while
+// the debate is still making progress or detection is disabled.
+func (e *Engine) checkStalemate(ctx context.Context, debate *core.Debate, round int) *core.StalemateResult {
+	cfg := core.StalemateConfig{}
+	if debate.Stalemate != nil {
+		cfg = *debate.Stalemate
+	}
+	if cfg.Disabled {
+		return nil
+	}
+	threshold := cfg.Threshold
+	if threshold == 0 {
+		threshold = stalemate.DefaultThreshold
+	}
+
+	turns, err := e.storage.GetTurns(debate.ID)
+	if err != nil {
+		return nil
+	}
+	var roundTurns []*core.Turn
+	for _, t := range turns {
+		if t.Round != round || !isDebateTurn(t) {
+			continue
+		}
+		if _, ok := debate.AgentByID(t.AgentID); ok {
+			roundTurns = append(roundTurns, t)
+		}
+	}
+
+	n := len(debate.Participants())
+	if len(roundTurns) < 2*n {
+		return nil
+	}
+	previous := stalemate.RotationNovelty(roundTurns[len(roundTurns)-2*n : len(roundTurns)-n])
+	latest := stalemate.RotationNovelty(roundTurns[len(roundTurns)-n:])
+	if previous >= threshold || latest >= threshold {
+		return nil
+	}
+
+	result := &core.StalemateResult{
+		Novelty:   []float64{previous, latest},
+		Threshold: threshold,
+		Rationale: fmt.Sprintf("Two consecutive rotations added little new: novelty %.2f and %.2f, below %.2f.", previous, latest, threshold),
+	}
+	if cfg.Confirm {
+		stale, rationale, err := stalemate.Confirm(ctx, stalemate.GenerateFunc(e.consensusGenerator(debate)), stalemate.Input{
+			Topic:        debate.Topic,
+			History:      e.buildDebateHistory(debate, turns),
+			Instructions: formatProjectInstructions(debate.ProjectInstructions),
+		})
+		if err != nil {
+			slog.Warn("Stalemate confirmation failed", "debate_id", debate.ID, "error", err)
+			return nil
+		}
+		if !stale {
+			slog.Info("Stalemate not confirmed", "debate_id", debate.ID, "rationale", rationale)
+			return nil
+		}
+		result.Confirmed, result.Rationale = true, rationale
+	}
+
+	slog.Info("Debate reached a stalemate", "debate_id", debate.ID, "novelty", result.Novelty)
+	return result
+}
@@ -0,0 +1,255 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/alienxp03/conclave/internal/consensus"
+	"github.com/alienxp03/conclave/internal/core"
+	"github.com/alienxp03/conclave/internal/provider"
+	baseprovider "github.com/alienxp03/conclave/provider"
+)
+
+// Steelman defaults: a restatement needs 7/10 and a low score is retried once.
+const (
+	defaultSteelmanMinScore = 7
+	defaultSteelmanRetries  = 1
+	maxSteelmanRetries      = 5
+)
+
+// steelmanHeading finds the heading of a turn's steelman section.
+var steelmanHeading = regexp.MustCompile(`(?im)^#{1,6}\s*steelman\b.*$`)
+
+// steelmanLimits resolves a debate's steelman settings to their defaults.
+func steelmanLimits(cfg *core.SteelmanConfig) (minScore, maxRetries int) {
+	minScore, maxRetries = cfg.MinScore, cfg.MaxRetries
+	if minScore == 0 {
+		minScore = defaultSteelmanMinScore
+	}
+	if maxRetries == 0 {
+		maxRetries = defaultSteelmanRetries
+	}
+	return minScore, maxRetries
+}
+
+// validateSteelman checks a steelman config before a debate is created.
+func validateSteelman(cfg *core.SteelmanConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	if cfg.MinScore < 0 || cfg.MinScore > 10 {
+		return fmt.Errorf("steelman minimum score must be between 1 and 10")
+	}
+	if cfg.MaxRetries < 0 || cfg.MaxRetries > maxSteelmanRetries {
+		return fmt.Errorf("steelman retries must be between 0 and %d", maxSteelmanRetries)
+	}
+	return nil
+}
+
+// steelmanTarget returns the latest debate turn by another participant,
+// the argument agent must restate, or nil if no one else has spoken.
+func steelmanTarget(debate *core.Debate, agent core.Agent, turns []*core.Turn) *core.Turn {
+	for i := len(turns) - 1; i >= 0; i-- {
+		t := turns[i]
+		if t.AgentID == agent.ID || !isDebateTurn(t) {
+			continue
+		}
+		if _, ok := debate.AgentByID(t.AgentID); ok {
+			return t
+		}
+	}
+	return nil
+}
+
+// steelmanInstructions asks an agent to restate the target's argument in a
+// section of its own before responding to it.
+func steelmanInstructions(targetName string, targetTurn int) string {
+	return fmt.Sprintf(`Steelman first: before you rebut anything, restate %s's strongest argument from turn %d as fairly and persuasively as %s would, under a "## Steelman" heading. Then give your own response under a "## Response" heading. Your restatement will be rated for fidelity.`,
+		targetName, targetTurn, targetName)
+}
+
+// extractRestatement returns the text of a turn's steelman section: from
+// its heading to the next heading. It returns "" if the turn has none.
+func extractRestatement(content string) string {
+	loc := steelmanHeading.FindStringIndex(content)
+	if loc == nil {
+		return ""
+	}
+	rest := content[loc[1]:]
+	var lines []string
+	for _, line := range strings.Split(rest, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			break
+		}
+		lines = append(lines, line)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// generateSteelmanTurn generates a turn that restates target before
+// rebutting it. Each attempt's restatement is rated; a score below the
+// debate's minimum is retried with the rater's feedback until the retries
+// run out. The reply returned carries the usage of every attempt, and the
+// score of the last one. A failed rating leaves the turn unrated.
+func (e *Engine) generateSteelmanTurn(ctx context.Context, debate *core.Debate, prov provider.Provider, model, prompt string, target *core.Turn) (*baseprovider.Response, *core.SteelmanScore, error) {
+	minScore, maxRetries := steelmanLimits(debate.Steelman)
+	names := maskedNamesByID(debate)
+	targetName := names[target.AgentID]
+	prompt += "\n\n" + steelmanInstructions(targetName, target.Number)
+
+	var resp *baseprovider.Response
+	var usage baseprovider.Metadata
+	var score *core.SteelmanScore
+	ask := prompt
+	for attempt := 1; attempt <= maxRetries+1; attempt++ {
+		next, err := prov.GenerateWithResponseDir(ctx, ask, model, debate.CWD)
+		if err != nil {
+			if resp == nil || ctx.Err() != nil {
+				return nil, nil, err
+			}
+			// Keep the earlier attempt rather than lose the turn
+			slog.Warn("Steelman retry failed, keeping the previous attempt", "debate_id", debate.ID, "error", err)
+			break
+		}
+		resp = next
+		addUsage(&usage, resp.Metadata)
+
+		score, err = e.rateSteelman(ctx, debate, target, resp.Content)
+		if err != nil {
+			slog.Warn("Steelman rating failed, leaving the turn unrated", "debate_id", debate.ID, "error", err)
+			break
+		}
+		score.Attempts = attempt
+		score.Passed = score.Score >= minScore
+		if score.Passed {
+			break
+		}
+
+		ask = fmt.Sprintf(`%s
+
+Your previous reply's steelman of %s scored %d/10 for fidelity (%d needed): %s
+Write the turn again, restating %s's argument more faithfully and in its strongest form before you respond to it.`,
+			prompt, targetName, score.Score, minScore, score.Rationale, targetName)
+	}
+
+	if resp.Metadata != nil || usage != (baseprovider.Metadata{}) {
+		if resp.Metadata != nil {
+			usage.StopReason = resp.Metadata.StopReason
+		}
+		resp.Metadata = &usage
+	}
+	return resp, score, nil
+}
+
+// addUsage adds one reply's tokens, time and cost to a running total.
+func addUsage(total *baseprovider.Metadata, m *baseprovider.Metadata) {
+	if m == nil {
+		return
+	}
+	total.InputTokens += m.InputTokens
+	total.OutputTokens += m.OutputTokens
+	total.TotalTokens += m.TotalTokens
+	total.Duration += m.Duration
+	total.CostUSD += m.CostUSD
+	total.Estimated = total.Estimated || m.Estimated
+	total.InputEstimated = total.InputEstimated || m.InputEstimated
+	total.OutputEstimated = total.OutputEstimated || m.OutputEstimated
+}
+
+// rateSteelman asks the debate's judge, or else the agent whose argument
+// was restated, how faithful a turn's restatement of target is. A turn
+// without a steelman section scores 0 without asking.
+func (e *Engine) rateSteelman(ctx context.Context, debate *core.Debate, target *core.Turn, content string) (*core.SteelmanScore, error) {
+	score := &core.SteelmanScore{
+		TargetID:    target.AgentID,
+		TargetTurn:  target.Number,
+		Restatement: extractRestatement(content),
+	}
+	if score.Restatement == "" {
+		score.Rationale = `No "## Steelman" section was found.`
+		return score, nil
+	}
+
+	rater, generate := e.steelmanRater(debate, target.AgentID)
+	score.RaterID = rater
+
+	instructionBlock := ""
+	if instructions := formatProjectInstructions(debate.ProjectInstructions); instructions != "" {
+		instructionBlock = "\n\n" + instructions
+	}
+	targetName := maskedNamesByID(debate)[target.AgentID]
+	prompt := fmt.Sprintf(`You are rating a steelman in a debate on: "%s"%s
+
+%s made this argument in turn %d:
+%s
+
+Another participant was asked to restate that argument in its strongest form before rebutting it. Their restatement:
+%s
+
+Rate the restatement's fidelity from 1 to 10: 10 means it captures the argument's strongest points as its author would make them; 1 means it misrepresents or weakens the argument (a straw man).
+
+Respond with ONLY a JSON object in this exact shape:
+{"score": <1-10>, "rationale": "<one or two sentences>"}`,
+		debate.Topic, instructionBlock, targetName, target.Number, target.Content, score.Restatement)
+
+	ask := prompt
+	var parseErr error
+	for attempt := 1; attempt <= maxStructuredAttempts; attempt++ {
+		reply, err := generate(ctx, ask)
+		if err != nil {
+			return nil, err
+		}
+		if score.Score, score.Rationale, parseErr = parseSteelmanRating(reply); parseErr == nil {
+			return score, nil
+		}
+		ask = retryPrompt(prompt, parseErr, reply)
+	}
+	return nil, fmt.Errorf("no valid reply after %d attempts: %w", maxStructuredAttempts, parseErr)
+}
+
+// steelmanRater returns who rates a restatement of targetID's argument:
+// the judge if the debate has one, otherwise the target agent itself. A
+// human target cannot be asked, so the debate's reviewing model rates it.
+func (e *Engine) steelmanRater(debate *core.Debate, targetID string) (string, consensus.GenerateFunc) {
+	if debate.Judge != nil {
+		return debate.Judge.ID, e.agentGenerator(debate, *debate.Judge)
+	}
+	if target, ok := debate.AgentByID(targetID); ok && !target.IsHuman() {
+		return target.ID, e.agentGenerator(debate, target)
+	}
+	return debate.ModelParticipants()[0].ID, e.consensusGenerator(debate)
+}
+
+// parseSteelmanRating reads a {"score", "rationale"} reply. Scores are
+// rounded and must be between 1 and 10.
+func parseSteelmanRating(content string) (int, string, error) {
+	var reply struct {
+		Score     *float64 `json:"score"`
+		Rationale string   `json:"rationale"`
+	}
+	if err := decodeRepairedJSON(content, &reply); err != nil {
+		return 0, "", err
+	}
+	if reply.Score == nil {
+		return 0, "", fmt.Errorf("score is required")
+	}
+	score := int(math.Round(*reply.Score))
+	if score < 1 || score > 10 {
+		return 0, "", fmt.Errorf("score must be between 1 and 10, got %v", *reply.Score)
+	}
+	return score, strings.TrimSpace(reply.Rationale), nil
+}
+
+// steelmanStatus describes a round's steelman scores for the summary prompt.
+func steelmanStatus(debate *core.Debate, summaries []*core.SteelmanSummary) string {
+	names := maskedNamesByID(debate)
+	parts := make([]string, 0, len(summaries))
+	for _, s := range summaries {
+		parts = append(parts, fmt.Sprintf("%s averaged %.1f/10 over %d restatement(s), %d passed", names[s.AgentID], s.Average, s.Turns, s.Passed))
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,315 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+	baseprovider "github.com/alienxp03/conclave/provider"
+)
+
+// maxStructuredAttempts is how many times a model is asked for a strict
+// JSON reply (the first ask plus re-asks) before the engine gives up.
+const maxStructuredAttempts = 2
+
+// maxExtractedOptions caps the options extracted from a debate.
+const maxExtractedOptions = 5
+
+// voteOptions returns the options the debate votes over, extracting them
+// from the history when the debate has none. It returns nil for debates
+// that vote AGREE/DISAGREE.
+func (e *Engine) voteOptions(ctx context.Context, debate *core.Debate, history string) ([]string, error) {
+	if debate.Vote == nil {
+		return nil, nil
+	}
+	if len(debate.Vote.Options) > 0 {
+		return debate.Vote.Options, nil
+	}
+	return e.extractVoteOptions(ctx, debate, history)
+}
+
+// extractVoteOptions asks the judge, or the first model participant if the
+// debate has none, for the distinct courses of action the debate considered.
+// A human seat has no model to ask.
+func (e *Engine) extractVoteOptions(ctx context.Context, debate *core.Debate, history string) ([]string, error) {
+	instructionBlock := ""
+	if instructions := formatProjectInstructions(debate.ProjectInstructions); instructions != "" {
+		instructionBlock = "\n\n" + instructions
+	}
+
+	prompt := fmt.Sprintf(`Here is a debate on: "%s"%s
+
+%s
+
+List the distinct options (positions or courses of action) the participants put forward, so they can vote between them. Give between 2 and %d short, mutually exclusive options, each a few words long.
+
+Respond with ONLY a JSON object in this exact shape:
+{"options": ["<option>", "<option>"]}`, debate.Topic, instructionBlock, history, maxExtractedOptions)
+
+	extractor := debate.ModelParticipants()[0]
+	if debate.Judge != nil {
+		extractor = *debate.Judge
+	}
+
+	var options []string
+	err := e.askStructured(ctx, debate, extractor, core.TurnTypeConclusion, prompt, func(content string) error {
+		var reply struct {
+			Options []string `json:"options"`
+		}
+		if err := decodeRepairedJSON(content, &reply); err != nil {
+			return err
+		}
+		normalized, err := core.NormalizeVoteOptions(reply.Options)
+		if err != nil {
+			return err
+		}
+		if len(normalized) > maxExtractedOptions {
+			normalized = normalized[:maxExtractedOptions]
+		}
+		options = normalized
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract vote options: %w", err)
+	}
+	return options, nil
+}
+
+// getOptionVote asks an agent to pick one of the options with a confidence
+// and rationale.
+func (e *Engine) getOptionVote(ctx context.Context, debate *core.Debate, agent core.Agent, history string, options []string) (*core.Vote, error) {
+	instructionBlock := ""
+	if instructions := formatProjectInstructions(debate.ProjectInstructions); instructions != "" {
+		instructionBlock = "\n\n" + instructions
+	}
+
+	var list strings.Builder
+	for i, option := range options {
+		fmt.Fprintf(&list, "%d. %s\n", i+1, option)
+	}
+
+	prompt := fmt.Sprintf(`You participated in a debate on: "%s"%s
+
+Here is the full debate:
+%s
+
+Now it's time to decide. Vote for the option you believe is best, whatever position you argued:
+%s
+Respond with ONLY a JSON object in this exact shape:
+{"option": <option number>, "confidence": <0.0-1.0>, "rationale": "<why, in 1-2 sentences>"}`, debate.Topic, instructionBlock, history, list.String())
+
+	var vote *core.Vote
+	err := e.askStructured(ctx, debate, agent, core.TurnTypeVote, prompt, func(content string) error {
+		v, err := parseOptionVote(content, options)
+		if err != nil {
+			return err
+		}
+		v.AgentID = agent.ID
+		vote = v
+		return nil
+	})
+	return vote, err
+}
+
+// askStructured sends prompt to agent and hands the reply to parse. When
+// parse fails the agent is asked again, shown the error and its reply, up
+// to maxStructuredAttempts times. Every reply is saved as a turnType turn
+// so it counts toward usage and budgets.
+func (e *Engine) askStructured(ctx context.Context, debate *core.Debate, agent core.Agent, turnType core.TurnType, prompt string, parse func(content string) error) error {
+	prov, err := e.registry.Get(agent.Provider)
+	if err != nil {
+		return err
+	}
+	model := agent.Model
+	if model == "" {
+		model = prov.DefaultModel()
+	}
+
+	ask := prompt
+	var parseErr error
+	for attempt := 1; attempt <= maxStructuredAttempts; attempt++ {
+		resp, err := prov.GenerateWithResponseDir(ctx, ask, model, debate.CWD)
+		if err != nil {
+			return err
+		}
+		e.saveConclusionTurn(debate, agent.ID, turnType, model, resp)
+
+		if parseErr = parse(resp.Content); parseErr == nil {
+			return nil
+		}
+		ask = retryPrompt(prompt, parseErr, resp.Content)
+	}
+	return fmt.Errorf("no valid reply after %d attempts: %w", maxStructuredAttempts, parseErr)
+}
+
+// retryPrompt asks again for a structured reply, showing the model why its
+// previous reply could not be used.
+func retryPrompt(prompt string, parseErr error, reply string) string {
+	return fmt.Sprintf(`%s
+
+Your previous reply could not be used (%v):
+%s
+
+Reply again with ONLY the JSON object, exactly in the shape requested.`, prompt, parseErr, reply)
+}
+
+// saveConclusionTurn records a vote or conclusion reply as a turn in the
+// latest round, for metadata tracking.
+func (e *Engine) saveConclusionTurn(debate *core.Debate, agentID string, turnType core.TurnType, model string, resp *baseprovider.Response) {
+	turns, _ := e.storage.GetTurns(debate.ID)
+	round := 1
+	if len(turns) > 0 {
+		round = turns[len(turns)-1].Round
+	}
+
+	turn := &core.Turn{
+		ID:        core.GenerateID(),
+		DebateID:  debate.ID,
+		AgentID:   agentID,
+		Number:    len(turns) + 1,
+		Round:     round,
+		Content:   resp.Content,
+		CreatedAt: time.Now(),
+		TurnType:  turnType,
+		Model:     model,
+	}
+	if resp.Metadata != nil {
+		turn.InputTokens = resp.Metadata.InputTokens
+		turn.OutputTokens = resp.Metadata.OutputTokens
+		turn.TotalTokens = resp.Metadata.TotalTokens
+		turn.DurationMs = resp.Metadata.Duration.Milliseconds()
+		turn.StopReason = resp.Metadata.StopReason
+		turn.TokensEstimated = resp.Metadata.Estimated
+		turn.InputEstimated = resp.Metadata.InputEstimated
+		turn.OutputEstimated = resp.Metadata.OutputEstimated
+		turn.CostUSD = resp.Metadata.CostUSD
+	}
+	if err := e.storage.AddTurn(turn); err != nil {
+		slog.Warn("Failed to save conclusion turn", "type", turnType, "error", err)
+	}
+}
+
+// parseOptionVote reads a {"option", "confidence", "rationale"} reply. The
+// option may be its number or its text; the confidence may be a fraction,
+// a percentage or a numeric string.
+func parseOptionVote(content string, options []string) (*core.Vote, error) {
+	var reply struct {
+		Option     any    `json:"option"`
+		Confidence any    `json:"confidence"`
+		Rationale  string `json:"rationale"`
+	}
+	if err := decodeRepairedJSON(content, &reply); err != nil {
+		return nil, err
+	}
+
+	option, err := resolveOption(reply.Option, options)
+	if err != nil {
+		return nil, err
+	}
+	confidence, err := parseConfidence(reply.Confidence)
+	if err != nil {
+		return nil, err
+	}
+	rationale := strings.TrimSpace(reply.Rationale)
+	if rationale == "" {
+		return nil, fmt.Errorf("rationale is required")
+	}
+
+	return &core.Vote{Option: option, Confidence: confidence, Reasoning: rationale}, nil
+}
+
+var optionNumberPrefix = regexp.MustCompile(`^(?:option\s*)?(\d+)[.):]?\s*`)
+
+func resolveOption(value any, options []string) (string, error) {
+	var text string
+	switch v := value.(type) {
+	case float64:
+		text = strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		text = strings.TrimSpace(v)
+	case nil:
+		return "", fmt.Errorf("option is required")
+	default:
+		return "", fmt.Errorf("option must be a number or text")
+	}
+
+	for _, option := range options {
+		if strings.EqualFold(text, option) {
+			return option, nil
+		}
+	}
+	// A number, "2." or "Option 2", optionally followed by the option text
+	if m := optionNumberPrefix.FindStringSubmatch(strings.ToLower(text)); m != nil {
+		if n, _ := strconv.Atoi(m[1]); n >= 1 && n <= len(options) {
+			return options[n-1], nil
+		}
+	}
+	return "", fmt.Errorf("option %q is not one of the %d options", text, len(options))
+}
+
+func parseConfidence(value any) (float64, error) {
+	var c float64
+	switch v := value.(type) {
+	case float64:
+		c = v
+	case string:
+		s := strings.TrimSpace(v)
+		percent := strings.HasSuffix(s, "%")
+		n, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+		if err != nil {
+			return 0, fmt.Errorf("confidence %q is not a number", v)
+		}
+		if c = n; percent {
+			c = n / 100
+		}
+	case nil:
+		return 0, fmt.Errorf("confidence is required")
+	default:
+		return 0, fmt.Errorf("confidence must be a number")
+	}
+
+	if c > 1 && c <= 100 {
+		c /= 100 // A percentage without the sign
+	}
+	if c < 0 || c > 1 {
+		return 0, fmt.Errorf("confidence %v is not between 0 and 1", value)
+	}
+	return c, nil
+}
+
+var (
+	codeFence     = regexp.MustCompile("(?s)```(?:json)?\\s*(.*?)```")
+	trailingComma = regexp.MustCompile(`,\s*([}\]])`)
+	smartQuotes   = strings.NewReplacer("“", `"`, "”", `"`)
+)
+
+// decodeRepairedJSON decodes the JSON object in a model reply into v after
+// fixing common slips: code fences, text around the object, curly quotes
+// and trailing commas.
+func decodeRepairedJSON(content string, v any) error {
+	s := content
+	if m := codeFence.FindStringSubmatch(s); m != nil {
+		s = m[1]
+	}
+	start := strings.Index(s, "{")
+	end := strings.LastIndex(s, "}")
+	if start < 0 || end < start {
+		return fmt.Errorf("no JSON object found")
+	}
+	s = s[start : end+1]
+
+	if err := json.Unmarshal([]byte(s), v); err == nil {
+		return nil
+	}
+	s = trailingComma.ReplaceAllString(smartQuotes.Replace(s), "$1")
+	if err := json.Unmarshal([]byte(s), v); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+	return nil
+}
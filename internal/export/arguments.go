@@ -0,0 +1,168 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// Argument graph formats.
+const (
+	FormatDOT     Format = "dot"
+	FormatMermaid Format = "mermaid"
+)
+
+// argumentColors fill each participant's claims, in seat order.
+var argumentColors = []string{"#dbeafe", "#fee2e2", "#dcfce7", "#fef3c7", "#ede9fe", "#cffafe"}
+
+// maxLabelLen truncates long claims in graph labels.
+const maxLabelLen = 120
+
+// ExportArguments writes a debate's argument graph as Graphviz DOT or
+// Mermaid. Claims are grouped by turn, rebuttals point at the claims they
+// answer, and claims no other agent answered get a thick red border.
+func ExportArguments(debate *core.Debate, graph *core.ArgumentGraph, format Format, w io.Writer) error {
+	switch format {
+	case FormatDOT:
+		_, err := io.WriteString(w, argumentsDOT(debate, graph))
+		return err
+	case FormatMermaid:
+		_, err := io.WriteString(w, argumentsMermaid(debate, graph))
+		return err
+	default:
+		return fmt.Errorf("unsupported argument graph format: %s (use dot or mermaid)", format)
+	}
+}
+
+func argumentsDOT(debate *core.Debate, graph *core.ArgumentGraph) string {
+	colors := claimColors(debate)
+	unanswered := unansweredIDs(graph)
+
+	var sb strings.Builder
+	sb.WriteString("digraph arguments {\n")
+	fmt.Fprintf(&sb, "  label=%s;\n  labelloc=t;\n", dotQuote(debate.Topic))
+	sb.WriteString("  rankdir=TB;\n  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\"];\n")
+
+	for _, turn := range claimsByTurn(graph) {
+		first := turn[0]
+		fmt.Fprintf(&sb, "\n  subgraph cluster_t%d {\n", first.TurnNumber)
+		fmt.Fprintf(&sb, "    label=%s;\n", dotQuote(turnLabel(debate, first)))
+		for _, c := range turn {
+			label := truncate(c.Text, maxLabelLen)
+			for _, ev := range c.Evidence {
+				label += "\n• " + truncate(ev, maxLabelLen)
+			}
+			attrs := fmt.Sprintf("label=%s, fillcolor=%q", dotQuote(label), colors[c.AgentID])
+			if unanswered[c.ID] {
+				attrs += ", color=\"#dc2626\", penwidth=3"
+			}
+			fmt.Fprintf(&sb, "    %s [%s];\n", c.ID, attrs)
+		}
+		sb.WriteString("  }\n")
+	}
+
+	sb.WriteString("\n")
+	for _, c := range graph.Claims {
+		for _, target := range c.Rebuts {
+			fmt.Fprintf(&sb, "  %s -> %s [label=\"rebuts\"];\n", c.ID, target)
+		}
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+func argumentsMermaid(debate *core.Debate, graph *core.ArgumentGraph) string {
+	colors := claimColors(debate)
+
+	var sb strings.Builder
+	sb.WriteString("flowchart TD\n")
+	for _, turn := range claimsByTurn(graph) {
+		first := turn[0]
+		fmt.Fprintf(&sb, "  subgraph t%d[\"%s\"]\n", first.TurnNumber, mermaidEscape(turnLabel(debate, first)))
+		for _, c := range turn {
+			label := mermaidEscape(truncate(c.Text, maxLabelLen))
+			for _, ev := range c.Evidence {
+				label += "<br/>• " + mermaidEscape(truncate(ev, maxLabelLen))
+			}
+			fmt.Fprintf(&sb, "    %s[\"%s\"]\n", c.ID, label)
+		}
+		sb.WriteString("  end\n")
+	}
+
+	for _, c := range graph.Claims {
+		for _, target := range c.Rebuts {
+			fmt.Fprintf(&sb, "  %s -->|rebuts| %s\n", c.ID, target)
+		}
+	}
+	for _, c := range graph.Claims {
+		fmt.Fprintf(&sb, "  style %s fill:%s\n", c.ID, colors[c.AgentID])
+	}
+
+	var unanswered []string
+	for _, c := range graph.Unanswered() {
+		unanswered = append(unanswered, c.ID)
+	}
+	if len(unanswered) > 0 {
+		sb.WriteString("  classDef unanswered stroke:#dc2626,stroke-width:3px\n")
+		fmt.Fprintf(&sb, "  class %s unanswered\n", strings.Join(unanswered, ","))
+	}
+	return sb.String()
+}
+
+// claimsByTurn splits the graph's claims into runs of the same turn.
+func claimsByTurn(graph *core.ArgumentGraph) [][]*core.Claim {
+	var turns [][]*core.Claim
+	for _, c := range graph.Claims {
+		if n := len(turns); n > 0 && turns[n-1][0].TurnNumber == c.TurnNumber {
+			turns[n-1] = append(turns[n-1], c)
+			continue
+		}
+		turns = append(turns, []*core.Claim{c})
+	}
+	return turns
+}
+
+func claimColors(debate *core.Debate) map[string]string {
+	colors := make(map[string]string)
+	for i, agent := range debate.Participants() {
+		colors[agent.ID] = argumentColors[i%len(argumentColors)]
+	}
+	return colors
+}
+
+func unansweredIDs(graph *core.ArgumentGraph) map[string]bool {
+	ids := make(map[string]bool)
+	for _, c := range graph.Unanswered() {
+		ids[c.ID] = true
+	}
+	return ids
+}
+
+func turnLabel(debate *core.Debate, c *core.Claim) string {
+	name := c.AgentID
+	if agent, ok := debate.AgentByID(c.AgentID); ok {
+		name = agent.Name
+	}
+	return fmt.Sprintf("Turn %d - %s", c.TurnNumber, name)
+}
+
+func truncate(s string, n int) string {
+	r := []rune(strings.Join(strings.Fields(s), " "))
+	if len(r) <= n {
+		return string(r)
+	}
+	return string(r[:n-1]) + "…"
+}
+
+// dotQuote quotes s as a DOT string; newlines become centered line breaks.
+func dotQuote(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+	return `"` + strings.ReplaceAll(s, "\n", `\n`) + `"`
+}
+
+// mermaidEscape makes s safe inside a quoted Mermaid label.
+func mermaidEscape(s string) string {
+	return strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;").Replace(s)
+}
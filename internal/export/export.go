@@ -82,3 +82,15 @@ func formatDuration(start, end time.Time) string {
 	}
 	return fmt.Sprintf("%.1f hours", d.Hours())
 }
+
+// referenceNote describes a checked code reference after its text: where
+// it resolved to, or why it could not be verified.
+func referenceNote(ref *core.CodeReference) string {
+	if ref.Verified {
+		if ref.Resolved != "" && ref.Resolved != ref.Text {
+			return "verified, " + ref.Resolved
+		}
+		return "verified"
+	}
+	return "unverified, " + ref.Reason
+}
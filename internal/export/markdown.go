@@ -32,16 +32,33 @@ func (e *MarkdownExporter) Export(debate *core.Debate, turns []*core.Turn, w io.
 
 	// Participants
 	sb.WriteString("## Participants\n\n")
-	sb.WriteString(fmt.Sprintf("### Agent A\n"))
-	sb.WriteString(fmt.Sprintf("- **Name:** %s\n", debate.AgentA.Name))
-	sb.WriteString(fmt.Sprintf("- **Provider:** %s\n", debate.AgentA.Provider))
-	sb.WriteString(fmt.Sprintf("- **Persona:** %s\n", debate.AgentA.Persona))
-	sb.WriteString("\n")
-	sb.WriteString(fmt.Sprintf("### Agent B\n"))
-	sb.WriteString(fmt.Sprintf("- **Name:** %s\n", debate.AgentB.Name))
-	sb.WriteString(fmt.Sprintf("- **Provider:** %s\n", debate.AgentB.Provider))
-	sb.WriteString(fmt.Sprintf("- **Persona:** %s\n", debate.AgentB.Persona))
-	sb.WriteString("\n")
+	for i, agent := range debate.Participants() {
+		sb.WriteString(fmt.Sprintf("### Agent %c\n", 'A'+i))
+		sb.WriteString(fmt.Sprintf("- **Name:** %s\n", agent.Name))
+		sb.WriteString(fmt.Sprintf("- **Provider:** %s\n", agent.Provider))
+		if agent.Persona != "" {
+			sb.WriteString(fmt.Sprintf("- **Persona:** %s\n", agent.Persona))
+		}
+		sb.WriteString("\n")
+	}
+	if debate.Judge != nil {
+		sb.WriteString("### Judge\n")
+		sb.WriteString(fmt.Sprintf("- **Name:** %s\n", debate.Judge.Name))
+		sb.WriteString(fmt.Sprintf("- **Provider:** %s\n", debate.Judge.Provider))
+		if debate.Judge.Persona != "" {
+			sb.WriteString(fmt.Sprintf("- **Persona:** %s\n", debate.Judge.Persona))
+		}
+		sb.WriteString("\n")
+	}
+	if debate.Moderator != nil {
+		sb.WriteString("### Moderator\n")
+		sb.WriteString(fmt.Sprintf("- **Name:** %s\n", debate.Moderator.Name))
+		sb.WriteString(fmt.Sprintf("- **Provider:** %s\n", debate.Moderator.Provider))
+		if debate.Moderator.Persona != "" {
+			sb.WriteString(fmt.Sprintf("- **Persona:** %s\n", debate.Moderator.Persona))
+		}
+		sb.WriteString("\n")
+	}
 
 	// Debate Content
 	sb.WriteString("## Debate\n\n")
@@ -71,16 +88,31 @@ func (e *MarkdownExporter) Export(debate *core.Debate, turns []*core.Turn, w io.
 			}
 
 			for _, turn := range rounds[r] {
-				agentName := debate.AgentA.Name
-				if turn.AgentID == debate.AgentB.ID {
-					agentName = debate.AgentB.Name
-				} else if turn.AgentID == "user" {
-					agentName = "User (Follow-up)"
+				agentName := "User (Follow-up)"
+				if agent, ok := debate.AgentByID(turn.AgentID); ok {
+					agentName = agent.Name
+				} else if debate.IsJudge(turn.AgentID) {
+					agentName = debate.Judge.Name
+				} else if debate.IsModerator(turn.AgentID) {
+					agentName = debate.Moderator.Name
 				}
 
 				sb.WriteString(fmt.Sprintf("#### Turn %d - %s\n\n", turn.Number, agentName))
 				sb.WriteString(fmt.Sprintf("*%s*\n\n", turn.CreatedAt.Format("3:04 PM")))
 				sb.WriteString(turn.Content)
+				if st := turn.Steelman; st != nil {
+					sb.WriteString(fmt.Sprintf("\n\n*Steelman fidelity: %d/10 (%d attempt(s)). %s*", st.Score, st.Attempts, st.Rationale))
+				}
+				if len(turn.References) > 0 {
+					sb.WriteString("\n\n**Code references:**\n")
+					for _, ref := range turn.References {
+						mark := "❌"
+						if ref.Verified {
+							mark = "✅"
+						}
+						sb.WriteString(fmt.Sprintf("\n- %s `%s` (%s)", mark, ref.Text, referenceNote(ref)))
+					}
+				}
 				sb.WriteString("\n\n---\n\n")
 			}
 
@@ -88,8 +120,12 @@ func (e *MarkdownExporter) Export(debate *core.Debate, turns []*core.Turn, w io.
 			if c, ok := conclusions[r]; ok {
 				sb.WriteString(fmt.Sprintf("### Round %d Conclusion\n\n", r))
 
-				if c.Agreed {
+				if c.Agreed && c.ModelVotesOnly {
+					sb.WriteString("**✅ Consensus Reached** (AI vote only; the human participant did not vote)\n\n")
+				} else if c.Agreed {
 					sb.WriteString("**✅ Consensus Reached**\n\n")
+				} else if c.Type == core.ConclusionStalemate {
+					sb.WriteString("**🔁 Stalemate**\n\n")
 				} else {
 					sb.WriteString("**❌ No Consensus**\n\n")
 				}
@@ -97,16 +133,63 @@ func (e *MarkdownExporter) Export(debate *core.Debate, turns []*core.Turn, w io.
 				sb.WriteString(c.Summary)
 				sb.WriteString("\n\n")
 
-				if !c.Agreed {
-					if c.AgentASummary != "" {
-						sb.WriteString(fmt.Sprintf("#### %s's Position\n\n", debate.AgentA.Name))
-						sb.WriteString(c.AgentASummary)
-						sb.WriteString("\n\n")
+				if r := c.Consensus; r != nil {
+					sb.WriteString(fmt.Sprintf("*Consensus check (%s): %.2f / %.2f. %s*\n\n", r.Method, r.Score, r.Threshold, r.Rationale))
+				}
+				if r := c.Stalemate; r != nil {
+					sb.WriteString(fmt.Sprintf("*Stalemate check: %s*\n\n", r.Rationale))
+				}
+				for _, st := range c.Steelman {
+					name := st.AgentID
+					if agent, ok := debate.AgentByID(st.AgentID); ok {
+						name = agent.Name
+					}
+					sb.WriteString(fmt.Sprintf("*Steelman fidelity, %s: %.1f/10 over %d restatement(s), %d passed*\n\n", name, st.Average, st.Turns, st.Passed))
+				}
+
+				if c.Verdict != nil {
+					e.writeVerdict(&sb, debate, c.Verdict)
+				}
+
+				var votes strings.Builder
+				for _, agent := range debate.Participants() {
+					if vote := c.VoteFor(agent.ID); vote != nil {
+						verdict := "Disagree"
+						if vote.Option != "" {
+							verdict = vote.Label() + " — " + vote.Reasoning
+						} else if vote.Agrees {
+							verdict = "Agree"
+						}
+						votes.WriteString(fmt.Sprintf("- **%s:** %s\n", agent.Name, verdict))
+					}
+				}
+				if votes.Len() > 0 {
+					sb.WriteString("#### Votes\n\n")
+					sb.WriteString(votes.String())
+					sb.WriteString("\n")
+				}
+
+				if t := c.Tally; t != nil {
+					sb.WriteString("#### Tally\n\n")
+					sb.WriteString("| Option | Votes | Confidence |\n")
+					sb.WriteString("|--------|-------|------------|\n")
+					for _, o := range t.Options {
+						option := o.Option
+						if option == t.Winner {
+							option = "**" + option + "** 🏆"
+						}
+						sb.WriteString(fmt.Sprintf("| %s | %d | %.2f |\n", option, o.Votes, o.Confidence))
 					}
-					if c.AgentBSummary != "" {
-						sb.WriteString(fmt.Sprintf("#### %s's Position\n\n", debate.AgentB.Name))
-						sb.WriteString(c.AgentBSummary)
-						sb.WriteString("\n\n")
+					sb.WriteString("\n")
+				}
+
+				if !c.Agreed {
+					for _, agent := range debate.Participants() {
+						if position := c.PositionOf(agent.ID); position != "" {
+							sb.WriteString(fmt.Sprintf("#### %s's Position\n\n", agent.Name))
+							sb.WriteString(position)
+							sb.WriteString("\n\n")
+						}
 					}
 				}
 				sb.WriteString("\n---\n\n")
@@ -126,3 +209,51 @@ func (e *MarkdownExporter) Export(debate *core.Debate, turns []*core.Turn, w io.
 func (e *MarkdownExporter) FileExtension() string {
 	return "md"
 }
+
+// writeVerdict renders a judge's rubric scores as a table.
+func (e *MarkdownExporter) writeVerdict(sb *strings.Builder, debate *core.Debate, verdict *core.JudgeVerdict) {
+	sb.WriteString("#### Judge Scores\n\n")
+	sb.WriteString("| Agent |")
+	for _, criterion := range core.DefaultRubric {
+		sb.WriteString(fmt.Sprintf(" %s |", criterion.Name))
+	}
+	sb.WriteString(" Total |\n|---|")
+	for range core.DefaultRubric {
+		sb.WriteString("---|")
+	}
+	sb.WriteString("---|\n")
+
+	for _, agent := range debate.Participants() {
+		score := verdict.ScoreFor(agent.ID)
+		if score == nil {
+			continue
+		}
+		name := agent.Name
+		if verdict.WinnerID == agent.ID {
+			name += " 🏆"
+		}
+		sb.WriteString(fmt.Sprintf("| %s |", name))
+		for _, criterion := range core.DefaultRubric {
+			sb.WriteString(fmt.Sprintf(" %d |", score.Scores[criterion.ID]))
+		}
+		sb.WriteString(fmt.Sprintf(" %d |\n", score.Total))
+	}
+	sb.WriteString("\n")
+
+	for _, agent := range debate.Participants() {
+		if score := verdict.ScoreFor(agent.ID); score != nil && score.Feedback != "" {
+			sb.WriteString(fmt.Sprintf("- **%s:** %s\n", agent.Name, score.Feedback))
+		}
+	}
+
+	switch verdict.Outcome {
+	case core.VerdictWinner:
+		if winner, ok := debate.AgentByID(verdict.WinnerID); ok {
+			sb.WriteString(fmt.Sprintf("\n**Winner:** %s\n\n", winner.Name))
+		}
+	case core.VerdictDraw:
+		sb.WriteString("\n**Outcome:** Draw\n\n")
+	default:
+		sb.WriteString("\n")
+	}
+}
@@ -49,9 +49,24 @@ func (e *PDFExporter) Export(debate *core.Debate, turns []*core.Turn, w io.Write
 	pdf.Ln(8)
 
 	pdf.SetFont("Arial", "", 10)
-	e.addParticipantBox(pdf, "Agent A", debate.AgentA, 200, 230, 255) // Light blue
-	pdf.Ln(3)
-	e.addParticipantBox(pdf, "Agent B", debate.AgentB, 200, 255, 200) // Light green
+	participants := debate.Participants()
+	colorByAgent := make(map[string][3]int)
+	for i, agent := range participants {
+		color := participantColors[i%len(participantColors)]
+		colorByAgent[agent.ID] = color
+		if i > 0 {
+			pdf.Ln(3)
+		}
+		e.addParticipantBox(pdf, fmt.Sprintf("Agent %c", 'A'+i), agent, color[0], color[1], color[2])
+	}
+	if debate.Judge != nil {
+		pdf.Ln(3)
+		e.addParticipantBox(pdf, "Judge", *debate.Judge, 235, 235, 235) // Light gray
+	}
+	if debate.Moderator != nil {
+		pdf.Ln(3)
+		e.addParticipantBox(pdf, "Moderator", *debate.Moderator, 235, 235, 235) // Light gray
+	}
 	pdf.Ln(8)
 
 	// Debate content
@@ -88,15 +103,16 @@ func (e *PDFExporter) Export(debate *core.Debate, turns []*core.Turn, w io.Write
 			}
 
 			for _, turn := range rounds[r] {
-				agentName := debate.AgentA.Name
-				isAgentA := turn.AgentID == debate.AgentA.ID
-				isUser := turn.AgentID == "user"
-
-				if !isAgentA {
-					agentName = debate.AgentB.Name
-				}
-				if isUser {
-					agentName = "User (Follow-up)"
+				agentName := "User (Follow-up)"
+				agent, isAgent := debate.AgentByID(turn.AgentID)
+				isJudge := debate.IsJudge(turn.AgentID)
+				isModerator := debate.IsModerator(turn.AgentID)
+				if isAgent {
+					agentName = agent.Name
+				} else if isJudge {
+					agentName = debate.Judge.Name
+				} else if isModerator {
+					agentName = debate.Moderator.Name
 				}
 
 				// Check if we need a new page
@@ -105,12 +121,13 @@ func (e *PDFExporter) Export(debate *core.Debate, turns []*core.Turn, w io.Write
 				}
 
 				// Turn header
-				if isUser {
-					pdf.SetFillColor(255, 240, 200) // Light yellow
-				} else if isAgentA {
-					pdf.SetFillColor(200, 230, 255) // Light blue
+				if isAgent {
+					color := colorByAgent[agent.ID]
+					pdf.SetFillColor(color[0], color[1], color[2])
+				} else if isJudge || isModerator {
+					pdf.SetFillColor(235, 235, 235) // Light gray
 				} else {
-					pdf.SetFillColor(200, 255, 200) // Light green
+					pdf.SetFillColor(255, 240, 200) // Light yellow
 				}
 
 				pdf.SetFont("Arial", "B", 10)
@@ -122,6 +139,14 @@ func (e *PDFExporter) Export(debate *core.Debate, turns []*core.Turn, w io.Write
 				pdf.SetFillColor(255, 255, 255)
 				content := e.sanitizeText(turn.Content)
 				pdf.MultiCell(0, 5, content, "", "", false)
+				if st := turn.Steelman; st != nil {
+					line := fmt.Sprintf("Steelman fidelity: %d/10 (%d attempt(s)). %s", st.Score, st.Attempts, st.Rationale)
+					pdf.MultiCell(0, 5, e.sanitizeText(line), "", "", false)
+				}
+				for _, ref := range turn.References {
+					line := fmt.Sprintf("Code reference %s: %s", ref.Text, referenceNote(ref))
+					pdf.MultiCell(0, 5, e.sanitizeText(line), "", "", false)
+				}
 				pdf.Ln(5)
 			}
 
@@ -138,7 +163,15 @@ func (e *PDFExporter) Export(debate *core.Debate, turns []*core.Turn, w io.Write
 				if c.Agreed {
 					pdf.SetFillColor(200, 255, 200) // Light green
 					pdf.SetFont("Arial", "B", 10)
-					pdf.CellFormat(0, 7, "Consensus Reached", "", 1, "", true, 0, "")
+					label := "Consensus Reached"
+					if c.ModelVotesOnly {
+						label += " (AI vote only)"
+					}
+					pdf.CellFormat(0, 7, label, "", 1, "", true, 0, "")
+				} else if c.Type == core.ConclusionStalemate {
+					pdf.SetFillColor(255, 235, 200) // Light orange
+					pdf.SetFont("Arial", "B", 10)
+					pdf.CellFormat(0, 7, "Stalemate", "", 1, "", true, 0, "")
 				} else {
 					pdf.SetFillColor(255, 200, 200) // Light red
 					pdf.SetFont("Arial", "B", 10)
@@ -150,21 +183,80 @@ func (e *PDFExporter) Export(debate *core.Debate, turns []*core.Turn, w io.Write
 				pdf.MultiCell(0, 5, e.sanitizeText(c.Summary), "", "", false)
 				pdf.Ln(3)
 
-				if !c.Agreed {
-					if c.AgentASummary != "" {
-						pdf.SetFont("Arial", "B", 10)
-						pdf.Cell(0, 6, fmt.Sprintf("%s's Position:", debate.AgentA.Name))
-						pdf.Ln(6)
-						pdf.SetFont("Arial", "", 9)
-						pdf.MultiCell(0, 5, e.sanitizeText(c.AgentASummary), "", "", false)
-						pdf.Ln(3)
+				pdf.SetFont("Arial", "", 9)
+				if r := c.Consensus; r != nil {
+					line := fmt.Sprintf("Consensus check (%s): %.2f / %.2f. %s", r.Method, r.Score, r.Threshold, r.Rationale)
+					pdf.MultiCell(0, 5, e.sanitizeText(line), "", "", false)
+				}
+				if r := c.Stalemate; r != nil {
+					pdf.MultiCell(0, 5, e.sanitizeText("Stalemate check: "+r.Rationale), "", "", false)
+				}
+				for _, st := range c.Steelman {
+					name := st.AgentID
+					if agent, ok := debate.AgentByID(st.AgentID); ok {
+						name = agent.Name
+					}
+					line := fmt.Sprintf("Steelman fidelity - %s: %.1f/10 over %d restatement(s), %d passed", name, st.Average, st.Turns, st.Passed)
+					pdf.MultiCell(0, 5, e.sanitizeText(line), "", "", false)
+				}
+				if v := c.Verdict; v != nil {
+					for _, agent := range participants {
+						score := v.ScoreFor(agent.ID)
+						if score == nil {
+							continue
+						}
+						line := fmt.Sprintf("Judge - %s: %d points", agent.Name, score.Total)
+						for _, criterion := range core.DefaultRubric {
+							line += fmt.Sprintf(" | %s %d", criterion.Name, score.Scores[criterion.ID])
+						}
+						if v.WinnerID == agent.ID {
+							line += " (winner)"
+						}
+						pdf.MultiCell(0, 5, e.sanitizeText(line), "", "", false)
+						if score.Feedback != "" {
+							pdf.MultiCell(0, 5, e.sanitizeText("    "+score.Feedback), "", "", false)
+						}
+					}
+					if v.Outcome == core.VerdictDraw {
+						pdf.Cell(0, 5, "Judge declared a draw")
+						pdf.Ln(5)
+					}
+				}
+				for _, agent := range participants {
+					if vote := c.VoteFor(agent.ID); vote != nil {
+						verdict := "Disagree"
+						if vote.Option != "" {
+							verdict = vote.Label()
+						} else if vote.Agrees {
+							verdict = "Agree"
+						}
+						pdf.Cell(0, 5, e.sanitizeText(fmt.Sprintf("Vote - %s: %s", agent.Name, verdict)))
+						pdf.Ln(5)
 					}
-					if c.AgentBSummary != "" {
+				}
+				if t := c.Tally; t != nil {
+					for _, o := range t.Options {
+						line := fmt.Sprintf("Tally - %s: %d vote(s), confidence %.2f", o.Option, o.Votes, o.Confidence)
+						if o.Option == t.Winner {
+							line += " (winner)"
+						}
+						pdf.Cell(0, 5, e.sanitizeText(line))
+						pdf.Ln(5)
+					}
+				}
+				pdf.Ln(2)
+
+				if !c.Agreed {
+					for _, agent := range participants {
+						position := c.PositionOf(agent.ID)
+						if position == "" {
+							continue
+						}
 						pdf.SetFont("Arial", "B", 10)
-						pdf.Cell(0, 6, fmt.Sprintf("%s's Position:", debate.AgentB.Name))
+						pdf.Cell(0, 6, fmt.Sprintf("%s's Position:", agent.Name))
 						pdf.Ln(6)
 						pdf.SetFont("Arial", "", 9)
-						pdf.MultiCell(0, 5, e.sanitizeText(c.AgentBSummary), "", "", false)
+						pdf.MultiCell(0, 5, e.sanitizeText(position), "", "", false)
 						pdf.Ln(3)
 					}
 				}
@@ -195,6 +287,15 @@ func (e *PDFExporter) addMetadataRow(pdf *gofpdf.Fpdf, label, value string) {
 	pdf.Ln(5)
 }
 
+// participantColors are the fill colors cycled through for debate participants.
+var participantColors = [][3]int{
+	{200, 230, 255}, // Light blue
+	{200, 255, 200}, // Light green
+	{230, 210, 255}, // Light purple
+	{255, 220, 200}, // Light orange
+	{210, 245, 245}, // Light teal
+}
+
 // Helper to add a participant box
 func (e *PDFExporter) addParticipantBox(pdf *gofpdf.Fpdf, title string, agent core.Agent, r, g, b int) {
 	pdf.SetFillColor(r, g, b)
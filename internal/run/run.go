@@ -0,0 +1,276 @@
+// Package run tracks in-flight debate and council runs so they can be
+// paused, resumed, or cancelled.
+package run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// DefaultTimeout bounds a single background run.
+const DefaultTimeout = 30 * time.Minute
+
+const (
+	// HeartbeatInterval is how often a running session's heartbeat is refreshed.
+	HeartbeatInterval = 15 * time.Second
+	// StaleAfter is how long a heartbeat can go unrefreshed before its run
+	// is presumed dead.
+	StaleAfter = 4 * HeartbeatInterval
+)
+
+var (
+	// ErrPaused is the cancellation cause of a paused run.
+	ErrPaused = errors.New("run paused")
+	// ErrCancelled is the cancellation cause of a cancelled run.
+	ErrCancelled = errors.New("run cancelled")
+	// ErrAlreadyRunning is returned when a session already has an active run.
+	ErrAlreadyRunning = errors.New("session is already running")
+)
+
+// Heartbeats records which process owns a session's run and when it last
+// showed signs of life, so other processes can tell live runs from dead ones.
+type Heartbeats interface {
+	Heartbeat(sessionID, owner string) error
+	ClearHeartbeat(sessionID string) error
+}
+
+// Owner identifies this process in heartbeats as host:pid.
+func Owner() string {
+	host, _ := os.Hostname()
+	return fmt.Sprintf("%s:%d", host, os.Getpid())
+}
+
+// Manager owns a cancellable context per running session.
+type Manager struct {
+	mu         sync.Mutex
+	runs       map[string]*entry
+	heartbeats Heartbeats
+	owner      string
+}
+
+type entry struct {
+	cancel context.CancelCauseFunc
+	done   chan struct{}
+}
+
+// NewManager creates an empty run manager. While a session runs, its
+// heartbeat is refreshed in heartbeats every HeartbeatInterval; heartbeats
+// may be nil.
+func NewManager(heartbeats Heartbeats) *Manager {
+	return &Manager{runs: make(map[string]*entry), heartbeats: heartbeats, owner: Owner()}
+}
+
+// Run executes fn in the calling goroutine with a context that Pause and
+// Cancel can stop. It returns ErrAlreadyRunning if the session has a run.
+func (m *Manager) Run(ctx context.Context, id string, fn func(ctx context.Context) error) error {
+	runCtx, finish, err := m.register(ctx, id)
+	if err != nil {
+		return err
+	}
+	defer finish()
+	return fn(runCtx)
+}
+
+// Start executes fn in the background with a context detached from the
+// caller and bounded by DefaultTimeout. The run is registered before Start
+// returns, so it can be paused or waited on immediately.
+func (m *Manager) Start(id string, fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	err := m.StartContext(ctx, id, func(ctx context.Context) error {
+		defer cancel()
+		return fn(ctx)
+	})
+	if err != nil {
+		cancel()
+	}
+	return err
+}
+
+// StartContext executes fn in the background like Start, but under ctx
+// instead of DefaultTimeout. Long runs such as tournaments use it so they
+// end with their parent, e.g. on server shutdown.
+func (m *Manager) StartContext(ctx context.Context, id string, fn func(ctx context.Context) error) error {
+	runCtx, finish, err := m.register(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer finish()
+		if err := fn(runCtx); err != nil && !Stopped(runCtx) {
+			slog.Error("Background run failed", "id", id, "error", err)
+		}
+	}()
+	return nil
+}
+
+// register creates the cancellable context for a run and returns a func
+// that must be called when the run ends.
+func (m *Manager) register(ctx context.Context, id string) (context.Context, func(), error) {
+	m.mu.Lock()
+	if _, ok := m.runs[id]; ok {
+		m.mu.Unlock()
+		return nil, nil, ErrAlreadyRunning
+	}
+	runCtx, cancel := context.WithCancelCause(ctx)
+	e := &entry{cancel: cancel, done: make(chan struct{})}
+	m.runs[id] = e
+	m.mu.Unlock()
+
+	stopBeating := m.beat(id)
+	finish := func() {
+		cancel(nil)
+		stopBeating()
+		m.mu.Lock()
+		delete(m.runs, id)
+		m.mu.Unlock()
+		close(e.done)
+	}
+	return runCtx, finish, nil
+}
+
+// beat refreshes the session's heartbeat now and every HeartbeatInterval
+// until the returned func is called, which also clears it.
+func (m *Manager) beat(id string) func() {
+	if m.heartbeats == nil {
+		return func() {}
+	}
+	refresh := func() {
+		if err := m.heartbeats.Heartbeat(id, m.owner); err != nil {
+			slog.Warn("Failed to refresh run heartbeat", "id", id, "error", err)
+		}
+	}
+	refresh()
+
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(HeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				refresh()
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+		<-stopped
+		if err := m.heartbeats.ClearHeartbeat(id); err != nil {
+			slog.Warn("Failed to clear run heartbeat", "id", id, "error", err)
+		}
+	}
+}
+
+// Pause stops a run so it can be resumed later. It reports whether the
+// session was running in this process.
+func (m *Manager) Pause(id string) bool {
+	return m.stop(id, ErrPaused)
+}
+
+// Cancel stops a run for good. It reports whether the session was running
+// in this process.
+func (m *Manager) Cancel(id string) bool {
+	return m.stop(id, ErrCancelled)
+}
+
+func (m *Manager) stop(id string, cause error) bool {
+	m.mu.Lock()
+	e, ok := m.runs[id]
+	m.mu.Unlock()
+	if !ok {
+		return false
+	}
+	e.cancel(cause)
+	return true
+}
+
+// Running reports whether a session has an active run in this process.
+func (m *Manager) Running(id string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.runs[id]
+	return ok
+}
+
+// Wait blocks until the session's run finishes or ctx ends.
+// It returns immediately when nothing is running.
+func (m *Manager) Wait(ctx context.Context, id string) error {
+	m.mu.Lock()
+	e, ok := m.runs[id]
+	m.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	select {
+	case <-e.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// WaitAll blocks until every run in this process finishes or ctx ends.
+func (m *Manager) WaitAll(ctx context.Context) error {
+	m.mu.Lock()
+	ids := make([]string, 0, len(m.runs))
+	for id := range m.runs {
+		ids = append(ids, id)
+	}
+	m.mu.Unlock()
+
+	for _, id := range ids {
+		if err := m.Wait(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stopped reports whether ctx ended because its run was paused or cancelled.
+func Stopped(ctx context.Context) bool {
+	cause := context.Cause(ctx)
+	return errors.Is(cause, ErrPaused) || errors.Is(cause, ErrCancelled)
+}
+
+// StopStatus returns the status a session should be left in once ctx has
+// ended: paused or cancelled when stopped on request, failed otherwise.
+func StopStatus(ctx context.Context) core.DebateStatus {
+	switch cause := context.Cause(ctx); {
+	case errors.Is(cause, ErrPaused):
+		return core.StatusPaused
+	case errors.Is(cause, ErrCancelled):
+		return core.StatusCancelled
+	}
+	return core.StatusFailed
+}
+
+// StatusError returns the error for a session left in a stop status.
+func StatusError(status core.DebateStatus) error {
+	switch status {
+	case core.StatusPaused:
+		return ErrPaused
+	case core.StatusCancelled:
+		return ErrCancelled
+	}
+	return fmt.Errorf("run stopped: %s", status)
+}
+
+// Live reports whether a heartbeat last refreshed at beatAt belongs to a run
+// that is still alive. A zero time means the session never had one.
+func Live(beatAt, now time.Time) bool {
+	return !beatAt.IsZero() && now.Sub(beatAt) < StaleAfter
+}
@@ -0,0 +1,149 @@
+package run
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+func TestManagerPauseAndCancel(t *testing.T) {
+	tests := []struct {
+		name   string
+		stop   func(m *Manager, id string) bool
+		cause  error
+		status core.DebateStatus
+	}{
+		{"pause", (*Manager).Pause, ErrPaused, core.StatusPaused},
+		{"cancel", (*Manager).Cancel, ErrCancelled, core.StatusCancelled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil)
+			started := make(chan struct{})
+			var status core.DebateStatus
+
+			go func() {
+				<-started
+				if !tt.stop(m, "s1") {
+					t.Error("expected the run to be found")
+				}
+			}()
+
+			err := m.Run(context.Background(), "s1", func(ctx context.Context) error {
+				close(started)
+				<-ctx.Done()
+				status = StopStatus(ctx)
+				return context.Cause(ctx)
+			})
+			if !errors.Is(err, tt.cause) {
+				t.Errorf("Run() error = %v, want %v", err, tt.cause)
+			}
+			if status != tt.status {
+				t.Errorf("StopStatus() = %s, want %s", status, tt.status)
+			}
+			if m.Running("s1") {
+				t.Error("run should be unregistered after it returns")
+			}
+			if tt.stop(m, "s1") {
+				t.Error("stopping a finished run should report false")
+			}
+		})
+	}
+}
+
+func TestManagerStartAndWait(t *testing.T) {
+	m := NewManager(nil)
+	release := make(chan struct{})
+
+	if err := m.Start("s1", func(ctx context.Context) error {
+		<-release
+		return nil
+	}); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if !m.Running("s1") {
+		t.Error("run should be registered when Start returns")
+	}
+	if err := m.Start("s1", func(ctx context.Context) error { return nil }); !errors.Is(err, ErrAlreadyRunning) {
+		t.Errorf("second Start() error = %v, want ErrAlreadyRunning", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := m.Wait(ctx, "s1"); err == nil {
+		t.Error("Wait() should time out while the run is blocked")
+	}
+
+	close(release)
+	if err := m.Wait(context.Background(), "s1"); err != nil {
+		t.Errorf("Wait() error = %v", err)
+	}
+	if m.Running("s1") {
+		t.Error("run should be unregistered after Wait")
+	}
+}
+
+func TestStopStatus(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	<-ctx.Done()
+
+	if Stopped(ctx) {
+		t.Error("a timed-out context is not stopped on request")
+	}
+	if got := StopStatus(ctx); got != core.StatusFailed {
+		t.Errorf("StopStatus() = %s, want failed", got)
+	}
+	if !errors.Is(StatusError(core.StatusPaused), ErrPaused) {
+		t.Error("StatusError(paused) should be ErrPaused")
+	}
+}
+
+type fakeHeartbeats struct {
+	mu    sync.Mutex
+	beats map[string]string
+}
+
+func (f *fakeHeartbeats) Heartbeat(sessionID, owner string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.beats[sessionID] = owner
+	return nil
+}
+
+func (f *fakeHeartbeats) ClearHeartbeat(sessionID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.beats, sessionID)
+	return nil
+}
+
+func TestManagerHeartbeats(t *testing.T) {
+	beats := &fakeHeartbeats{beats: make(map[string]string)}
+	m := NewManager(beats)
+
+	err := m.Run(context.Background(), "s1", func(ctx context.Context) error {
+		beats.mu.Lock()
+		defer beats.mu.Unlock()
+		if beats.beats["s1"] != Owner() {
+			t.Errorf("heartbeat owner = %q, want %q", beats.beats["s1"], Owner())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if _, ok := beats.beats["s1"]; ok {
+		t.Error("heartbeat should be cleared when the run ends")
+	}
+
+	now := time.Now()
+	if Live(time.Time{}, now) || Live(now.Add(-StaleAfter), now) || !Live(now.Add(-HeartbeatInterval), now) {
+		t.Error("Live() should only accept heartbeats newer than StaleAfter")
+	}
+}
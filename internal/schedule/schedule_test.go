@@ -0,0 +1,198 @@
+package schedule
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+	"github.com/alienxp03/conclave/internal/council"
+	"github.com/alienxp03/conclave/internal/provider"
+	"github.com/alienxp03/conclave/internal/storage"
+	extprovider "github.com/alienxp03/conclave/provider"
+)
+
+func TestCronNext(t *testing.T) {
+	// 2026-03-04 is a Wednesday
+	from := time.Date(2026, 3, 4, 10, 17, 30, 0, time.UTC)
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"*/15 * * * *", time.Date(2026, 3, 4, 10, 30, 0, 0, time.UTC)},
+		{"0 9 * * *", time.Date(2026, 3, 5, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * MON", time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)},
+		{"30 8 * * 1-5", time.Date(2026, 3, 5, 8, 30, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 0 13 * 5", time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)},    // Either day field matches
+		{"0 9 */1 * MON", time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)}, // Unrestricted day of month: weekday only
+		{"0 9 1-31 * MON", time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)},
+		{"0 9 */2 * MON", time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)}, // Odd days that are Mondays
+		{"0 9 15 * 0-6", time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)},
+		{"0 12 29 feb *", time.Date(2028, 2, 29, 12, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, 3, 4, 11, 0, 0, 0, time.UTC)},
+		{"0 0 31 2 *", time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			cron, err := ParseCron(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCron() error = %v", err)
+			}
+			if got := cron.Next(from); !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCronErrors(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "0 0 0 * *", "* * * 13 *", "5-1 * * * *", "*/0 * * * *", "0 0 * * funday"} {
+		if _, err := ParseCron(expr); err == nil {
+			t.Errorf("ParseCron(%q) expected an error", expr)
+		}
+	}
+}
+
+func setupTestScheduler(t *testing.T) (*Scheduler, *council.Engine, storage.Storage) {
+	t.Helper()
+
+	store, err := storage.NewSQLiteStorage(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to create storage: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	if err := store.Initialize(); err != nil {
+		t.Fatalf("failed to initialize storage: %v", err)
+	}
+
+	registry := provider.NewRegistry()
+	registry.Register(provider.NewMockProvider(extprovider.Config{}))
+	councils := council.New(store, registry, nil)
+	return New(store, councils), councils, store
+}
+
+func TestCreateValidates(t *testing.T) {
+	s, _, _ := setupTestScheduler(t)
+	members := []core.MemberSpec{{Provider: "mock"}, {Provider: "mock"}}
+
+	tests := []struct {
+		name     string
+		schedule core.Schedule
+		want     string
+	}{
+		{"no topic", core.Schedule{Cron: "@daily", Members: members}, "topic is required"},
+		{"bad cron", core.Schedule{Topic: "T", Cron: "daily", Members: members}, "invalid cron"},
+		{"one member", core.Schedule{Topic: "T", Cron: "@daily", Members: members[:1]}, "at least 2 members"},
+		{"bad policy", core.Schedule{Topic: "T", Cron: "@daily", Members: members, CatchUp: "all"}, "invalid catch-up"},
+		{"missing project", core.Schedule{Topic: "T", Cron: "@daily", Members: members, ProjectID: "nope"}, "project not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.Create(&tt.schedule); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+
+	sc, err := s.Create(&core.Schedule{Topic: "Review our on-call runbook", Cron: "0 9 * * 1", Members: members})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if sc.Name != sc.Topic || sc.CatchUp != core.CatchUpOnce || sc.NextRunAt == nil || sc.NextRunAt.Weekday() != time.Monday {
+		t.Errorf("unexpected defaults: %+v", sc)
+	}
+}
+
+func TestTickCatchUpPolicies(t *testing.T) {
+	s, councils, store := setupTestScheduler(t)
+	ctx := context.Background()
+	members := []core.MemberSpec{{Provider: "mock", Persona: "optimist"}, {Provider: "mock", Persona: "skeptic"}}
+
+	due, err := s.Create(&core.Schedule{Name: "due", Topic: "Due now", Cron: "0 * * * *", Members: members})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	missedOnce, _ := s.Create(&core.Schedule{Name: "missed-once", Topic: "Missed once", Cron: "0 * * * *", Members: members})
+	missedSkip, _ := s.Create(&core.Schedule{Name: "missed-skip", Topic: "Missed skip", Cron: "0 * * * *", Members: members, CatchUp: core.CatchUpSkip})
+	paused, _ := s.Create(&core.Schedule{Name: "paused", Topic: "Paused", Cron: "0 * * * *", Members: members, Paused: true})
+
+	// due fell due a minute ago; the others several hours ago
+	now := *due.NextRunAt
+	backdate := func(sc *core.Schedule, at time.Time) {
+		sc.NextRunAt = &at
+		if err := store.UpdateSchedule(sc); err != nil {
+			t.Fatalf("UpdateSchedule() error = %v", err)
+		}
+	}
+	backdate(due, now.Add(-time.Minute))
+	backdate(missedOnce, now.Add(-3*time.Hour))
+	backdate(missedSkip, now.Add(-3*time.Hour))
+	backdate(paused, now.Add(-3*time.Hour))
+
+	s.Tick(ctx, now)
+
+	for _, tt := range []struct {
+		schedule *core.Schedule
+		wantRun  bool
+	}{{due, true}, {missedOnce, true}, {missedSkip, false}, {paused, false}} {
+		got, _ := s.Get(tt.schedule.ID)
+		runs, _ := s.Runs(got.ID, 10)
+		if tt.wantRun != (len(runs) == 1) || tt.wantRun != (got.LastCouncilID != "") {
+			t.Errorf("%s: %d runs, last council %q, want run = %v", got.Name, len(runs), got.LastCouncilID, tt.wantRun)
+			continue
+		}
+		if !got.Paused && (got.NextRunAt == nil || !got.NextRunAt.After(now)) {
+			t.Errorf("%s: next run %v not advanced past %v", got.Name, got.NextRunAt, now)
+		}
+		if tt.wantRun {
+			if err := councils.WaitCouncil(ctx, got.LastCouncilID); err != nil {
+				t.Errorf("%s: council failed: %v", got.Name, err)
+			}
+			if c, _ := store.GetCouncil(got.LastCouncilID); c == nil || c.ScheduleID != got.ID || c.Status != core.StatusCompleted {
+				t.Errorf("%s: unexpected council %+v", got.Name, c)
+			}
+		}
+	}
+
+	// A second tick at the same time starts nothing new
+	s.Tick(ctx, now)
+	if runs, _ := s.Runs(due.ID, 10); len(runs) != 1 {
+		t.Errorf("expected one run after a repeated tick, got %d", len(runs))
+	}
+}
+
+func TestPauseResumeTrigger(t *testing.T) {
+	s, councils, _ := setupTestScheduler(t)
+	ctx := context.Background()
+	sc, err := s.Create(&core.Schedule{Topic: "Weekly review", Cron: "@weekly", Members: []core.MemberSpec{{Provider: "mock"}, {Provider: "mock"}}})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if sc, err = s.Pause(sc.ID); err != nil || !sc.Paused || sc.NextRunAt != nil {
+		t.Fatalf("Pause() = %+v, %v", sc, err)
+	}
+
+	c, err := s.Trigger(ctx, sc.ID)
+	if err != nil {
+		t.Fatalf("Trigger() error = %v", err)
+	}
+	if err := councils.WaitCouncil(ctx, c.ID); err != nil {
+		t.Errorf("triggered council failed: %v", err)
+	}
+	if got, _ := s.Get(sc.ID); got.LastCouncilID != c.ID || got.LastRunAt == nil || !got.Paused {
+		t.Errorf("trigger not recorded: %+v", got)
+	}
+
+	if sc, err = s.Resume(sc.ID); err != nil || sc.Paused || sc.NextRunAt == nil || sc.NextRunAt.Weekday() != time.Sunday {
+		t.Errorf("Resume() = %+v, %v", sc, err)
+	}
+	if _, err := s.Trigger(ctx, "missing"); err == nil {
+		t.Error("expected an error triggering a missing schedule")
+	}
+}
@@ -0,0 +1,244 @@
+// Package schedule starts councils on cron schedules while the web server
+// runs, and catches up on runs missed while it was down.
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+	"github.com/alienxp03/conclave/internal/council"
+	"github.com/alienxp03/conclave/internal/storage"
+)
+
+const (
+	// PollInterval is how often Start checks for due schedules.
+	PollInterval = 30 * time.Second
+	// GracePeriod is how late a run may start before it counts as missed
+	// and the schedule's catch-up policy applies.
+	GracePeriod = 5 * time.Minute
+)
+
+// Scheduler creates schedules and starts their councils when they are due.
+type Scheduler struct {
+	storage  storage.Storage
+	councils *council.Engine
+	mu       sync.Mutex // Serializes ticks and triggers so a due run starts once
+}
+
+// New creates a scheduler.
+func New(store storage.Storage, councils *council.Engine) *Scheduler {
+	return &Scheduler{storage: store, councils: councils}
+}
+
+// Create validates and saves a schedule, filling in its ID, catch-up policy
+// and first run time.
+func (s *Scheduler) Create(sc *core.Schedule) (*core.Schedule, error) {
+	sc.Name = strings.TrimSpace(sc.Name)
+	sc.Topic = strings.TrimSpace(sc.Topic)
+	if sc.Topic == "" {
+		return nil, fmt.Errorf("topic is required")
+	}
+	if sc.Name == "" {
+		sc.Name = sc.Topic
+	}
+	cron, err := ParseCron(sc.Cron)
+	if err != nil {
+		return nil, err
+	}
+	if len(sc.Members) < 2 {
+		return nil, fmt.Errorf("a schedule needs at least 2 members, got %d", len(sc.Members))
+	}
+	switch sc.CatchUp {
+	case "":
+		sc.CatchUp = core.CatchUpOnce
+	case core.CatchUpOnce, core.CatchUpSkip:
+	default:
+		return nil, fmt.Errorf("invalid catch-up policy %q (want %s or %s)", sc.CatchUp, core.CatchUpOnce, core.CatchUpSkip)
+	}
+	if sc.ProjectID != "" {
+		project, err := s.storage.GetProject(sc.ProjectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load project: %w", err)
+		}
+		if project == nil {
+			return nil, fmt.Errorf("project not found")
+		}
+	}
+
+	now := time.Now()
+	sc.ID = core.GenerateID()
+	sc.LastRunAt, sc.LastCouncilID, sc.LastError = nil, "", ""
+	sc.NextRunAt = nil
+	if !sc.Paused {
+		sc.NextRunAt = nextRun(cron, now)
+	}
+	sc.CreatedAt, sc.UpdatedAt = now, now
+
+	if err := s.storage.CreateSchedule(sc); err != nil {
+		return nil, err
+	}
+	return sc, nil
+}
+
+// Get returns a schedule, or an error if there is none.
+func (s *Scheduler) Get(id string) (*core.Schedule, error) {
+	sc, err := s.storage.GetSchedule(id)
+	if err != nil {
+		return nil, err
+	}
+	if sc == nil {
+		return nil, fmt.Errorf("schedule not found")
+	}
+	return sc, nil
+}
+
+// Pause stops a schedule from starting councils until it is resumed.
+func (s *Scheduler) Pause(id string) (*core.Schedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sc, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	sc.Paused, sc.NextRunAt, sc.UpdatedAt = true, nil, time.Now()
+	return sc, s.storage.UpdateSchedule(sc)
+}
+
+// Resume unpauses a schedule. Runs that fell due while it was paused are
+// not caught up; the next run is the first one after now.
+func (s *Scheduler) Resume(id string) (*core.Schedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sc, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	cron, err := ParseCron(sc.Cron)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	sc.Paused, sc.NextRunAt, sc.UpdatedAt = false, nextRun(cron, now), now
+	return sc, s.storage.UpdateSchedule(sc)
+}
+
+// Trigger starts a schedule's council now, paused or not. The next
+// scheduled run is unchanged.
+func (s *Scheduler) Trigger(ctx context.Context, id string) (*core.Council, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sc, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.run(ctx, sc, time.Now())
+	if updateErr := s.storage.UpdateSchedule(sc); updateErr != nil {
+		slog.Error("Failed to save schedule run", "schedule_id", sc.ID, "error", updateErr)
+	}
+	return c, err
+}
+
+// Runs returns the councils a schedule started, newest first.
+func (s *Scheduler) Runs(id string, limit int) ([]*core.CouncilSummary, error) {
+	return s.storage.ListCouncilsBySchedule(id, limit, 0)
+}
+
+// Start runs due schedules now and then every PollInterval until ctx is
+// done. It returns immediately.
+func (s *Scheduler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(PollInterval)
+		defer ticker.Stop()
+		for {
+			s.Tick(ctx, time.Now())
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Tick starts the council of every unpaused schedule due at now. A run more
+// than GracePeriod late was missed while the server was down: it starts
+// once under CatchUpOnce, however many runs were missed, and is dropped
+// under CatchUpSkip. Either way the next run is the first one after now.
+func (s *Scheduler) Tick(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedules, err := s.storage.ListSchedules()
+	if err != nil {
+		slog.Error("Failed to list schedules", "error", err)
+		return
+	}
+
+	for _, sc := range schedules {
+		if sc.Paused || sc.NextRunAt == nil || sc.NextRunAt.After(now) {
+			continue
+		}
+		cron, err := ParseCron(sc.Cron)
+		if err != nil {
+			slog.Error("Invalid schedule", "schedule_id", sc.ID, "error", err)
+			continue
+		}
+
+		if now.Sub(*sc.NextRunAt) > GracePeriod && sc.CatchUp == core.CatchUpSkip {
+			slog.Info("Skipping missed schedule run", "schedule_id", sc.ID, "due", sc.NextRunAt)
+		} else if _, err := s.run(ctx, sc, now); err != nil {
+			slog.Error("Failed to start scheduled council", "schedule_id", sc.ID, "error", err)
+		}
+
+		sc.NextRunAt, sc.UpdatedAt = nextRun(cron, now), time.Now()
+		if err := s.storage.UpdateSchedule(sc); err != nil {
+			slog.Error("Failed to save schedule", "schedule_id", sc.ID, "error", err)
+		}
+	}
+}
+
+// run creates and starts the schedule's council, recording the outcome on
+// sc without saving it.
+func (s *Scheduler) run(ctx context.Context, sc *core.Schedule, now time.Time) (*core.Council, error) {
+	sc.LastRunAt, sc.UpdatedAt = &now, time.Now()
+
+	c, err := s.councils.CreateCouncil(ctx, core.NewCouncilConfig{
+		Topic:       sc.Topic,
+		WorkspaceID: sc.WorkspaceID,
+		ProjectID:   sc.ProjectID,
+		Members:     sc.Members,
+		Chairman:    sc.Chairman,
+		Budget:      sc.Budget,
+		ScheduleID:  sc.ID,
+	})
+	if err == nil {
+		sc.LastCouncilID = c.ID
+		err = s.councils.StartCouncil(c)
+	}
+	if err != nil {
+		sc.LastError = err.Error()
+		return c, err
+	}
+
+	slog.Info("Started scheduled council", "schedule_id", sc.ID, "council_id", c.ID)
+	sc.LastError = ""
+	return c, nil
+}
+
+// nextRun returns the first run after now, or nil if the expression never
+// matches.
+func nextRun(cron *Cron, now time.Time) *time.Time {
+	next := cron.Next(now)
+	if next.IsZero() {
+		return nil
+	}
+	return &next
+}
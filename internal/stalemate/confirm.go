@@ -0,0 +1,67 @@
+package stalemate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// GenerateFunc sends a prompt to a model and returns its reply.
+type GenerateFunc func(ctx context.Context, prompt string) (string, error)
+
+// Input is what a model sees when asked to confirm a stalemate.
+type Input struct {
+	Topic   string
+	History string // Formatted debate history
+
+	// Instructions are formatted project instructions for the model.
+	Instructions string
+}
+
+// Confirm asks a model whether the latest rotations of a debate added new
+// arguments. It reports a stalemate only when the model clearly says none
+// were made.
+func Confirm(ctx context.Context, generate GenerateFunc, in Input) (bool, string, error) {
+	instructionBlock := ""
+	if in.Instructions != "" {
+		instructionBlock = "\n\n" + in.Instructions
+	}
+
+	prompt := fmt.Sprintf(`You are reviewing a debate on: "%s"%s
+
+Discussion so far:
+%s
+
+In the last two rounds, did any participant add a new argument, piece of evidence or concession, or did they only restate points they had already made?
+
+Respond with ONLY a JSON object in this exact shape:
+{"new_arguments": true or false, "rationale": "<one sentence>"}`, in.Topic, instructionBlock, in.History)
+
+	response, err := generate(ctx, prompt)
+	if err != nil {
+		return false, "", err
+	}
+
+	start := strings.Index(response, "{")
+	end := strings.LastIndex(response, "}")
+	if start < 0 || end < start {
+		return false, "", fmt.Errorf("no JSON object in reply")
+	}
+	var reply struct {
+		NewArguments *bool  `json:"new_arguments"`
+		Rationale    string `json:"rationale"`
+	}
+	if err := json.Unmarshal([]byte(response[start:end+1]), &reply); err != nil {
+		return false, "", fmt.Errorf("invalid JSON: %w", err)
+	}
+	if reply.NewArguments == nil {
+		return false, "", fmt.Errorf("new_arguments is required")
+	}
+
+	rationale := strings.TrimSpace(reply.Rationale)
+	if rationale == "" {
+		rationale = "The reviewer gave no rationale."
+	}
+	return !*reply.NewArguments, rationale, nil
+}
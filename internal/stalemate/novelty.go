@@ -0,0 +1,86 @@
+// Package stalemate detects debates going in circles: agents restating
+// their earlier points instead of adding new ones.
+package stalemate
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// DefaultThreshold is the novelty a rotation needs to count as progress
+// when the debate does not configure one.
+const DefaultThreshold = 0.3
+
+// ngramSize is the length of the word sequences compared between turns.
+const ngramSize = 3
+
+// Measure scores how much content adds over earlier turns by the same
+// agent. An empty turn scores 0.
+func Measure(content string, earlier []string) core.Novelty {
+	grams := ngrams(content)
+	if len(grams) == 0 {
+		return core.Novelty{}
+	}
+
+	seen := make(map[string]bool)
+	var overlap float64
+	for _, prev := range earlier {
+		prevGrams := ngrams(prev)
+		shared := 0
+		for g := range grams {
+			if prevGrams[g] {
+				shared++
+			}
+		}
+		if o := float64(shared) / float64(len(grams)); o > overlap {
+			overlap = o
+		}
+		for g := range prevGrams {
+			seen[g] = true
+		}
+	}
+
+	fresh := 0
+	for g := range grams {
+		if !seen[g] {
+			fresh++
+		}
+	}
+	return core.Novelty{
+		Score:   float64(fresh) / float64(len(grams)),
+		Overlap: overlap,
+		NGrams:  len(grams),
+	}
+}
+
+// RotationNovelty returns the highest novelty among a rotation's turns: a
+// rotation only adds nothing new if no agent did. Turns that were never
+// measured, such as human turns, count as new.
+func RotationNovelty(turns []*core.Turn) float64 {
+	var best float64
+	for _, t := range turns {
+		if t.Novelty == nil {
+			return 1
+		}
+		if t.Novelty.Score > best {
+			best = t.Novelty.Score
+		}
+	}
+	return best
+}
+
+// ngrams returns the distinct word trigrams in text, ignoring case and
+// punctuation. Text shorter than a trigram is a single n-gram.
+func ngrams(text string) map[string]bool {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	})
+	size := min(ngramSize, len(words))
+	grams := make(map[string]bool)
+	for i := 0; size > 0 && i+size <= len(words); i++ {
+		grams[strings.Join(words[i:i+size], " ")] = true
+	}
+	return grams
+}
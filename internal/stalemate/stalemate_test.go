@@ -0,0 +1,88 @@
+package stalemate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+func TestMeasure(t *testing.T) {
+	earlier := []string{
+		"Spaces keep indentation consistent across every editor.",
+		"Reviewers should never have to guess the tab width.",
+	}
+
+	tests := []struct {
+		name        string
+		content     string
+		earlier     []string
+		wantScore   float64
+		wantOverlap float64
+	}{
+		{"first turn", "Spaces keep indentation consistent.", nil, 1, 0},
+		{"verbatim repeat", "Spaces keep indentation consistent across every editor!", earlier, 0, 1},
+		{"case and punctuation ignored", "SPACES, keep indentation; consistent across every editor", earlier, 0, 1},
+		// 2 of the 5 trigrams were used before
+		{"partial repeat", "Spaces keep indentation consistent in large monorepos", earlier, 0.6, 0.4},
+		{"new point", "Tabs let each developer pick their own width.", earlier, 1, 0},
+		{"empty", "", earlier, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Measure(tt.content, tt.earlier)
+			if got.Score != tt.wantScore || got.Overlap != tt.wantOverlap {
+				t.Errorf("Measure() = %+v, want score %v and overlap %v", got, tt.wantScore, tt.wantOverlap)
+			}
+		})
+	}
+
+	// Overlap is against the closest single turn; the score is against all of them
+	spread := Measure("keep indentation consistent never have to", []string{"keep indentation consistent", "never have to"})
+	if spread.Overlap != 0.25 || spread.Score != 0.5 {
+		t.Errorf("Measure() across turns = %+v, want overlap 0.25 and score 0.5", spread)
+	}
+}
+
+func TestRotationNovelty(t *testing.T) {
+	turn := func(score float64) *core.Turn { return &core.Turn{Novelty: &core.Novelty{Score: score}} }
+
+	if got := RotationNovelty([]*core.Turn{turn(0.1), turn(0.4)}); got != 0.4 {
+		t.Errorf("RotationNovelty() = %v, want the highest score 0.4", got)
+	}
+	if got := RotationNovelty([]*core.Turn{turn(0.1), {}}); got != 1 {
+		t.Errorf("RotationNovelty() with an unmeasured turn = %v, want 1", got)
+	}
+}
+
+func TestConfirm(t *testing.T) {
+	tests := []struct {
+		name      string
+		reply     string
+		err       error
+		wantStale bool
+		wantErr   bool
+	}{
+		{"no new arguments", `{"new_arguments": false, "rationale": "Both repeat themselves."}`, nil, true, false},
+		{"new arguments", "Sure:\n{\"new_arguments\": true, \"rationale\": \"B raised cost.\"}", nil, false, false},
+		{"missing field", `{"rationale": "Hard to say."}`, nil, false, true},
+		{"not JSON", "They are repeating themselves.", nil, false, true},
+		{"model error", "", errors.New("offline"), false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generate := func(context.Context, string) (string, error) { return tt.reply, tt.err }
+			stale, rationale, err := Confirm(context.Background(), generate, Input{Topic: "Tabs or spaces?"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Confirm() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if stale != tt.wantStale {
+				t.Errorf("stale = %v, want %v", stale, tt.wantStale)
+			}
+			if err == nil && rationale == "" {
+				t.Error("expected a rationale")
+			}
+		})
+	}
+}
@@ -31,7 +31,9 @@ func NewSQLiteStorage(dbPath string) (*SQLiteStorage, error) {
 		}
 	}
 
-	db, err := sql.Open("sqlite3", dbPath+"?_foreign_keys=on")
+	// Concurrent runs (such as tournament workers) wait for the write lock
+	// instead of failing with "database is locked"
+	db, err := sql.Open("sqlite3", dbPath+"?_foreign_keys=on&_busy_timeout=5000")
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -159,7 +161,108 @@ func (s *SQLiteStorage) Initialize() error {
 		FOREIGN KEY (reviewer_id) REFERENCES council_members(id) ON DELETE CASCADE
 	);
 
+	CREATE TABLE IF NOT EXISTS stop_requests (
+		session_id TEXT PRIMARY KEY,
+		status TEXT NOT NULL,
+		created_at DATETIME NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS run_heartbeats (
+		session_id TEXT PRIMARY KEY,
+		owner TEXT NOT NULL,
+		beat_at DATETIME NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS tournaments (
+		id TEXT PRIMARY KEY,
+		name TEXT NOT NULL,
+		topics_json TEXT NOT NULL,
+		entrants_json TEXT NOT NULL,
+		judge_json TEXT NOT NULL,
+		format TEXT NOT NULL DEFAULT 'round_robin',
+		style TEXT NOT NULL,
+		max_turns INTEGER NOT NULL,
+		workers INTEGER NOT NULL DEFAULT 1,
+		status TEXT NOT NULL DEFAULT 'pending',
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		completed_at DATETIME
+	);
+
+	CREATE TABLE IF NOT EXISTS tournament_matches (
+		id TEXT PRIMARY KEY,
+		tournament_id TEXT NOT NULL,
+		round INTEGER NOT NULL DEFAULT 1,
+		topic TEXT NOT NULL,
+		entrant_a TEXT NOT NULL,
+		entrant_b TEXT NOT NULL,
+		debate_id TEXT NOT NULL DEFAULT '',
+		status TEXT NOT NULL DEFAULT 'pending',
+		outcome TEXT NOT NULL DEFAULT '',
+		winner TEXT NOT NULL DEFAULT '',
+		error TEXT NOT NULL DEFAULT '',
+		created_at DATETIME NOT NULL,
+		completed_at DATETIME,
+		FOREIGN KEY (tournament_id) REFERENCES tournaments(id) ON DELETE CASCADE
+	);
+
+	CREATE TABLE IF NOT EXISTS ratings (
+		entrant TEXT PRIMARY KEY,
+		rating REAL NOT NULL,
+		matches INTEGER NOT NULL DEFAULT 0,
+		wins INTEGER NOT NULL DEFAULT 0,
+		losses INTEGER NOT NULL DEFAULT 0,
+		draws INTEGER NOT NULL DEFAULT 0,
+		updated_at DATETIME NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS presets (
+		id TEXT PRIMARY KEY,
+		name TEXT NOT NULL UNIQUE,
+		description TEXT NOT NULL DEFAULT '',
+		mode TEXT NOT NULL DEFAULT 'council',
+		members_json TEXT NOT NULL DEFAULT '',
+		chairman_json TEXT NOT NULL DEFAULT '',
+		style TEXT NOT NULL DEFAULT '',
+		max_turns INTEGER NOT NULL DEFAULT 0,
+		project_id TEXT NOT NULL DEFAULT '',
+		budget_json TEXT NOT NULL DEFAULT '',
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS schedules (
+		id TEXT PRIMARY KEY,
+		name TEXT NOT NULL,
+		cron TEXT NOT NULL,
+		topic TEXT NOT NULL,
+		members_json TEXT NOT NULL DEFAULT '',
+		chairman_json TEXT NOT NULL DEFAULT '',
+		workspace_id TEXT NOT NULL DEFAULT '',
+		project_id TEXT NOT NULL DEFAULT '',
+		budget_json TEXT NOT NULL DEFAULT '',
+		catch_up TEXT NOT NULL DEFAULT 'once',
+		paused INTEGER NOT NULL DEFAULT 0,
+		last_run_at DATETIME,
+		next_run_at DATETIME,
+		last_council_id TEXT NOT NULL DEFAULT '',
+		last_error TEXT NOT NULL DEFAULT '',
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS turn_arguments (
+		turn_id TEXT PRIMARY KEY,
+		debate_id TEXT NOT NULL,
+		turn_number INTEGER NOT NULL,
+		claims_json TEXT NOT NULL DEFAULT '',
+		created_at DATETIME NOT NULL,
+		FOREIGN KEY (turn_id) REFERENCES turns(id) ON DELETE CASCADE,
+		FOREIGN KEY (debate_id) REFERENCES debates(id) ON DELETE CASCADE
+	);
+
 	CREATE INDEX IF NOT EXISTS idx_turns_debate_id ON turns(debate_id);
+	CREATE INDEX IF NOT EXISTS idx_turn_arguments_debate_id ON turn_arguments(debate_id);
 	CREATE INDEX IF NOT EXISTS idx_debates_status ON debates(status);
 	CREATE INDEX IF NOT EXISTS idx_debates_created_at ON debates(created_at DESC);
 	CREATE INDEX IF NOT EXISTS idx_personas_is_builtin ON personas(is_builtin);
@@ -170,6 +273,9 @@ func (s *SQLiteStorage) Initialize() error {
 	CREATE INDEX IF NOT EXISTS idx_councils_status ON councils(status);
 	CREATE INDEX IF NOT EXISTS idx_councils_created_at ON councils(created_at DESC);
 	CREATE INDEX IF NOT EXISTS idx_projects_updated_at ON projects(updated_at DESC);
+	CREATE INDEX IF NOT EXISTS idx_tournaments_created_at ON tournaments(created_at DESC);
+	CREATE INDEX IF NOT EXISTS idx_tournament_matches_tournament_id ON tournament_matches(tournament_id);
+	CREATE INDEX IF NOT EXISTS idx_ratings_rating ON ratings(rating DESC);
 	`
 
 	_, err := s.db.Exec(schema)
@@ -200,6 +306,39 @@ func (s *SQLiteStorage) migrate() {
 	// Add title column if not exists
 	s.db.Exec("ALTER TABLE debates ADD COLUMN title TEXT NOT NULL DEFAULT 'New conversation'")
 	s.db.Exec("ALTER TABLE councils ADD COLUMN title TEXT NOT NULL DEFAULT 'New conversation'")
+	// Add N-agent debate columns (two-agent rows fall back to agent_a_json/agent_b_json)
+	s.db.Exec("ALTER TABLE debates ADD COLUMN agents_json TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE debates ADD COLUMN speaking_order TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE debates ADD COLUMN judge_json TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE debates ADD COLUMN moderator_json TEXT NOT NULL DEFAULT ''")
+	// Add consensus detection columns if not exists
+	s.db.Exec("ALTER TABLE debates ADD COLUMN consensus_json TEXT NOT NULL DEFAULT ''")
+	// Add multi-option vote column if not exists
+	s.db.Exec("ALTER TABLE debates ADD COLUMN vote_json TEXT NOT NULL DEFAULT ''")
+	// Add stalemate detection column if not exists
+	s.db.Exec("ALTER TABLE debates ADD COLUMN stalemate_json TEXT NOT NULL DEFAULT ''")
+	// Add steelman step column if not exists
+	s.db.Exec("ALTER TABLE debates ADD COLUMN steelman_json TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE styles ADD COLUMN consensus_method TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE styles ADD COLUMN consensus_threshold REAL NOT NULL DEFAULT 0")
+	// Add multi-phase style column if not exists
+	s.db.Exec("ALTER TABLE styles ADD COLUMN phases_json TEXT NOT NULL DEFAULT ''")
+	// Add fork lineage columns if not exists
+	s.db.Exec("ALTER TABLE debates ADD COLUMN parent_id TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE debates ADD COLUMN fork_point INTEGER NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE councils ADD COLUMN parent_id TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE councils ADD COLUMN fork_point INTEGER NOT NULL DEFAULT 0")
+	// Add swap-sides lineage column if not exists
+	s.db.Exec("ALTER TABLE debates ADD COLUMN swap_of TEXT NOT NULL DEFAULT ''")
+	// Add budget columns if not exists
+	s.db.Exec("ALTER TABLE debates ADD COLUMN budget_json TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE debates ADD COLUMN stop_reason TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE councils ADD COLUMN budget_json TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE councils ADD COLUMN stop_reason TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE projects ADD COLUMN budget_json TEXT NOT NULL DEFAULT ''")
+	// Add schedule link column if not exists
+	s.db.Exec("ALTER TABLE councils ADD COLUMN schedule_id TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("CREATE INDEX IF NOT EXISTS idx_councils_schedule_id ON councils(schedule_id)")
 
 	// Add round column if not exists
 	s.db.Exec("ALTER TABLE turns ADD COLUMN round INTEGER NOT NULL DEFAULT 1")
@@ -214,6 +353,18 @@ func (s *SQLiteStorage) migrate() {
 	s.db.Exec("ALTER TABLE turns ADD COLUMN duration_ms INTEGER NOT NULL DEFAULT 0")
 	s.db.Exec("ALTER TABLE turns ADD COLUMN model TEXT NOT NULL DEFAULT ''")
 	s.db.Exec("ALTER TABLE turns ADD COLUMN stop_reason TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE turns ADD COLUMN tokens_estimated INTEGER NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE turns ADD COLUMN input_estimated INTEGER NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE turns ADD COLUMN output_estimated INTEGER NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE turns ADD COLUMN status TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE turns ADD COLUMN error TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE turns ADD COLUMN versions_json TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE turns ADD COLUMN selected_version INTEGER NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE turns ADD COLUMN cost_usd REAL NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE turns ADD COLUMN novelty_json TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE turns ADD COLUMN steelman_json TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE turns ADD COLUMN references_json TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE turns ADD COLUMN targeting_json TEXT NOT NULL DEFAULT ''")
 
 	// Add metadata columns to responses table for council usage tracking
 	s.db.Exec("ALTER TABLE responses ADD COLUMN response_type TEXT NOT NULL DEFAULT 'response'")
@@ -223,6 +374,27 @@ func (s *SQLiteStorage) migrate() {
 	s.db.Exec("ALTER TABLE responses ADD COLUMN duration_ms INTEGER NOT NULL DEFAULT 0")
 	s.db.Exec("ALTER TABLE responses ADD COLUMN model TEXT NOT NULL DEFAULT ''")
 	s.db.Exec("ALTER TABLE responses ADD COLUMN stop_reason TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE responses ADD COLUMN tokens_estimated INTEGER NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE responses ADD COLUMN input_estimated INTEGER NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE responses ADD COLUMN output_estimated INTEGER NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE responses ADD COLUMN versions_json TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE responses ADD COLUMN selected_version INTEGER NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE responses ADD COLUMN cost_usd REAL NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE responses ADD COLUMN references_json TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE responses ADD COLUMN targeting_json TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE responses ADD COLUMN question TEXT NOT NULL DEFAULT ''")
+
+	// Add metadata columns to rankings table so budgets count every stage
+	s.db.Exec("ALTER TABLE rankings ADD COLUMN input_tokens INTEGER NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE rankings ADD COLUMN output_tokens INTEGER NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE rankings ADD COLUMN total_tokens INTEGER NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE rankings ADD COLUMN duration_ms INTEGER NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE rankings ADD COLUMN model TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE rankings ADD COLUMN stop_reason TEXT NOT NULL DEFAULT ''")
+	s.db.Exec("ALTER TABLE rankings ADD COLUMN tokens_estimated INTEGER NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE rankings ADD COLUMN input_estimated INTEGER NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE rankings ADD COLUMN output_estimated INTEGER NOT NULL DEFAULT 0")
+	s.db.Exec("ALTER TABLE rankings ADD COLUMN cost_usd REAL NOT NULL DEFAULT 0")
 
 	// Fix responses table constraint (remove member_id foreign key)
 	// Check if constraint exists by checking schema
@@ -263,6 +435,50 @@ func (s *SQLiteStorage) Close() error {
 
 // CreateDebate creates a new debate.
 func (s *SQLiteStorage) CreateDebate(debate *core.Debate) error {
+	if len(debate.Agents) > 0 {
+		debate.SetParticipants(debate.Agents)
+	}
+
+	agentsJSON, err := json.Marshal(debate.Participants())
+	if err != nil {
+		return fmt.Errorf("failed to marshal agents: %w", err)
+	}
+
+	judgeJSON, err := marshalOptionalAgent(debate.Judge, "judge")
+	if err != nil {
+		return err
+	}
+
+	moderatorJSON, err := marshalOptionalAgent(debate.Moderator, "moderator")
+	if err != nil {
+		return err
+	}
+
+	budgetJSON, err := marshalBudget(debate.Budget)
+	if err != nil {
+		return err
+	}
+
+	consensusJSON, err := marshalConsensus(debate.Consensus)
+	if err != nil {
+		return err
+	}
+
+	voteJSON, err := marshalVoteConfig(debate.Vote)
+	if err != nil {
+		return err
+	}
+
+	stalemateJSON, err := marshalStalemateConfig(debate.Stalemate)
+	if err != nil {
+		return err
+	}
+
+	steelmanJSON, err := marshalSteelmanConfig(debate.Steelman)
+	if err != nil {
+		return err
+	}
+
 	agentAJSON, err := json.Marshal(debate.AgentA)
 	if err != nil {
 		return fmt.Errorf("failed to marshal agent A: %w", err)
@@ -288,8 +504,8 @@ func (s *SQLiteStorage) CreateDebate(debate *core.Debate) error {
 	}
 
 	query := `
-	INSERT INTO debates (id, title, topic, cwd, project_id, project_instructions, agent_a_json, agent_b_json, style, max_turns, status, read_only, conclusion_json, created_at, updated_at, completed_at)
-	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	INSERT INTO debates (id, title, topic, cwd, project_id, project_instructions, agent_a_json, agent_b_json, agents_json, speaking_order, judge_json, moderator_json, consensus_json, vote_json, stalemate_json, steelman_json, budget_json, stop_reason, parent_id, fork_point, swap_of, style, max_turns, status, read_only, conclusion_json, created_at, updated_at, completed_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	readOnly := 0
@@ -306,6 +522,19 @@ func (s *SQLiteStorage) CreateDebate(debate *core.Debate) error {
 		debate.ProjectInstructions,
 		string(agentAJSON),
 		string(agentBJSON),
+		string(agentsJSON),
+		debate.SpeakingOrder,
+		judgeJSON,
+		moderatorJSON,
+		consensusJSON,
+		voteJSON,
+		stalemateJSON,
+		steelmanJSON,
+		budgetJSON,
+		debate.StopReason,
+		debate.ParentID,
+		debate.ForkPoint,
+		debate.SwapOf,
 		debate.Style,
 		debate.MaxTurns,
 		debate.Status,
@@ -326,13 +555,13 @@ func (s *SQLiteStorage) CreateDebate(debate *core.Debate) error {
 // GetDebate retrieves a debate by ID.
 func (s *SQLiteStorage) GetDebate(id string) (*core.Debate, error) {
 	query := `
-	SELECT id, title, topic, cwd, project_id, project_instructions, agent_a_json, agent_b_json, style, max_turns, status, read_only, conclusion_json, created_at, updated_at, completed_at
+	SELECT id, title, topic, cwd, project_id, project_instructions, agent_a_json, agent_b_json, agents_json, speaking_order, judge_json, moderator_json, consensus_json, vote_json, stalemate_json, steelman_json, budget_json, stop_reason, parent_id, fork_point, swap_of, style, max_turns, status, read_only, conclusion_json, created_at, updated_at, completed_at
 	FROM debates
 	WHERE id = ?
 	`
 
 	var debate core.Debate
-	var agentAJSON, agentBJSON string
+	var agentAJSON, agentBJSON, agentsJSON, judgeJSON, moderatorJSON, consensusJSON, voteJSON, stalemateJSON, steelmanJSON, budgetJSON string
 	var conclusionsJSON sql.NullString
 	var completedAt sql.NullTime
 	var readOnly int
@@ -346,6 +575,19 @@ func (s *SQLiteStorage) GetDebate(id string) (*core.Debate, error) {
 		&debate.ProjectInstructions,
 		&agentAJSON,
 		&agentBJSON,
+		&agentsJSON,
+		&debate.SpeakingOrder,
+		&judgeJSON,
+		&moderatorJSON,
+		&consensusJSON,
+		&voteJSON,
+		&stalemateJSON,
+		&steelmanJSON,
+		&budgetJSON,
+		&debate.StopReason,
+		&debate.ParentID,
+		&debate.ForkPoint,
+		&debate.SwapOf,
 		&debate.Style,
 		&debate.MaxTurns,
 		&debate.Status,
@@ -406,6 +648,69 @@ func (s *SQLiteStorage) GetDebate(id string) (*core.Debate, error) {
 		}
 	}
 
+	// Debates created before N-agent support only store agent A and B
+	if agentsJSON != "" {
+		var agents []core.Agent
+		if err := json.Unmarshal([]byte(agentsJSON), &agents); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal agents: %w", err)
+		}
+		debate.SetParticipants(agents)
+	} else {
+		debate.SetParticipants([]core.Agent{debate.AgentA, debate.AgentB})
+	}
+
+	if judgeJSON != "" {
+		var judge core.Agent
+		if err := json.Unmarshal([]byte(judgeJSON), &judge); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal judge: %w", err)
+		}
+		debate.Judge = &judge
+	}
+
+	if moderatorJSON != "" {
+		var moderator core.Agent
+		if err := json.Unmarshal([]byte(moderatorJSON), &moderator); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal moderator: %w", err)
+		}
+		debate.Moderator = &moderator
+	}
+
+	if consensusJSON != "" {
+		var consensus core.ConsensusConfig
+		if err := json.Unmarshal([]byte(consensusJSON), &consensus); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal consensus config: %w", err)
+		}
+		debate.Consensus = &consensus
+	}
+
+	if voteJSON != "" {
+		var vote core.VoteConfig
+		if err := json.Unmarshal([]byte(voteJSON), &vote); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal vote config: %w", err)
+		}
+		debate.Vote = &vote
+	}
+
+	if stalemateJSON != "" {
+		var stalemate core.StalemateConfig
+		if err := json.Unmarshal([]byte(stalemateJSON), &stalemate); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal stalemate config: %w", err)
+		}
+		debate.Stalemate = &stalemate
+	}
+
+	if steelmanJSON != "" {
+		var steelman core.SteelmanConfig
+		if err := json.Unmarshal([]byte(steelmanJSON), &steelman); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal steelman config: %w", err)
+		}
+		debate.Steelman = &steelman
+	}
+
+	if debate.Budget, err = unmarshalBudget(budgetJSON); err != nil {
+		return nil, err
+	}
+
 	if completedAt.Valid {
 		debate.CompletedAt = &completedAt.Time
 	}
@@ -426,6 +731,50 @@ func (s *SQLiteStorage) UpdateDebate(debate *core.Debate) error {
 		}
 	}
 
+	if len(debate.Agents) > 0 {
+		debate.SetParticipants(debate.Agents)
+	}
+
+	agentsJSON, err := json.Marshal(debate.Participants())
+	if err != nil {
+		return fmt.Errorf("failed to marshal agents: %w", err)
+	}
+
+	judgeJSON, err := marshalOptionalAgent(debate.Judge, "judge")
+	if err != nil {
+		return err
+	}
+
+	moderatorJSON, err := marshalOptionalAgent(debate.Moderator, "moderator")
+	if err != nil {
+		return err
+	}
+
+	budgetJSON, err := marshalBudget(debate.Budget)
+	if err != nil {
+		return err
+	}
+
+	consensusJSON, err := marshalConsensus(debate.Consensus)
+	if err != nil {
+		return err
+	}
+
+	voteJSON, err := marshalVoteConfig(debate.Vote)
+	if err != nil {
+		return err
+	}
+
+	stalemateJSON, err := marshalStalemateConfig(debate.Stalemate)
+	if err != nil {
+		return err
+	}
+
+	steelmanJSON, err := marshalSteelmanConfig(debate.Steelman)
+	if err != nil {
+		return err
+	}
+
 	agentAJSON, err := json.Marshal(debate.AgentA)
 	if err != nil {
 		return fmt.Errorf("failed to marshal agent A: %w", err)
@@ -455,7 +804,7 @@ func (s *SQLiteStorage) UpdateDebate(debate *core.Debate) error {
 
 	query := `
 	UPDATE debates
-	SET title = ?, topic = ?, cwd = ?, project_id = ?, project_instructions = ?, agent_a_json = ?, agent_b_json = ?, style = ?, max_turns = ?, status = ?, read_only = ?, conclusion_json = ?, updated_at = ?, completed_at = ?
+	SET title = ?, topic = ?, cwd = ?, project_id = ?, project_instructions = ?, agent_a_json = ?, agent_b_json = ?, agents_json = ?, speaking_order = ?, judge_json = ?, moderator_json = ?, consensus_json = ?, vote_json = ?, stalemate_json = ?, steelman_json = ?, budget_json = ?, stop_reason = ?, parent_id = ?, fork_point = ?, swap_of = ?, style = ?, max_turns = ?, status = ?, read_only = ?, conclusion_json = ?, updated_at = ?, completed_at = ?
 	WHERE id = ?
 	`
 
@@ -467,6 +816,19 @@ func (s *SQLiteStorage) UpdateDebate(debate *core.Debate) error {
 		debate.ProjectInstructions,
 		string(agentAJSON),
 		string(agentBJSON),
+		string(agentsJSON),
+		debate.SpeakingOrder,
+		judgeJSON,
+		moderatorJSON,
+		consensusJSON,
+		voteJSON,
+		stalemateJSON,
+		steelmanJSON,
+		budgetJSON,
+		debate.StopReason,
+		debate.ParentID,
+		debate.ForkPoint,
+		debate.SwapOf,
 		debate.Style,
 		debate.MaxTurns,
 		debate.Status,
@@ -514,7 +876,7 @@ func (s *SQLiteStorage) DeleteDebate(id string) error {
 // ListDebates returns a list of debate summaries.
 func (s *SQLiteStorage) ListDebates(limit, offset int) ([]*core.DebateSummary, error) {
 	query := `
-	SELECT d.id, d.title, d.topic, d.cwd, d.project_id, d.status, d.style, d.read_only, d.agent_a_json, d.agent_b_json, d.created_at,
+	SELECT d.id, d.title, d.topic, d.cwd, d.project_id, d.status, d.style, d.read_only, d.agent_a_json, d.agent_b_json, d.agents_json, d.created_at,
 		   (SELECT COUNT(*) FROM turns WHERE debate_id = d.id) as turn_count
 	FROM debates d
 	ORDER BY d.created_at DESC
@@ -530,7 +892,7 @@ func (s *SQLiteStorage) ListDebates(limit, offset int) ([]*core.DebateSummary, e
 	var summaries []*core.DebateSummary
 	for rows.Next() {
 		var summary core.DebateSummary
-		var agentAJSON, agentBJSON string
+		var agentAJSON, agentBJSON, agentsJSON string
 		var readOnly int
 
 		err := rows.Scan(
@@ -544,6 +906,7 @@ func (s *SQLiteStorage) ListDebates(limit, offset int) ([]*core.DebateSummary, e
 			&readOnly,
 			&agentAJSON,
 			&agentBJSON,
+			&agentsJSON,
 			&summary.CreatedAt,
 			&summary.TurnCount,
 		)
@@ -557,6 +920,7 @@ func (s *SQLiteStorage) ListDebates(limit, offset int) ([]*core.DebateSummary, e
 
 		summary.AgentA = fmt.Sprintf("%s:%s", agentA.Provider, agentA.Persona)
 		summary.AgentB = fmt.Sprintf("%s:%s", agentB.Provider, agentB.Persona)
+		summary.AgentCount = countAgents(agentsJSON)
 
 		summary.ReadOnly = readOnly == 1
 
@@ -569,7 +933,7 @@ func (s *SQLiteStorage) ListDebates(limit, offset int) ([]*core.DebateSummary, e
 // ListDebatesByProject returns debate summaries for a specific project.
 func (s *SQLiteStorage) ListDebatesByProject(projectID string, limit, offset int) ([]*core.DebateSummary, error) {
 	query := `
-	SELECT d.id, d.title, d.topic, d.cwd, d.project_id, d.status, d.style, d.read_only, d.agent_a_json, d.agent_b_json, d.created_at,
+	SELECT d.id, d.title, d.topic, d.cwd, d.project_id, d.status, d.style, d.read_only, d.agent_a_json, d.agent_b_json, d.agents_json, d.created_at,
 		   (SELECT COUNT(*) FROM turns WHERE debate_id = d.id) as turn_count
 	FROM debates d
 	WHERE d.project_id = ?
@@ -586,7 +950,7 @@ func (s *SQLiteStorage) ListDebatesByProject(projectID string, limit, offset int
 	var summaries []*core.DebateSummary
 	for rows.Next() {
 		var summary core.DebateSummary
-		var agentAJSON, agentBJSON string
+		var agentAJSON, agentBJSON, agentsJSON string
 		var readOnly int
 
 		err := rows.Scan(
@@ -600,6 +964,7 @@ func (s *SQLiteStorage) ListDebatesByProject(projectID string, limit, offset int
 			&readOnly,
 			&agentAJSON,
 			&agentBJSON,
+			&agentsJSON,
 			&summary.CreatedAt,
 			&summary.TurnCount,
 		)
@@ -613,6 +978,7 @@ func (s *SQLiteStorage) ListDebatesByProject(projectID string, limit, offset int
 
 		summary.AgentA = fmt.Sprintf("%s:%s", agentA.Provider, agentA.Persona)
 		summary.AgentB = fmt.Sprintf("%s:%s", agentB.Provider, agentB.Persona)
+		summary.AgentCount = countAgents(agentsJSON)
 		summary.ReadOnly = readOnly == 1
 
 		summaries = append(summaries, &summary)
@@ -621,6 +987,99 @@ func (s *SQLiteStorage) ListDebatesByProject(projectID string, limit, offset int
 	return summaries, nil
 }
 
+// marshalOptionalAgent encodes a debate's judge or moderator; debates
+// without one store an empty string.
+func marshalOptionalAgent(agent *core.Agent, role string) (string, error) {
+	if agent == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(agent)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal %s: %w", role, err)
+	}
+	return string(data), nil
+}
+
+// marshalConsensus encodes a debate's consensus config; debates using their
+// style's detection store an empty string.
+func marshalConsensus(cfg *core.ConsensusConfig) (string, error) {
+	if cfg == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal consensus config: %w", err)
+	}
+	return string(data), nil
+}
+
+func marshalVoteConfig(cfg *core.VoteConfig) (string, error) {
+	if cfg == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal vote config: %w", err)
+	}
+	return string(data), nil
+}
+
+func marshalStalemateConfig(cfg *core.StalemateConfig) (string, error) {
+	if cfg == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal stalemate config: %w", err)
+	}
+	return string(data), nil
+}
+
+func marshalSteelmanConfig(cfg *core.SteelmanConfig) (string, error) {
+	if cfg == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal steelman config: %w", err)
+	}
+	return string(data), nil
+}
+
+// marshalBudget encodes a session or project budget; no budget stores an empty string.
+func marshalBudget(budget *core.Budget) (string, error) {
+	if budget.IsZero() {
+		return "", nil
+	}
+	data, err := json.Marshal(budget)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal budget: %w", err)
+	}
+	return string(data), nil
+}
+
+// unmarshalBudget decodes a budget stored by marshalBudget.
+func unmarshalBudget(data string) (*core.Budget, error) {
+	if data == "" {
+		return nil, nil
+	}
+	var budget core.Budget
+	if err := json.Unmarshal([]byte(data), &budget); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal budget: %w", err)
+	}
+	return &budget, nil
+}
+
+// countAgents returns the number of participants stored in agents_json.
+// Rows without it are two-agent debates.
+func countAgents(agentsJSON string) int {
+	var agents []core.Agent
+	if agentsJSON == "" || json.Unmarshal([]byte(agentsJSON), &agents) != nil || len(agents) == 0 {
+		return 2
+	}
+	return len(agents)
+}
+
 // SetReadOnly sets the read-only flag for a debate.
 func (s *SQLiteStorage) SetReadOnly(id string, readOnly bool) error {
 	val := 0
@@ -639,8 +1098,10 @@ func (s *SQLiteStorage) SetReadOnly(id string, readOnly bool) error {
 func (s *SQLiteStorage) AddTurn(turn *core.Turn) error {
 	query := `
 	INSERT INTO turns (id, debate_id, agent_id, number, round, content, created_at,
-		turn_type, input_tokens, output_tokens, total_tokens, duration_ms, model, stop_reason)
-	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		turn_type, input_tokens, output_tokens, total_tokens, duration_ms, model, stop_reason, tokens_estimated, input_estimated, output_estimated,
+		status, error, versions_json, selected_version, cost_usd, novelty_json, steelman_json, references_json,
+		targeting_json)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	if turn.Round == 0 {
@@ -652,7 +1113,28 @@ func (s *SQLiteStorage) AddTurn(turn *core.Turn) error {
 		turnType = string(core.TurnTypeDebate)
 	}
 
-	_, err := s.db.Exec(query,
+	versionsJSON, err := marshalVersions(turn.Versions)
+	if err != nil {
+		return err
+	}
+	noveltyJSON, err := marshalNovelty(turn.Novelty)
+	if err != nil {
+		return err
+	}
+	steelmanJSON, err := marshalSteelmanScore(turn.Steelman)
+	if err != nil {
+		return err
+	}
+	referencesJSON, err := marshalReferences(turn.References)
+	if err != nil {
+		return err
+	}
+	targetingJSON, err := marshalTargeting(turn.Targeting)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(query,
 		turn.ID,
 		turn.DebateID,
 		turn.AgentID,
@@ -667,6 +1149,18 @@ func (s *SQLiteStorage) AddTurn(turn *core.Turn) error {
 		turn.DurationMs,
 		turn.Model,
 		turn.StopReason,
+		turn.TokensEstimated,
+		turn.InputEstimated,
+		turn.OutputEstimated,
+		turn.Status,
+		turn.Error,
+		versionsJSON,
+		turn.SelectedVersion,
+		turn.CostUSD,
+		noveltyJSON,
+		steelmanJSON,
+		referencesJSON,
+		targetingJSON,
 	)
 
 	if err != nil {
@@ -676,68 +1170,82 @@ func (s *SQLiteStorage) AddTurn(turn *core.Turn) error {
 	return nil
 }
 
-// GetTurns returns all turns for a debate.
-func (s *SQLiteStorage) GetTurns(debateID string) ([]*core.Turn, error) {
-	query := `
-	SELECT id, debate_id, agent_id, number, round, content, created_at,
-		COALESCE(turn_type, 'debate'), COALESCE(input_tokens, 0), COALESCE(output_tokens, 0),
-		COALESCE(total_tokens, 0), COALESCE(duration_ms, 0), COALESCE(model, ''), COALESCE(stop_reason, '')
-	FROM turns
-	WHERE debate_id = ?
-	ORDER BY number ASC
-	`
-
-	rows, err := s.db.Query(query, debateID)
+// UpdateTurn saves a turn's content, metadata and versions.
+func (s *SQLiteStorage) UpdateTurn(turn *core.Turn) error {
+	versionsJSON, err := marshalVersions(turn.Versions)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get turns: %w", err)
+		return err
+	}
+	noveltyJSON, err := marshalNovelty(turn.Novelty)
+	if err != nil {
+		return err
+	}
+	steelmanJSON, err := marshalSteelmanScore(turn.Steelman)
+	if err != nil {
+		return err
+	}
+	referencesJSON, err := marshalReferences(turn.References)
+	if err != nil {
+		return err
+	}
+	targetingJSON, err := marshalTargeting(turn.Targeting)
+	if err != nil {
+		return err
 	}
-	defer rows.Close()
 
-	var turns []*core.Turn
-	for rows.Next() {
-		var turn core.Turn
-		var turnType string
-		err := rows.Scan(
-			&turn.ID,
-			&turn.DebateID,
-			&turn.AgentID,
-			&turn.Number,
-			&turn.Round,
-			&turn.Content,
-			&turn.CreatedAt,
-			&turnType,
-			&turn.InputTokens,
-			&turn.OutputTokens,
-			&turn.TotalTokens,
-			&turn.DurationMs,
-			&turn.Model,
-			&turn.StopReason,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan turn: %w", err)
-		}
-		turn.TurnType = core.TurnType(turnType)
-		turns = append(turns, &turn)
+	_, err = s.db.Exec(`
+	UPDATE turns
+	SET content = ?, input_tokens = ?, output_tokens = ?, total_tokens = ?, duration_ms = ?, model = ?, stop_reason = ?,
+		tokens_estimated = ?, input_estimated = ?, output_estimated = ?, status = ?, error = ?, versions_json = ?, selected_version = ?, cost_usd = ?, novelty_json = ?, steelman_json = ?,
+		references_json = ?, targeting_json = ?
+	WHERE id = ?
+	`,
+		turn.Content,
+		turn.InputTokens,
+		turn.OutputTokens,
+		turn.TotalTokens,
+		turn.DurationMs,
+		turn.Model,
+		turn.StopReason,
+		turn.TokensEstimated,
+		turn.InputEstimated,
+		turn.OutputEstimated,
+		turn.Status,
+		turn.Error,
+		versionsJSON,
+		turn.SelectedVersion,
+		turn.CostUSD,
+		noveltyJSON,
+		steelmanJSON,
+		referencesJSON,
+		targetingJSON,
+		turn.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update turn: %w", err)
 	}
+	return nil
+}
 
-	return turns, nil
+// DeleteTurn deletes a single turn.
+func (s *SQLiteStorage) DeleteTurn(id string) error {
+	if _, err := s.db.Exec("DELETE FROM turns WHERE id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete turn: %w", err)
+	}
+	return nil
 }
 
-// GetLatestTurn returns the most recent turn for a debate.
-func (s *SQLiteStorage) GetLatestTurn(debateID string) (*core.Turn, error) {
-	query := `
-	SELECT id, debate_id, agent_id, number, round, content, created_at,
+const turnColumns = `id, debate_id, agent_id, number, round, content, created_at,
 		COALESCE(turn_type, 'debate'), COALESCE(input_tokens, 0), COALESCE(output_tokens, 0),
-		COALESCE(total_tokens, 0), COALESCE(duration_ms, 0), COALESCE(model, ''), COALESCE(stop_reason, '')
-	FROM turns
-	WHERE debate_id = ?
-	ORDER BY number DESC
-	LIMIT 1
-	`
+		COALESCE(total_tokens, 0), COALESCE(duration_ms, 0), COALESCE(model, ''), COALESCE(stop_reason, ''),
+		COALESCE(tokens_estimated, 0), input_estimated, output_estimated, status, error, versions_json, selected_version, cost_usd, novelty_json, steelman_json, references_json,
+		targeting_json`
 
+// scanTurn scans a row selected with turnColumns.
+func scanTurn(row interface{ Scan(...any) error }) (*core.Turn, error) {
 	var turn core.Turn
-	var turnType string
-	err := s.db.QueryRow(query, debateID).Scan(
+	var turnType, versionsJSON, noveltyJSON, steelmanJSON, referencesJSON, targetingJSON string
+	err := row.Scan(
 		&turn.ID,
 		&turn.DebateID,
 		&turn.AgentID,
@@ -752,19 +1260,208 @@ func (s *SQLiteStorage) GetLatestTurn(debateID string) (*core.Turn, error) {
 		&turn.DurationMs,
 		&turn.Model,
 		&turn.StopReason,
+		&turn.TokensEstimated,
+		&turn.InputEstimated,
+		&turn.OutputEstimated,
+		&turn.Status,
+		&turn.Error,
+		&versionsJSON,
+		&turn.SelectedVersion,
+		&turn.CostUSD,
+		&noveltyJSON,
+		&steelmanJSON,
+		&referencesJSON,
+		&targetingJSON,
 	)
-
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get latest turn: %w", err)
+		return nil, err
 	}
-
 	turn.TurnType = core.TurnType(turnType)
-	return &turn, nil
-}
-
+	if versionsJSON != "" {
+		if err := json.Unmarshal([]byte(versionsJSON), &turn.Versions); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal turn versions: %w", err)
+		}
+	}
+	if noveltyJSON != "" {
+		if err := json.Unmarshal([]byte(noveltyJSON), &turn.Novelty); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal turn novelty: %w", err)
+		}
+	}
+	if steelmanJSON != "" {
+		if err := json.Unmarshal([]byte(steelmanJSON), &turn.Steelman); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal turn steelman score: %w", err)
+		}
+	}
+	if referencesJSON != "" {
+		if err := json.Unmarshal([]byte(referencesJSON), &turn.References); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal turn references: %w", err)
+		}
+	}
+	if targetingJSON != "" {
+		if err := json.Unmarshal([]byte(targetingJSON), &turn.Targeting); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal turn targeting: %w", err)
+		}
+	}
+	return &turn, nil
+}
+
+// marshalVersions encodes regenerated versions, or "" when there are none.
+func marshalVersions(versions []*core.Version) (string, error) {
+	if len(versions) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(versions)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal versions: %w", err)
+	}
+	return string(data), nil
+}
+
+// marshalNovelty encodes a turn's novelty, or "" when it was not measured.
+func marshalNovelty(novelty *core.Novelty) (string, error) {
+	if novelty == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(novelty)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal novelty: %w", err)
+	}
+	return string(data), nil
+}
+
+// marshalSteelmanScore encodes a turn's steelman score, or "" when it was not rated.
+func marshalSteelmanScore(score *core.SteelmanScore) (string, error) {
+	if score == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(score)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal steelman score: %w", err)
+	}
+	return string(data), nil
+}
+
+// marshalReferences encodes checked code references, or "" when there are none.
+func marshalReferences(refs []*core.CodeReference) (string, error) {
+	if len(refs) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(refs)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal code references: %w", err)
+	}
+	return string(data), nil
+}
+
+// marshalTargeting encodes a follow-up's targeting, or "" when it addresses everyone.
+func marshalTargeting(targeting *core.Targeting) (string, error) {
+	if targeting == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(targeting)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal targeting: %w", err)
+	}
+	return string(data), nil
+}
+
+// GetTurns returns all turns for a debate.
+func (s *SQLiteStorage) GetTurns(debateID string) ([]*core.Turn, error) {
+	query := `SELECT ` + turnColumns + `
+	FROM turns
+	WHERE debate_id = ?
+	ORDER BY number ASC
+	`
+
+	rows, err := s.db.Query(query, debateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get turns: %w", err)
+	}
+	defer rows.Close()
+
+	var turns []*core.Turn
+	for rows.Next() {
+		turn, err := scanTurn(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan turn: %w", err)
+		}
+		turns = append(turns, turn)
+	}
+
+	return turns, nil
+}
+
+// GetLatestTurn returns the most recent turn for a debate.
+func (s *SQLiteStorage) GetLatestTurn(debateID string) (*core.Turn, error) {
+	query := `SELECT ` + turnColumns + `
+	FROM turns
+	WHERE debate_id = ?
+	ORDER BY number DESC
+	LIMIT 1
+	`
+
+	turn, err := scanTurn(s.db.QueryRow(query, debateID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest turn: %w", err)
+	}
+	return turn, nil
+}
+
+// SaveTurnClaims stores the claims extracted from a turn, replacing any
+// earlier extraction. A turn with no claims is stored as extracted.
+func (s *SQLiteStorage) SaveTurnClaims(turn *core.Turn, claims []*core.Claim) error {
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return fmt.Errorf("failed to marshal claims: %w", err)
+	}
+	_, err = s.db.Exec(`
+	INSERT OR REPLACE INTO turn_arguments (turn_id, debate_id, turn_number, claims_json, created_at)
+	VALUES (?, ?, ?, ?, ?)
+	`, turn.ID, turn.DebateID, turn.Number, string(data), time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to save claims: %w", err)
+	}
+	return nil
+}
+
+// GetDebateClaims returns the claims extracted from a debate's turns, keyed
+// by turn ID. Turns that were never extracted have no entry.
+func (s *SQLiteStorage) GetDebateClaims(debateID string) (map[string][]*core.Claim, error) {
+	rows, err := s.db.Query(`SELECT turn_id, claims_json FROM turn_arguments WHERE debate_id = ?`, debateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get claims: %w", err)
+	}
+	defer rows.Close()
+
+	claims := make(map[string][]*core.Claim)
+	for rows.Next() {
+		var turnID, claimsJSON string
+		if err := rows.Scan(&turnID, &claimsJSON); err != nil {
+			return nil, fmt.Errorf("failed to scan claims: %w", err)
+		}
+		var turnClaims []*core.Claim
+		if claimsJSON != "" {
+			if err := json.Unmarshal([]byte(claimsJSON), &turnClaims); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal claims: %w", err)
+			}
+		}
+		claims[turnID] = turnClaims
+	}
+	return claims, rows.Err()
+}
+
+// DeleteDebateClaims discards the claims of a debate's turns numbered from
+// turnNumber on, so they are extracted again.
+func (s *SQLiteStorage) DeleteDebateClaims(debateID string, turnNumber int) error {
+	if _, err := s.db.Exec("DELETE FROM turn_arguments WHERE debate_id = ? AND turn_number >= ?", debateID, turnNumber); err != nil {
+		return fmt.Errorf("failed to delete claims: %w", err)
+	}
+	return nil
+}
+
 // DefaultDBPath returns the default database path.
 func DefaultDBPath() string {
 	home, err := os.UserHomeDir()
@@ -787,15 +1484,19 @@ type Persona struct {
 
 // Style represents a stored debate style.
 type Style struct {
-	ID               string    `json:"id"`
-	Name             string    `json:"name"`
-	Description      string    `json:"description"`
-	OpeningPrompt    string    `json:"opening_prompt"`
-	ResponsePrompt   string    `json:"response_prompt"`
-	ConclusionPrompt string    `json:"conclusion_prompt"`
-	IsBuiltin        bool      `json:"is_builtin"`
-	CreatedAt        time.Time `json:"created_at"`
-	UpdatedAt        time.Time `json:"updated_at"`
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	OpeningPrompt    string `json:"opening_prompt"`
+	ResponsePrompt   string `json:"response_prompt"`
+	ConclusionPrompt string `json:"conclusion_prompt"`
+	// Early consensus detection for debates in this style (empty uses the default)
+	ConsensusMethod    core.ConsensusMethod `json:"consensus_method,omitempty"`
+	ConsensusThreshold float64              `json:"consensus_threshold,omitempty"`
+	Phases             []core.Phase         `json:"phases,omitempty"` // Replace the three prompts when set
+	IsBuiltin          bool                 `json:"is_builtin"`
+	CreatedAt          time.Time            `json:"created_at"`
+	UpdatedAt          time.Time            `json:"updated_at"`
 }
 
 // CreatePersona creates a new persona.
@@ -929,16 +1630,21 @@ func (s *SQLiteStorage) CreateStyle(st *Style) error {
 	st.UpdatedAt = now
 
 	query := `
-	INSERT INTO styles (id, name, description, opening_prompt, response_prompt, conclusion_prompt, is_builtin, created_at, updated_at)
-	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	INSERT INTO styles (id, name, description, opening_prompt, response_prompt, conclusion_prompt, consensus_method, consensus_threshold, phases_json, is_builtin, created_at, updated_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
+	phasesJSON, err := marshalPhases(st.Phases)
+	if err != nil {
+		return err
+	}
+
 	isBuiltin := 0
 	if st.IsBuiltin {
 		isBuiltin = 1
 	}
 
-	_, err := s.db.Exec(query, st.ID, st.Name, st.Description, st.OpeningPrompt, st.ResponsePrompt, st.ConclusionPrompt, isBuiltin, st.CreatedAt, st.UpdatedAt)
+	_, err = s.db.Exec(query, st.ID, st.Name, st.Description, st.OpeningPrompt, st.ResponsePrompt, st.ConclusionPrompt, st.ConsensusMethod, st.ConsensusThreshold, phasesJSON, isBuiltin, st.CreatedAt, st.UpdatedAt)
 	if err != nil {
 		return fmt.Errorf("failed to create style: %w", err)
 	}
@@ -948,15 +1654,16 @@ func (s *SQLiteStorage) CreateStyle(st *Style) error {
 // GetStyle retrieves a style by ID.
 func (s *SQLiteStorage) GetStyle(id string) (*Style, error) {
 	query := `
-	SELECT id, name, description, opening_prompt, response_prompt, conclusion_prompt, is_builtin, created_at, updated_at
+	SELECT id, name, description, opening_prompt, response_prompt, conclusion_prompt, consensus_method, consensus_threshold, phases_json, is_builtin, created_at, updated_at
 	FROM styles
 	WHERE id = ?
 	`
 
 	var st Style
+	var phasesJSON string
 	var isBuiltin int
 	err := s.db.QueryRow(query, id).Scan(
-		&st.ID, &st.Name, &st.Description, &st.OpeningPrompt, &st.ResponsePrompt, &st.ConclusionPrompt, &isBuiltin, &st.CreatedAt, &st.UpdatedAt,
+		&st.ID, &st.Name, &st.Description, &st.OpeningPrompt, &st.ResponsePrompt, &st.ConclusionPrompt, &st.ConsensusMethod, &st.ConsensusThreshold, &phasesJSON, &isBuiltin, &st.CreatedAt, &st.UpdatedAt,
 	)
 
 	if err == sql.ErrNoRows {
@@ -965,6 +1672,9 @@ func (s *SQLiteStorage) GetStyle(id string) (*Style, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to get style: %w", err)
 	}
+	if err := unmarshalPhases(phasesJSON, &st.Phases); err != nil {
+		return nil, err
+	}
 
 	st.IsBuiltin = isBuiltin == 1
 	return &st, nil
@@ -980,15 +1690,20 @@ func (s *SQLiteStorage) UpdateStyle(st *Style) error {
 		return fmt.Errorf("cannot update builtin style")
 	}
 
+	phasesJSON, err := marshalPhases(st.Phases)
+	if err != nil {
+		return err
+	}
+
 	st.UpdatedAt = time.Now()
 
 	query := `
 	UPDATE styles
-	SET name = ?, description = ?, opening_prompt = ?, response_prompt = ?, conclusion_prompt = ?, updated_at = ?
+	SET name = ?, description = ?, opening_prompt = ?, response_prompt = ?, conclusion_prompt = ?, consensus_method = ?, consensus_threshold = ?, phases_json = ?, updated_at = ?
 	WHERE id = ? AND is_builtin = 0
 	`
 
-	_, err = s.db.Exec(query, st.Name, st.Description, st.OpeningPrompt, st.ResponsePrompt, st.ConclusionPrompt, st.UpdatedAt, st.ID)
+	_, err = s.db.Exec(query, st.Name, st.Description, st.OpeningPrompt, st.ResponsePrompt, st.ConclusionPrompt, st.ConsensusMethod, st.ConsensusThreshold, phasesJSON, st.UpdatedAt, st.ID)
 	if err != nil {
 		return fmt.Errorf("failed to update style: %w", err)
 	}
@@ -1015,7 +1730,7 @@ func (s *SQLiteStorage) DeleteStyle(id string) error {
 // ListStyles returns all styles.
 func (s *SQLiteStorage) ListStyles(includeBuiltin bool) ([]*Style, error) {
 	query := `
-	SELECT id, name, description, opening_prompt, response_prompt, conclusion_prompt, is_builtin, created_at, updated_at
+	SELECT id, name, description, opening_prompt, response_prompt, conclusion_prompt, consensus_method, consensus_threshold, phases_json, is_builtin, created_at, updated_at
 	FROM styles
 	`
 	if !includeBuiltin {
@@ -1032,11 +1747,15 @@ func (s *SQLiteStorage) ListStyles(includeBuiltin bool) ([]*Style, error) {
 	var styles []*Style
 	for rows.Next() {
 		var st Style
+		var phasesJSON string
 		var isBuiltin int
-		err := rows.Scan(&st.ID, &st.Name, &st.Description, &st.OpeningPrompt, &st.ResponsePrompt, &st.ConclusionPrompt, &isBuiltin, &st.CreatedAt, &st.UpdatedAt)
+		err := rows.Scan(&st.ID, &st.Name, &st.Description, &st.OpeningPrompt, &st.ResponsePrompt, &st.ConclusionPrompt, &st.ConsensusMethod, &st.ConsensusThreshold, &phasesJSON, &isBuiltin, &st.CreatedAt, &st.UpdatedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan style: %w", err)
 		}
+		if err := unmarshalPhases(phasesJSON, &st.Phases); err != nil {
+			return nil, err
+		}
 		st.IsBuiltin = isBuiltin == 1
 		styles = append(styles, &st)
 	}
@@ -1044,6 +1763,29 @@ func (s *SQLiteStorage) ListStyles(includeBuiltin bool) ([]*Style, error) {
 	return styles, nil
 }
 
+// marshalPhases encodes a style's phases; styles without phases store "".
+func marshalPhases(phases []core.Phase) (string, error) {
+	if len(phases) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(phases)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal phases: %w", err)
+	}
+	return string(data), nil
+}
+
+// unmarshalPhases decodes a style's phases_json column.
+func unmarshalPhases(data string, phases *[]core.Phase) error {
+	if data == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(data), phases); err != nil {
+		return fmt.Errorf("failed to unmarshal phases: %w", err)
+	}
+	return nil
+}
+
 // CreateCouncil creates a new council.
 func (s *SQLiteStorage) CreateCouncil(council *core.Council) error {
 	if council.Title == "" {
@@ -1055,6 +1797,11 @@ func (s *SQLiteStorage) CreateCouncil(council *core.Council) error {
 		return fmt.Errorf("failed to marshal chairman: %w", err)
 	}
 
+	budgetJSON, err := marshalBudget(council.Budget)
+	if err != nil {
+		return err
+	}
+
 	var synthesesJSON *string
 	if len(council.Syntheses) > 0 {
 		data, err := json.Marshal(council.Syntheses)
@@ -1066,8 +1813,8 @@ func (s *SQLiteStorage) CreateCouncil(council *core.Council) error {
 	}
 
 	query := `
-	INSERT INTO councils (id, title, topic, cwd, project_id, project_instructions, chairman_json, status, synthesis, created_at, updated_at, completed_at)
-	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	INSERT INTO councils (id, title, topic, cwd, project_id, project_instructions, chairman_json, budget_json, stop_reason, parent_id, fork_point, schedule_id, status, synthesis, created_at, updated_at, completed_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	var completedAt *time.Time
@@ -1083,6 +1830,11 @@ func (s *SQLiteStorage) CreateCouncil(council *core.Council) error {
 		council.ProjectID,
 		council.ProjectInstructions,
 		string(chairmanJSON),
+		budgetJSON,
+		council.StopReason,
+		council.ParentID,
+		council.ForkPoint,
+		council.ScheduleID,
 		council.Status,
 		synthesesJSON,
 		council.CreatedAt,
@@ -1126,13 +1878,13 @@ func (s *SQLiteStorage) insertCouncilMember(councilID string, member core.Agent)
 // GetCouncil retrieves a council by ID.
 func (s *SQLiteStorage) GetCouncil(id string) (*core.Council, error) {
 	query := `
-	SELECT id, title, topic, cwd, project_id, project_instructions, chairman_json, status, synthesis, created_at, updated_at, completed_at
+	SELECT id, title, topic, cwd, project_id, project_instructions, chairman_json, budget_json, stop_reason, parent_id, fork_point, schedule_id, status, synthesis, created_at, updated_at, completed_at
 	FROM councils
 	WHERE id = ?
 	`
 
 	var council core.Council
-	var chairmanJSON string
+	var chairmanJSON, budgetJSON string
 	var synthesesJSON sql.NullString
 	var completedAt sql.NullTime
 
@@ -1144,6 +1896,11 @@ func (s *SQLiteStorage) GetCouncil(id string) (*core.Council, error) {
 		&council.ProjectID,
 		&council.ProjectInstructions,
 		&chairmanJSON,
+		&budgetJSON,
+		&council.StopReason,
+		&council.ParentID,
+		&council.ForkPoint,
+		&council.ScheduleID,
 		&council.Status,
 		&synthesesJSON,
 		&council.CreatedAt,
@@ -1152,7 +1909,7 @@ func (s *SQLiteStorage) GetCouncil(id string) (*core.Council, error) {
 	)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("council not found")
+		return nil, ErrCouncilNotFound
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get council: %w", err)
@@ -1163,6 +1920,10 @@ func (s *SQLiteStorage) GetCouncil(id string) (*core.Council, error) {
 		return nil, fmt.Errorf("failed to unmarshal chairman: %w", err)
 	}
 
+	if council.Budget, err = unmarshalBudget(budgetJSON); err != nil {
+		return nil, err
+	}
+
 	if synthesesJSON.Valid {
 		if err := json.Unmarshal([]byte(synthesesJSON.String), &council.Syntheses); err != nil {
 			// Backward compatibility: try unmarshaling as single string
@@ -1239,6 +2000,11 @@ func (s *SQLiteStorage) UpdateCouncil(council *core.Council) error {
 		return fmt.Errorf("failed to marshal chairman: %w", err)
 	}
 
+	budgetJSON, err := marshalBudget(council.Budget)
+	if err != nil {
+		return err
+	}
+
 	var synthesesJSON *string
 	if len(council.Syntheses) > 0 {
 		data, err := json.Marshal(council.Syntheses)
@@ -1251,7 +2017,7 @@ func (s *SQLiteStorage) UpdateCouncil(council *core.Council) error {
 
 	query := `
 	UPDATE councils
-	SET title = ?, topic = ?, cwd = ?, project_id = ?, project_instructions = ?, chairman_json = ?, status = ?, synthesis = ?, updated_at = ?, completed_at = ?
+	SET title = ?, topic = ?, cwd = ?, project_id = ?, project_instructions = ?, chairman_json = ?, budget_json = ?, stop_reason = ?, parent_id = ?, fork_point = ?, status = ?, synthesis = ?, updated_at = ?, completed_at = ?
 	WHERE id = ?
 	`
 
@@ -1267,6 +2033,10 @@ func (s *SQLiteStorage) UpdateCouncil(council *core.Council) error {
 		council.ProjectID,
 		council.ProjectInstructions,
 		string(chairmanJSON),
+		budgetJSON,
+		council.StopReason,
+		council.ParentID,
+		council.ForkPoint,
 		council.Status,
 		synthesesJSON,
 		council.UpdatedAt,
@@ -1394,12 +2164,63 @@ func (s *SQLiteStorage) ListCouncilsByProject(projectID string, limit, offset in
 	return summaries, nil
 }
 
+// ListCouncilsBySchedule returns the councils a schedule started, newest first.
+func (s *SQLiteStorage) ListCouncilsBySchedule(scheduleID string, limit, offset int) ([]*core.CouncilSummary, error) {
+	query := `
+	SELECT
+		c.id,
+		c.title,
+		c.topic,
+		c.cwd,
+		c.project_id,
+		c.schedule_id,
+		c.status,
+		c.created_at,
+		COUNT(cm.id) as member_count
+	FROM councils c
+	LEFT JOIN council_members cm ON c.id = cm.council_id
+	WHERE c.schedule_id = ?
+	GROUP BY c.id
+	ORDER BY c.created_at DESC
+	LIMIT ? OFFSET ?
+	`
+
+	rows, err := s.db.Query(query, scheduleID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list councils by schedule: %w", err)
+	}
+	defer rows.Close()
+
+	var summaries []*core.CouncilSummary
+	for rows.Next() {
+		var summary core.CouncilSummary
+		err := rows.Scan(
+			&summary.ID,
+			&summary.Title,
+			&summary.Topic,
+			&summary.CWD,
+			&summary.ProjectID,
+			&summary.ScheduleID,
+			&summary.Status,
+			&summary.CreatedAt,
+			&summary.MemberCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan council summary: %w", err)
+		}
+		summaries = append(summaries, &summary)
+	}
+
+	return summaries, nil
+}
+
 // AddResponse adds a response to a council.
 func (s *SQLiteStorage) AddResponse(response *core.Response) error {
 	query := `
 	INSERT INTO responses (id, council_id, member_id, round, content, created_at,
-		response_type, input_tokens, output_tokens, total_tokens, duration_ms, model, stop_reason)
-	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		response_type, input_tokens, output_tokens, total_tokens, duration_ms, model, stop_reason, tokens_estimated, input_estimated, output_estimated,
+		versions_json, selected_version, cost_usd, references_json, targeting_json, question)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	if response.Round == 0 {
@@ -1411,7 +2232,20 @@ func (s *SQLiteStorage) AddResponse(response *core.Response) error {
 		responseType = string(core.ResponseTypeResponse)
 	}
 
-	_, err := s.db.Exec(query,
+	versionsJSON, err := marshalVersions(response.Versions)
+	if err != nil {
+		return err
+	}
+	referencesJSON, err := marshalReferences(response.References)
+	if err != nil {
+		return err
+	}
+	targetingJSON, err := marshalTargeting(response.Targeting)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(query,
 		response.ID,
 		response.CouncilID,
 		response.MemberID,
@@ -1425,6 +2259,15 @@ func (s *SQLiteStorage) AddResponse(response *core.Response) error {
 		response.DurationMs,
 		response.Model,
 		response.StopReason,
+		response.TokensEstimated,
+		response.InputEstimated,
+		response.OutputEstimated,
+		versionsJSON,
+		response.SelectedVersion,
+		response.CostUSD,
+		referencesJSON,
+		targetingJSON,
+		response.Question,
 	)
 
 	if err != nil {
@@ -1434,12 +2277,59 @@ func (s *SQLiteStorage) AddResponse(response *core.Response) error {
 	return nil
 }
 
+// UpdateResponse saves a response's content, metadata and versions.
+func (s *SQLiteStorage) UpdateResponse(response *core.Response) error {
+	versionsJSON, err := marshalVersions(response.Versions)
+	if err != nil {
+		return err
+	}
+	referencesJSON, err := marshalReferences(response.References)
+	if err != nil {
+		return err
+	}
+	targetingJSON, err := marshalTargeting(response.Targeting)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(`
+	UPDATE responses
+	SET content = ?, input_tokens = ?, output_tokens = ?, total_tokens = ?, duration_ms = ?, model = ?, stop_reason = ?,
+		tokens_estimated = ?, input_estimated = ?, output_estimated = ?, versions_json = ?, selected_version = ?, cost_usd = ?, references_json = ?,
+		targeting_json = ?
+	WHERE id = ?
+	`,
+		response.Content,
+		response.InputTokens,
+		response.OutputTokens,
+		response.TotalTokens,
+		response.DurationMs,
+		response.Model,
+		response.StopReason,
+		response.TokensEstimated,
+		response.InputEstimated,
+		response.OutputEstimated,
+		versionsJSON,
+		response.SelectedVersion,
+		response.CostUSD,
+		referencesJSON,
+		targetingJSON,
+		response.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update response: %w", err)
+	}
+	return nil
+}
+
 // GetResponses returns all responses for a council.
 func (s *SQLiteStorage) GetResponses(councilID string) ([]*core.Response, error) {
 	query := `
 	SELECT id, council_id, member_id, round, content, created_at,
 		COALESCE(response_type, 'response'), COALESCE(input_tokens, 0), COALESCE(output_tokens, 0),
-		COALESCE(total_tokens, 0), COALESCE(duration_ms, 0), COALESCE(model, ''), COALESCE(stop_reason, '')
+		COALESCE(total_tokens, 0), COALESCE(duration_ms, 0), COALESCE(model, ''), COALESCE(stop_reason, ''),
+		COALESCE(tokens_estimated, 0), input_estimated, output_estimated, versions_json, selected_version, cost_usd, references_json,
+		targeting_json, question
 	FROM responses
 	WHERE council_id = ?
 	ORDER BY created_at ASC
@@ -1454,7 +2344,7 @@ func (s *SQLiteStorage) GetResponses(councilID string) ([]*core.Response, error)
 	var responses []*core.Response
 	for rows.Next() {
 		var response core.Response
-		var responseType string
+		var responseType, versionsJSON, referencesJSON, targetingJSON string
 		err := rows.Scan(
 			&response.ID,
 			&response.CouncilID,
@@ -1469,11 +2359,35 @@ func (s *SQLiteStorage) GetResponses(councilID string) ([]*core.Response, error)
 			&response.DurationMs,
 			&response.Model,
 			&response.StopReason,
+			&response.TokensEstimated,
+			&response.InputEstimated,
+			&response.OutputEstimated,
+			&versionsJSON,
+			&response.SelectedVersion,
+			&response.CostUSD,
+			&referencesJSON,
+			&targetingJSON,
+			&response.Question,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan response: %w", err)
 		}
 		response.ResponseType = core.ResponseType(responseType)
+		if versionsJSON != "" {
+			if err := json.Unmarshal([]byte(versionsJSON), &response.Versions); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal response versions: %w", err)
+			}
+		}
+		if referencesJSON != "" {
+			if err := json.Unmarshal([]byte(referencesJSON), &response.References); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal response references: %w", err)
+			}
+		}
+		if targetingJSON != "" {
+			if err := json.Unmarshal([]byte(targetingJSON), &response.Targeting); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal response targeting: %w", err)
+			}
+		}
 		responses = append(responses, &response)
 	}
 
@@ -1488,8 +2402,9 @@ func (s *SQLiteStorage) AddRanking(ranking *core.Ranking) error {
 	}
 
 	query := `
-	INSERT INTO rankings (id, council_id, reviewer_id, round, rankings_json, reasoning, created_at)
-	VALUES (?, ?, ?, ?, ?, ?, ?)
+	INSERT INTO rankings (id, council_id, reviewer_id, round, rankings_json, reasoning, created_at,
+		input_tokens, output_tokens, total_tokens, duration_ms, model, stop_reason, tokens_estimated, input_estimated, output_estimated, cost_usd)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	if ranking.Round == 0 {
@@ -1504,6 +2419,16 @@ func (s *SQLiteStorage) AddRanking(ranking *core.Ranking) error {
 		string(rankingsJSON),
 		ranking.Reasoning,
 		ranking.CreatedAt,
+		ranking.InputTokens,
+		ranking.OutputTokens,
+		ranking.TotalTokens,
+		ranking.DurationMs,
+		ranking.Model,
+		ranking.StopReason,
+		ranking.TokensEstimated,
+		ranking.InputEstimated,
+		ranking.OutputEstimated,
+		ranking.CostUSD,
 	)
 
 	if err != nil {
@@ -1516,7 +2441,8 @@ func (s *SQLiteStorage) AddRanking(ranking *core.Ranking) error {
 // GetRankings returns all rankings for a council.
 func (s *SQLiteStorage) GetRankings(councilID string) ([]*core.Ranking, error) {
 	query := `
-	SELECT id, council_id, reviewer_id, round, rankings_json, reasoning, created_at
+	SELECT id, council_id, reviewer_id, round, rankings_json, reasoning, created_at,
+		input_tokens, output_tokens, total_tokens, duration_ms, model, stop_reason, tokens_estimated, input_estimated, output_estimated, cost_usd
 	FROM rankings
 	WHERE council_id = ?
 	ORDER BY created_at ASC
@@ -1540,6 +2466,16 @@ func (s *SQLiteStorage) GetRankings(councilID string) ([]*core.Ranking, error) {
 			&rankingsJSON,
 			&ranking.Reasoning,
 			&ranking.CreatedAt,
+			&ranking.InputTokens,
+			&ranking.OutputTokens,
+			&ranking.TotalTokens,
+			&ranking.DurationMs,
+			&ranking.Model,
+			&ranking.StopReason,
+			&ranking.TokensEstimated,
+			&ranking.InputEstimated,
+			&ranking.OutputEstimated,
+			&ranking.CostUSD,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan ranking: %w", err)
@@ -1556,18 +2492,32 @@ func (s *SQLiteStorage) GetRankings(councilID string) ([]*core.Ranking, error) {
 	return rankings, nil
 }
 
+// DeleteRankings deletes a council's rankings for one round.
+func (s *SQLiteStorage) DeleteRankings(councilID string, round int) error {
+	if _, err := s.db.Exec("DELETE FROM rankings WHERE council_id = ? AND round = ?", councilID, round); err != nil {
+		return fmt.Errorf("failed to delete rankings: %w", err)
+	}
+	return nil
+}
+
 // CreateProject creates a new project.
 func (s *SQLiteStorage) CreateProject(project *core.Project) error {
 	query := `
-	INSERT INTO projects (id, name, description, instructions, created_at, updated_at)
-	VALUES (?, ?, ?, ?, ?, ?)
+	INSERT INTO projects (id, name, description, instructions, budget_json, created_at, updated_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?)
 	`
 
-	_, err := s.db.Exec(query,
+	budgetJSON, err := marshalBudget(project.Budget)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(query,
 		project.ID,
 		project.Name,
 		project.Description,
 		project.Instructions,
+		budgetJSON,
 		project.CreatedAt,
 		project.UpdatedAt,
 	)
@@ -1581,17 +2531,19 @@ func (s *SQLiteStorage) CreateProject(project *core.Project) error {
 // GetProject retrieves a project by ID.
 func (s *SQLiteStorage) GetProject(id string) (*core.Project, error) {
 	query := `
-	SELECT id, name, description, instructions, created_at, updated_at
+	SELECT id, name, description, instructions, budget_json, created_at, updated_at
 	FROM projects
 	WHERE id = ?
 	`
 
 	var project core.Project
+	var budgetJSON string
 	err := s.db.QueryRow(query, id).Scan(
 		&project.ID,
 		&project.Name,
 		&project.Description,
 		&project.Instructions,
+		&budgetJSON,
 		&project.CreatedAt,
 		&project.UpdatedAt,
 	)
@@ -1602,12 +2554,20 @@ func (s *SQLiteStorage) GetProject(id string) (*core.Project, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to get project: %w", err)
 	}
+	if project.Budget, err = unmarshalBudget(budgetJSON); err != nil {
+		return nil, err
+	}
 
 	return &project, nil
 }
 
 // UpdateProject updates an existing project and re-injects instructions into chats.
 func (s *SQLiteStorage) UpdateProject(project *core.Project) error {
+	budgetJSON, err := marshalBudget(project.Budget)
+	if err != nil {
+		return err
+	}
+
 	tx, err := s.db.Begin()
 	if err != nil {
 		return fmt.Errorf("failed to begin project update: %w", err)
@@ -1615,7 +2575,7 @@ func (s *SQLiteStorage) UpdateProject(project *core.Project) error {
 
 	updateProjectQuery := `
 	UPDATE projects
-	SET name = ?, description = ?, instructions = ?, updated_at = ?
+	SET name = ?, description = ?, instructions = ?, budget_json = ?, updated_at = ?
 	WHERE id = ?
 	`
 
@@ -1623,6 +2583,7 @@ func (s *SQLiteStorage) UpdateProject(project *core.Project) error {
 		project.Name,
 		project.Description,
 		project.Instructions,
+		budgetJSON,
 		project.UpdatedAt,
 		project.ID,
 	); err != nil {
@@ -1691,7 +2652,7 @@ func (s *SQLiteStorage) DeleteProject(id string) error {
 // ListProjects returns a list of projects.
 func (s *SQLiteStorage) ListProjects(limit, offset int) ([]*core.Project, error) {
 	query := `
-	SELECT id, name, description, instructions, created_at, updated_at
+	SELECT id, name, description, instructions, budget_json, created_at, updated_at
 	FROM projects
 	ORDER BY updated_at DESC
 	LIMIT ? OFFSET ?
@@ -1706,19 +2667,779 @@ func (s *SQLiteStorage) ListProjects(limit, offset int) ([]*core.Project, error)
 	var projects []*core.Project
 	for rows.Next() {
 		var project core.Project
+		var budgetJSON string
 		err := rows.Scan(
 			&project.ID,
 			&project.Name,
 			&project.Description,
 			&project.Instructions,
+			&budgetJSON,
 			&project.CreatedAt,
 			&project.UpdatedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan project: %w", err)
 		}
+		if project.Budget, err = unmarshalBudget(budgetJSON); err != nil {
+			return nil, err
+		}
 		projects = append(projects, &project)
 	}
 
 	return projects, nil
 }
+
+// RequestStop records a pause or cancel request for a debate or council.
+// The process running the session honors it before its next turn or stage.
+func (s *SQLiteStorage) RequestStop(sessionID string, status core.DebateStatus) error {
+	_, err := s.db.Exec(`
+	INSERT INTO stop_requests (session_id, status, created_at) VALUES (?, ?, ?)
+	ON CONFLICT(session_id) DO UPDATE SET status = excluded.status, created_at = excluded.created_at
+	`, sessionID, status, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to request stop: %w", err)
+	}
+	return nil
+}
+
+// TakeStopRequest returns and clears a pending stop request.
+// It returns an empty status when there is none.
+func (s *SQLiteStorage) TakeStopRequest(sessionID string) (core.DebateStatus, error) {
+	var status core.DebateStatus
+	err := s.db.QueryRow("DELETE FROM stop_requests WHERE session_id = ? RETURNING status", sessionID).Scan(&status)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to take stop request: %w", err)
+	}
+	return status, nil
+}
+
+// ClearStopRequest drops a pending stop request, e.g. when a session resumes.
+func (s *SQLiteStorage) ClearStopRequest(sessionID string) error {
+	if _, err := s.db.Exec("DELETE FROM stop_requests WHERE session_id = ?", sessionID); err != nil {
+		return fmt.Errorf("failed to clear stop request: %w", err)
+	}
+	return nil
+}
+
+// Heartbeat records that owner's run of a session is alive as of now.
+func (s *SQLiteStorage) Heartbeat(sessionID, owner string) error {
+	_, err := s.db.Exec(`
+	INSERT INTO run_heartbeats (session_id, owner, beat_at) VALUES (?, ?, ?)
+	ON CONFLICT(session_id) DO UPDATE SET owner = excluded.owner, beat_at = excluded.beat_at
+	`, sessionID, owner, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to record heartbeat: %w", err)
+	}
+	return nil
+}
+
+// GetHeartbeat returns the owner and time of a session's last heartbeat.
+// It returns a zero time when the session has none.
+func (s *SQLiteStorage) GetHeartbeat(sessionID string) (string, time.Time, error) {
+	var owner string
+	var beatAt time.Time
+	err := s.db.QueryRow("SELECT owner, beat_at FROM run_heartbeats WHERE session_id = ?", sessionID).Scan(&owner, &beatAt)
+	if err == sql.ErrNoRows {
+		return "", time.Time{}, nil
+	}
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to get heartbeat: %w", err)
+	}
+	return owner, beatAt, nil
+}
+
+// ClearHeartbeat drops a session's heartbeat once its run ends.
+func (s *SQLiteStorage) ClearHeartbeat(sessionID string) error {
+	if _, err := s.db.Exec("DELETE FROM run_heartbeats WHERE session_id = ?", sessionID); err != nil {
+		return fmt.Errorf("failed to clear heartbeat: %w", err)
+	}
+	return nil
+}
+
+// ListDebateIDsByStatus returns the IDs of all debates in status, oldest first.
+func (s *SQLiteStorage) ListDebateIDsByStatus(status core.DebateStatus) ([]string, error) {
+	return s.listIDsByStatus("debates", status)
+}
+
+// ListCouncilIDsByStatus returns the IDs of all councils in status, oldest first.
+func (s *SQLiteStorage) ListCouncilIDsByStatus(status core.DebateStatus) ([]string, error) {
+	return s.listIDsByStatus("councils", status)
+}
+
+func (s *SQLiteStorage) listIDsByStatus(table string, status core.DebateStatus) ([]string, error) {
+	rows, err := s.db.Query("SELECT id FROM "+table+" WHERE status = ? ORDER BY created_at", status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan %s id: %w", table, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// ListDebateForks returns the debates forked directly from parentID, oldest first.
+func (s *SQLiteStorage) ListDebateForks(parentID string) ([]*core.ForkNode, error) {
+	return s.listForks("debates", parentID)
+}
+
+// ListCouncilForks returns the councils forked directly from parentID, oldest first.
+func (s *SQLiteStorage) ListCouncilForks(parentID string) ([]*core.ForkNode, error) {
+	return s.listForks("councils", parentID)
+}
+
+func (s *SQLiteStorage) listForks(table, parentID string) ([]*core.ForkNode, error) {
+	rows, err := s.db.Query("SELECT id, title, status, fork_point, created_at FROM "+table+" WHERE parent_id = ? ORDER BY created_at", parentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s forks: %w", table, err)
+	}
+	defer rows.Close()
+
+	var forks []*core.ForkNode
+	for rows.Next() {
+		var n core.ForkNode
+		if err := rows.Scan(&n.ID, &n.Title, &n.Status, &n.ForkPoint, &n.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan %s fork: %w", table, err)
+		}
+		forks = append(forks, &n)
+	}
+	return forks, rows.Err()
+}
+
+// GetSwappedDebate returns the latest swap-sides rerun of a debate, or nil
+// if it has none.
+func (s *SQLiteStorage) GetSwappedDebate(id string) (*core.Debate, error) {
+	var swapID string
+	err := s.db.QueryRow("SELECT id FROM debates WHERE swap_of = ? ORDER BY created_at DESC LIMIT 1", id).Scan(&swapID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get swapped debate: %w", err)
+	}
+	return s.GetDebate(swapID)
+}
+
+// CreateTournament creates a new tournament. Its matches are added
+// separately with AddTournamentMatch.
+func (s *SQLiteStorage) CreateTournament(t *core.Tournament) error {
+	topicsJSON, err := json.Marshal(t.Topics)
+	if err != nil {
+		return fmt.Errorf("failed to marshal topics: %w", err)
+	}
+	entrantsJSON, err := json.Marshal(t.Entrants)
+	if err != nil {
+		return fmt.Errorf("failed to marshal entrants: %w", err)
+	}
+	judgeJSON, err := json.Marshal(t.Judge)
+	if err != nil {
+		return fmt.Errorf("failed to marshal judge: %w", err)
+	}
+
+	_, err = s.db.Exec(`
+	INSERT INTO tournaments (id, name, topics_json, entrants_json, judge_json, format, style, max_turns, workers, status, created_at, updated_at, completed_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`,
+		t.ID,
+		t.Name,
+		string(topicsJSON),
+		string(entrantsJSON),
+		string(judgeJSON),
+		t.Format,
+		t.Style,
+		t.MaxTurns,
+		t.Workers,
+		t.Status,
+		t.CreatedAt,
+		t.UpdatedAt,
+		t.CompletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert tournament: %w", err)
+	}
+	return nil
+}
+
+// GetTournament retrieves a tournament and its matches by ID.
+func (s *SQLiteStorage) GetTournament(id string) (*core.Tournament, error) {
+	t, err := scanTournament(s.db.QueryRow(`
+	SELECT id, name, topics_json, entrants_json, judge_json, format, style, max_turns, workers, status, created_at, updated_at, completed_at
+	FROM tournaments
+	WHERE id = ?
+	`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Matches, err = s.getTournamentMatches(id); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// UpdateTournament updates a tournament's status. Matches are updated with
+// UpdateTournamentMatch.
+func (s *SQLiteStorage) UpdateTournament(t *core.Tournament) error {
+	t.UpdatedAt = time.Now()
+	_, err := s.db.Exec(`
+	UPDATE tournaments SET status = ?, updated_at = ?, completed_at = ?
+	WHERE id = ?
+	`, t.Status, t.UpdatedAt, t.CompletedAt, t.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update tournament: %w", err)
+	}
+	return nil
+}
+
+// ListTournaments returns tournaments, newest first, without their matches.
+func (s *SQLiteStorage) ListTournaments(limit, offset int) ([]*core.Tournament, error) {
+	rows, err := s.db.Query(`
+	SELECT id, name, topics_json, entrants_json, judge_json, format, style, max_turns, workers, status, created_at, updated_at, completed_at
+	FROM tournaments
+	ORDER BY created_at DESC
+	LIMIT ? OFFSET ?
+	`, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tournaments: %w", err)
+	}
+	defer rows.Close()
+
+	var tournaments []*core.Tournament
+	for rows.Next() {
+		t, err := scanTournament(rows)
+		if err != nil {
+			return nil, err
+		}
+		tournaments = append(tournaments, t)
+	}
+	return tournaments, rows.Err()
+}
+
+func scanTournament(row interface{ Scan(...any) error }) (*core.Tournament, error) {
+	var t core.Tournament
+	var topicsJSON, entrantsJSON, judgeJSON string
+	var completedAt sql.NullTime
+	err := row.Scan(
+		&t.ID,
+		&t.Name,
+		&topicsJSON,
+		&entrantsJSON,
+		&judgeJSON,
+		&t.Format,
+		&t.Style,
+		&t.MaxTurns,
+		&t.Workers,
+		&t.Status,
+		&t.CreatedAt,
+		&t.UpdatedAt,
+		&completedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan tournament: %w", err)
+	}
+	if completedAt.Valid {
+		t.CompletedAt = &completedAt.Time
+	}
+
+	if err := json.Unmarshal([]byte(topicsJSON), &t.Topics); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal topics: %w", err)
+	}
+	if err := json.Unmarshal([]byte(entrantsJSON), &t.Entrants); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal entrants: %w", err)
+	}
+	if err := json.Unmarshal([]byte(judgeJSON), &t.Judge); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal judge: %w", err)
+	}
+	return &t, nil
+}
+
+// AddTournamentMatch adds a match to a tournament.
+func (s *SQLiteStorage) AddTournamentMatch(m *core.TournamentMatch) error {
+	_, err := s.db.Exec(`
+	INSERT INTO tournament_matches (id, tournament_id, round, topic, entrant_a, entrant_b, debate_id, status, outcome, winner, error, created_at, completed_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`,
+		m.ID,
+		m.TournamentID,
+		m.Round,
+		m.Topic,
+		m.EntrantA,
+		m.EntrantB,
+		m.DebateID,
+		m.Status,
+		m.Outcome,
+		m.Winner,
+		m.Error,
+		m.CreatedAt,
+		m.CompletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert tournament match: %w", err)
+	}
+	return nil
+}
+
+// UpdateTournamentMatch records a match's debate and result.
+func (s *SQLiteStorage) UpdateTournamentMatch(m *core.TournamentMatch) error {
+	_, err := s.db.Exec(`
+	UPDATE tournament_matches SET debate_id = ?, status = ?, outcome = ?, winner = ?, error = ?, completed_at = ?
+	WHERE id = ?
+	`, m.DebateID, m.Status, m.Outcome, m.Winner, m.Error, m.CompletedAt, m.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update tournament match: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLiteStorage) getTournamentMatches(tournamentID string) ([]*core.TournamentMatch, error) {
+	rows, err := s.db.Query(`
+	SELECT id, tournament_id, round, topic, entrant_a, entrant_b, debate_id, status, outcome, winner, error, created_at, completed_at
+	FROM tournament_matches
+	WHERE tournament_id = ?
+	ORDER BY round, created_at, rowid
+	`, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tournament matches: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []*core.TournamentMatch
+	for rows.Next() {
+		var m core.TournamentMatch
+		var completedAt sql.NullTime
+		err := rows.Scan(
+			&m.ID,
+			&m.TournamentID,
+			&m.Round,
+			&m.Topic,
+			&m.EntrantA,
+			&m.EntrantB,
+			&m.DebateID,
+			&m.Status,
+			&m.Outcome,
+			&m.Winner,
+			&m.Error,
+			&m.CreatedAt,
+			&completedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tournament match: %w", err)
+		}
+		if completedAt.Valid {
+			m.CompletedAt = &completedAt.Time
+		}
+		matches = append(matches, &m)
+	}
+	return matches, rows.Err()
+}
+
+// GetRating returns an entrant's leaderboard rating, or nil if it has not
+// played a rated match.
+func (s *SQLiteStorage) GetRating(entrant string) (*core.Rating, error) {
+	var r core.Rating
+	err := s.db.QueryRow(`
+	SELECT entrant, rating, matches, wins, losses, draws, updated_at
+	FROM ratings
+	WHERE entrant = ?
+	`, entrant).Scan(&r.Entrant, &r.Rating, &r.Matches, &r.Wins, &r.Losses, &r.Draws, &r.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rating: %w", err)
+	}
+	return &r, nil
+}
+
+// SaveRating creates or replaces an entrant's leaderboard rating.
+func (s *SQLiteStorage) SaveRating(r *core.Rating) error {
+	_, err := s.db.Exec(`
+	INSERT INTO ratings (entrant, rating, matches, wins, losses, draws, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)
+	ON CONFLICT(entrant) DO UPDATE SET rating = excluded.rating, matches = excluded.matches, wins = excluded.wins,
+		losses = excluded.losses, draws = excluded.draws, updated_at = excluded.updated_at
+	`, r.Entrant, r.Rating, r.Matches, r.Wins, r.Losses, r.Draws, r.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save rating: %w", err)
+	}
+	return nil
+}
+
+// ListRatings returns the leaderboard, highest rating first.
+func (s *SQLiteStorage) ListRatings() ([]*core.Rating, error) {
+	rows, err := s.db.Query(`
+	SELECT entrant, rating, matches, wins, losses, draws, updated_at
+	FROM ratings
+	ORDER BY rating DESC, entrant
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ratings: %w", err)
+	}
+	defer rows.Close()
+
+	var ratings []*core.Rating
+	for rows.Next() {
+		var r core.Rating
+		if err := rows.Scan(&r.Entrant, &r.Rating, &r.Matches, &r.Wins, &r.Losses, &r.Draws, &r.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan rating: %w", err)
+		}
+		ratings = append(ratings, &r)
+	}
+	return ratings, rows.Err()
+}
+
+// CreatePreset creates a new preset. Names are unique.
+func (s *SQLiteStorage) CreatePreset(p *core.Preset) error {
+	membersJSON, chairmanJSON, budgetJSON, err := marshalPreset(p)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(`
+	INSERT INTO presets (id, name, description, mode, members_json, chairman_json, style, max_turns, project_id, budget_json, created_at, updated_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`,
+		p.ID,
+		p.Name,
+		p.Description,
+		p.Mode,
+		membersJSON,
+		chairmanJSON,
+		p.Style,
+		p.MaxTurns,
+		p.ProjectID,
+		budgetJSON,
+		p.CreatedAt,
+		p.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert preset: %w", err)
+	}
+	return nil
+}
+
+// GetPreset retrieves a preset by ID, or nil if there is none.
+func (s *SQLiteStorage) GetPreset(id string) (*core.Preset, error) {
+	return s.getPreset("id", id)
+}
+
+// GetPresetByName retrieves a preset by name, or nil if there is none.
+func (s *SQLiteStorage) GetPresetByName(name string) (*core.Preset, error) {
+	return s.getPreset("name", name)
+}
+
+func (s *SQLiteStorage) getPreset(column, value string) (*core.Preset, error) {
+	p, err := scanPreset(s.db.QueryRow(`
+	SELECT id, name, description, mode, members_json, chairman_json, style, max_turns, project_id, budget_json, created_at, updated_at
+	FROM presets
+	WHERE `+column+` = ?
+	`, value))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return p, err
+}
+
+// UpdatePreset replaces a preset's settings.
+func (s *SQLiteStorage) UpdatePreset(p *core.Preset) error {
+	membersJSON, chairmanJSON, budgetJSON, err := marshalPreset(p)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(`
+	UPDATE presets
+	SET name = ?, description = ?, mode = ?, members_json = ?, chairman_json = ?, style = ?, max_turns = ?, project_id = ?, budget_json = ?, updated_at = ?
+	WHERE id = ?
+	`,
+		p.Name,
+		p.Description,
+		p.Mode,
+		membersJSON,
+		chairmanJSON,
+		p.Style,
+		p.MaxTurns,
+		p.ProjectID,
+		budgetJSON,
+		p.UpdatedAt,
+		p.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update preset: %w", err)
+	}
+	return nil
+}
+
+// DeletePreset deletes a preset. Sessions created from it are unaffected.
+func (s *SQLiteStorage) DeletePreset(id string) error {
+	if _, err := s.db.Exec("DELETE FROM presets WHERE id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete preset: %w", err)
+	}
+	return nil
+}
+
+// ListPresets returns every preset, by name.
+func (s *SQLiteStorage) ListPresets() ([]*core.Preset, error) {
+	rows, err := s.db.Query(`
+	SELECT id, name, description, mode, members_json, chairman_json, style, max_turns, project_id, budget_json, created_at, updated_at
+	FROM presets
+	ORDER BY name
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list presets: %w", err)
+	}
+	defer rows.Close()
+
+	var presets []*core.Preset
+	for rows.Next() {
+		p, err := scanPreset(rows)
+		if err != nil {
+			return nil, err
+		}
+		presets = append(presets, p)
+	}
+	return presets, rows.Err()
+}
+
+func marshalPreset(p *core.Preset) (membersJSON, chairmanJSON, budgetJSON string, err error) {
+	if len(p.Members) > 0 {
+		data, err := json.Marshal(p.Members)
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to marshal members: %w", err)
+		}
+		membersJSON = string(data)
+	}
+	if p.Chairman != nil {
+		data, err := json.Marshal(p.Chairman)
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to marshal chairman: %w", err)
+		}
+		chairmanJSON = string(data)
+	}
+	budgetJSON, err = marshalBudget(p.Budget)
+	return membersJSON, chairmanJSON, budgetJSON, err
+}
+
+func scanPreset(row interface{ Scan(...any) error }) (*core.Preset, error) {
+	var p core.Preset
+	var membersJSON, chairmanJSON, budgetJSON string
+	err := row.Scan(
+		&p.ID,
+		&p.Name,
+		&p.Description,
+		&p.Mode,
+		&membersJSON,
+		&chairmanJSON,
+		&p.Style,
+		&p.MaxTurns,
+		&p.ProjectID,
+		&budgetJSON,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan preset: %w", err)
+	}
+
+	if membersJSON != "" {
+		if err := json.Unmarshal([]byte(membersJSON), &p.Members); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal members: %w", err)
+		}
+	}
+	if chairmanJSON != "" {
+		if err := json.Unmarshal([]byte(chairmanJSON), &p.Chairman); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal chairman: %w", err)
+		}
+	}
+	if p.Budget, err = unmarshalBudget(budgetJSON); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// CreateSchedule creates a new schedule.
+func (s *SQLiteStorage) CreateSchedule(sc *core.Schedule) error {
+	membersJSON, chairmanJSON, budgetJSON, err := marshalSchedule(sc)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(`
+	INSERT INTO schedules (id, name, cron, topic, members_json, chairman_json, workspace_id, project_id, budget_json, catch_up, paused, last_run_at, next_run_at, last_council_id, last_error, created_at, updated_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`,
+		sc.ID,
+		sc.Name,
+		sc.Cron,
+		sc.Topic,
+		membersJSON,
+		chairmanJSON,
+		sc.WorkspaceID,
+		sc.ProjectID,
+		budgetJSON,
+		sc.CatchUp,
+		sc.Paused,
+		sc.LastRunAt,
+		sc.NextRunAt,
+		sc.LastCouncilID,
+		sc.LastError,
+		sc.CreatedAt,
+		sc.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert schedule: %w", err)
+	}
+	return nil
+}
+
+// GetSchedule retrieves a schedule by ID, or nil if there is none.
+func (s *SQLiteStorage) GetSchedule(id string) (*core.Schedule, error) {
+	sc, err := scanSchedule(s.db.QueryRow(`
+	SELECT id, name, cron, topic, members_json, chairman_json, workspace_id, project_id, budget_json, catch_up, paused, last_run_at, next_run_at, last_council_id, last_error, created_at, updated_at
+	FROM schedules
+	WHERE id = ?
+	`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return sc, err
+}
+
+// UpdateSchedule replaces a schedule's settings and run state.
+func (s *SQLiteStorage) UpdateSchedule(sc *core.Schedule) error {
+	membersJSON, chairmanJSON, budgetJSON, err := marshalSchedule(sc)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(`
+	UPDATE schedules
+	SET name = ?, cron = ?, topic = ?, members_json = ?, chairman_json = ?, workspace_id = ?, project_id = ?, budget_json = ?, catch_up = ?, paused = ?, last_run_at = ?, next_run_at = ?, last_council_id = ?, last_error = ?, updated_at = ?
+	WHERE id = ?
+	`,
+		sc.Name,
+		sc.Cron,
+		sc.Topic,
+		membersJSON,
+		chairmanJSON,
+		sc.WorkspaceID,
+		sc.ProjectID,
+		budgetJSON,
+		sc.CatchUp,
+		sc.Paused,
+		sc.LastRunAt,
+		sc.NextRunAt,
+		sc.LastCouncilID,
+		sc.LastError,
+		sc.UpdatedAt,
+		sc.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+	return nil
+}
+
+// DeleteSchedule deletes a schedule. Councils it started are kept.
+func (s *SQLiteStorage) DeleteSchedule(id string) error {
+	if _, err := s.db.Exec("DELETE FROM schedules WHERE id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete schedule: %w", err)
+	}
+	return nil
+}
+
+// ListSchedules returns every schedule, by name.
+func (s *SQLiteStorage) ListSchedules() ([]*core.Schedule, error) {
+	rows, err := s.db.Query(`
+	SELECT id, name, cron, topic, members_json, chairman_json, workspace_id, project_id, budget_json, catch_up, paused, last_run_at, next_run_at, last_council_id, last_error, created_at, updated_at
+	FROM schedules
+	ORDER BY name
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+	defer rows.Close()
+
+	var schedules []*core.Schedule
+	for rows.Next() {
+		sc, err := scanSchedule(rows)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, sc)
+	}
+	return schedules, rows.Err()
+}
+
+func marshalSchedule(sc *core.Schedule) (membersJSON, chairmanJSON, budgetJSON string, err error) {
+	return marshalPreset(&core.Preset{Members: sc.Members, Chairman: sc.Chairman, Budget: sc.Budget})
+}
+
+func scanSchedule(row interface{ Scan(...any) error }) (*core.Schedule, error) {
+	var sc core.Schedule
+	var membersJSON, chairmanJSON, budgetJSON string
+	var lastRunAt, nextRunAt sql.NullTime
+	err := row.Scan(
+		&sc.ID,
+		&sc.Name,
+		&sc.Cron,
+		&sc.Topic,
+		&membersJSON,
+		&chairmanJSON,
+		&sc.WorkspaceID,
+		&sc.ProjectID,
+		&budgetJSON,
+		&sc.CatchUp,
+		&sc.Paused,
+		&lastRunAt,
+		&nextRunAt,
+		&sc.LastCouncilID,
+		&sc.LastError,
+		&sc.CreatedAt,
+		&sc.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan schedule: %w", err)
+	}
+
+	if lastRunAt.Valid {
+		sc.LastRunAt = &lastRunAt.Time
+	}
+	if nextRunAt.Valid {
+		sc.NextRunAt = &nextRunAt.Time
+	}
+	if membersJSON != "" {
+		if err := json.Unmarshal([]byte(membersJSON), &sc.Members); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal members: %w", err)
+		}
+	}
+	if chairmanJSON != "" {
+		if err := json.Unmarshal([]byte(chairmanJSON), &sc.Chairman); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal chairman: %w", err)
+		}
+	}
+	if sc.Budget, err = unmarshalBudget(budgetJSON); err != nil {
+		return nil, err
+	}
+	return &sc, nil
+}
@@ -102,6 +102,12 @@ func TestSQLiteStorage(t *testing.T) {
 			Number:    1,
 			Content:   "First argument",
 			CreatedAt: time.Now(),
+
+			InputTokens:     12,
+			OutputTokens:    3,
+			TokensEstimated: true,
+			OutputEstimated: true,
+			Novelty:         &core.Novelty{Score: 0.75, Overlap: 0.25, NGrams: 4},
 		}
 
 		turn2 := &core.Turn{
@@ -111,6 +117,10 @@ func TestSQLiteStorage(t *testing.T) {
 			Number:    2,
 			Content:   "Second argument",
 			CreatedAt: time.Now(),
+			Steelman:  &core.SteelmanScore{TargetID: "agent-a-1", TargetTurn: 1, Score: 8, Passed: true, Attempts: 2},
+			References: []*core.CodeReference{
+				{Text: "main.go:99", Path: "main.go", LineStart: 99, LineEnd: 99, Reason: "file not found"},
+			},
 		}
 
 		if err := store.AddTurn(turn1); err != nil {
@@ -132,6 +142,25 @@ func TestSQLiteStorage(t *testing.T) {
 		if turns[0].Number != 1 || turns[1].Number != 2 {
 			t.Error("turns not in correct order")
 		}
+
+		if !turns[0].TokensEstimated || turns[1].TokensEstimated {
+			t.Error("tokens_estimated flag not round-tripped")
+		}
+		if turns[0].InputEstimated || !turns[0].OutputEstimated {
+			t.Error("estimated side not round-tripped")
+		}
+
+		if n := turns[0].Novelty; n == nil || n.Score != 0.75 || n.NGrams != 4 || turns[1].Novelty != nil {
+			t.Errorf("novelty not round-tripped: %+v, %+v", turns[0].Novelty, turns[1].Novelty)
+		}
+
+		if sm := turns[1].Steelman; sm == nil || sm.Score != 8 || sm.Attempts != 2 || turns[0].Steelman != nil {
+			t.Errorf("steelman score not round-tripped: %+v, %+v", turns[0].Steelman, turns[1].Steelman)
+		}
+
+		if refs := turns[1].References; len(refs) != 1 || refs[0].LineStart != 99 || refs[0].Reason != "file not found" || turns[0].References != nil {
+			t.Errorf("code references not round-tripped: %+v, %+v", turns[0].References, turns[1].References)
+		}
 	})
 
 	t.Run("GetLatestTurn", func(t *testing.T) {
@@ -186,4 +215,380 @@ func TestSQLiteStorage(t *testing.T) {
 			t.Error("expected nil for nonexistent debate")
 		}
 	})
+
+	t.Run("MultiAgentDebate", func(t *testing.T) {
+		now := time.Now()
+		debate := &core.Debate{
+			ID:            "test-debate-multi",
+			Topic:         "Three-way",
+			SpeakingOrder: core.SpeakingOrderRoundRobin,
+			Style:         "collaborative",
+			MaxTurns:      2,
+			Status:        core.StatusPending,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+		debate.SetParticipants([]core.Agent{
+			{ID: "multi-a", Provider: "claude", Persona: "optimist"},
+			{ID: "multi-b", Provider: "gemini", Persona: "skeptic"},
+			{ID: "multi-c", Provider: "qwen", Persona: "pragmatist"},
+		})
+
+		if err := store.CreateDebate(debate); err != nil {
+			t.Fatalf("failed to create debate: %v", err)
+		}
+
+		got, err := store.GetDebate(debate.ID)
+		if err != nil {
+			t.Fatalf("failed to get debate: %v", err)
+		}
+		if len(got.Participants()) != 3 {
+			t.Fatalf("participant count: got %d, want 3", len(got.Participants()))
+		}
+		if got.AgentA.ID != "multi-a" || got.AgentB.ID != "multi-b" || got.Agents[2].ID != "multi-c" {
+			t.Errorf("participants out of order: %+v", got.Agents)
+		}
+		if got.SpeakingOrder != core.SpeakingOrderRoundRobin {
+			t.Errorf("SpeakingOrder mismatch: got %s", got.SpeakingOrder)
+		}
+		if got.TotalTurns() != 6 {
+			t.Errorf("TotalTurns: got %d, want 6", got.TotalTurns())
+		}
+
+		summaries, err := store.ListDebates(10, 0)
+		if err != nil {
+			t.Fatalf("failed to list debates: %v", err)
+		}
+		for _, s := range summaries {
+			if s.ID == debate.ID && s.AgentCount != 3 {
+				t.Errorf("AgentCount: got %d, want 3", s.AgentCount)
+			}
+		}
+	})
+
+	t.Run("LegacyTwoAgentRow", func(t *testing.T) {
+		now := time.Now()
+		_, err := store.db.Exec(`
+		INSERT INTO debates (id, topic, agent_a_json, agent_b_json, style, max_turns, status, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			"test-debate-legacy", "Legacy",
+			`{"id":"legacy-a","provider":"claude","persona":"optimist"}`,
+			`{"id":"legacy-b","provider":"gemini","persona":"skeptic"}`,
+			"collaborative", 3, core.StatusCompleted, now, now,
+		)
+		if err != nil {
+			t.Fatalf("failed to insert legacy row: %v", err)
+		}
+
+		got, err := store.GetDebate("test-debate-legacy")
+		if err != nil {
+			t.Fatalf("failed to get debate: %v", err)
+		}
+		ids := got.AgentIDs()
+		if len(ids) != 2 || ids[0] != "legacy-a" || ids[1] != "legacy-b" {
+			t.Errorf("legacy participants: got %v", ids)
+		}
+		if got.TotalTurns() != 6 {
+			t.Errorf("TotalTurns: got %d, want 6", got.TotalTurns())
+		}
+	})
+
+	t.Run("StopRequests", func(t *testing.T) {
+		if status, err := store.TakeStopRequest("test-session"); err != nil || status != "" {
+			t.Fatalf("empty take: got %q, %v", status, err)
+		}
+
+		store.RequestStop("test-session", core.StatusPaused)
+		store.RequestStop("test-session", core.StatusCancelled)
+		status, err := store.TakeStopRequest("test-session")
+		if err != nil {
+			t.Fatalf("failed to take stop request: %v", err)
+		}
+		if status != core.StatusCancelled {
+			t.Errorf("latest request wins: got %q, want cancelled", status)
+		}
+		if status, _ := store.TakeStopRequest("test-session"); status != "" {
+			t.Errorf("request should be consumed, got %q", status)
+		}
+
+		store.RequestStop("test-session", core.StatusPaused)
+		if err := store.ClearStopRequest("test-session"); err != nil {
+			t.Fatalf("failed to clear stop request: %v", err)
+		}
+		if status, _ := store.TakeStopRequest("test-session"); status != "" {
+			t.Errorf("request should be cleared, got %q", status)
+		}
+	})
+
+	t.Run("Heartbeats", func(t *testing.T) {
+		if owner, at, err := store.GetHeartbeat("test-session"); err != nil || owner != "" || !at.IsZero() {
+			t.Fatalf("missing heartbeat: got %q, %v, %v", owner, at, err)
+		}
+
+		before := time.Now().Add(-time.Second)
+		if err := store.Heartbeat("test-session", "host-a:1"); err != nil {
+			t.Fatalf("failed to record heartbeat: %v", err)
+		}
+		store.Heartbeat("test-session", "host-b:2")
+		owner, at, err := store.GetHeartbeat("test-session")
+		if err != nil {
+			t.Fatalf("failed to get heartbeat: %v", err)
+		}
+		if owner != "host-b:2" || at.Before(before) {
+			t.Errorf("latest heartbeat wins: got %q at %v", owner, at)
+		}
+
+		if err := store.ClearHeartbeat("test-session"); err != nil {
+			t.Fatalf("failed to clear heartbeat: %v", err)
+		}
+		if owner, _, _ := store.GetHeartbeat("test-session"); owner != "" {
+			t.Errorf("heartbeat should be cleared, got %q", owner)
+		}
+	})
+
+	t.Run("Forks", func(t *testing.T) {
+		now := time.Now()
+		fork := &core.Debate{
+			ID:        "test-debate-fork",
+			Topic:     "Three-way",
+			ParentID:  "test-debate-multi",
+			ForkPoint: 3,
+			Style:     "collaborative",
+			MaxTurns:  2,
+			Status:    core.StatusPaused,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		fork.SetParticipants([]core.Agent{{ID: "fork-a"}, {ID: "fork-b"}})
+		if err := store.CreateDebate(fork); err != nil {
+			t.Fatalf("failed to create fork: %v", err)
+		}
+
+		got, err := store.GetDebate(fork.ID)
+		if err != nil {
+			t.Fatalf("failed to get fork: %v", err)
+		}
+		if got.ParentID != "test-debate-multi" || got.ForkPoint != 3 {
+			t.Errorf("lineage mismatch: parent %q at %d", got.ParentID, got.ForkPoint)
+		}
+
+		forks, err := store.ListDebateForks("test-debate-multi")
+		if err != nil {
+			t.Fatalf("failed to list forks: %v", err)
+		}
+		if len(forks) != 1 || forks[0].ID != fork.ID || forks[0].ForkPoint != 3 || forks[0].Status != core.StatusPaused {
+			t.Errorf("wrong forks: %+v", forks)
+		}
+		if forks, _ := store.ListDebateForks(fork.ID); len(forks) != 0 {
+			t.Errorf("expected no forks of the fork, got %d", len(forks))
+		}
+	})
+
+	t.Run("TurnVersions", func(t *testing.T) {
+		now := time.Now()
+		turn := &core.Turn{
+			ID:        "test-turn-versions",
+			DebateID:  "test-debate-fork",
+			AgentID:   "fork-a",
+			Number:    1,
+			Round:     1,
+			Content:   "First draft",
+			CreatedAt: now,
+			Status:    "failed",
+			Error:     "timeout",
+		}
+		if err := store.AddTurn(turn); err != nil {
+			t.Fatalf("failed to add turn: %v", err)
+		}
+
+		turn.PushVersion(&core.Version{Content: "Second draft", CreatedAt: now, Status: "completed"})
+		if err := store.UpdateTurn(turn); err != nil {
+			t.Fatalf("failed to update turn: %v", err)
+		}
+
+		turns, err := store.GetTurns("test-debate-fork")
+		if err != nil || len(turns) != 1 {
+			t.Fatalf("failed to get turns: %v (%d turns)", err, len(turns))
+		}
+		got := turns[0]
+		if got.Content != "Second draft" || got.Status != "completed" || got.Error != "" {
+			t.Errorf("selected version not stored: %q %s %q", got.Content, got.Status, got.Error)
+		}
+		if len(got.Versions) != 2 || got.SelectedVersion != 1 || got.Versions[0].Error != "timeout" {
+			t.Errorf("versions not stored: %+v", got.Versions)
+		}
+
+		if err := store.DeleteTurn(turn.ID); err != nil {
+			t.Fatalf("failed to delete turn: %v", err)
+		}
+		if turns, _ := store.GetTurns("test-debate-fork"); len(turns) != 0 {
+			t.Errorf("expected no turns after delete, got %d", len(turns))
+		}
+	})
+
+	t.Run("Budgets", func(t *testing.T) {
+		debate, _ := store.GetDebate("test-debate-fork")
+		debate.Budget = &core.Budget{MaxTokens: 5000, MaxDurationMs: 60000}
+		debate.StopReason = "token budget of 5000 reached (5120 used)"
+		if err := store.UpdateDebate(debate); err != nil {
+			t.Fatalf("failed to update debate: %v", err)
+		}
+		got, _ := store.GetDebate(debate.ID)
+		if got.Budget == nil || *got.Budget != *debate.Budget || got.StopReason != debate.StopReason {
+			t.Errorf("budget not stored: %+v %q", got.Budget, got.StopReason)
+		}
+
+		now := time.Now()
+		project := &core.Project{ID: "test-project-budget", Name: "Budgeted", Budget: &core.Budget{MaxCostUSD: 2.5}, CreatedAt: now, UpdatedAt: now}
+		if err := store.CreateProject(project); err != nil {
+			t.Fatalf("failed to create project: %v", err)
+		}
+		gotProject, _ := store.GetProject(project.ID)
+		if gotProject.Budget == nil || gotProject.Budget.MaxCostUSD != 2.5 {
+			t.Errorf("project budget not stored: %+v", gotProject.Budget)
+		}
+
+		gotProject.Budget = nil
+		if err := store.UpdateProject(gotProject); err != nil {
+			t.Fatalf("failed to update project: %v", err)
+		}
+		if gotProject, _ := store.GetProject(project.ID); gotProject.Budget != nil {
+			t.Errorf("expected project budget cleared, got %+v", gotProject.Budget)
+		}
+	})
+
+	t.Run("Presets", func(t *testing.T) {
+		now := time.Now()
+		preset := &core.Preset{
+			ID:        "test-preset",
+			Name:      "security-review",
+			Mode:      core.PresetCouncil,
+			Members:   []core.MemberSpec{{Provider: "claude", Model: "opus", Persona: "skeptic"}, {Provider: "gemini", Persona: "optimist"}},
+			Chairman:  &core.MemberSpec{Provider: "claude", Model: "opus"},
+			Budget:    &core.Budget{MaxTokens: 20000},
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		if err := store.CreatePreset(preset); err != nil {
+			t.Fatalf("failed to create preset: %v", err)
+		}
+		if err := store.CreatePreset(&core.Preset{ID: "test-preset-2", Name: "security-review", CreatedAt: now, UpdatedAt: now}); err == nil {
+			t.Error("expected duplicate preset name to fail")
+		}
+
+		got, err := store.GetPresetByName("security-review")
+		if err != nil || got == nil {
+			t.Fatalf("failed to get preset by name: %v", err)
+		}
+		if len(got.Members) != 2 || got.Members[0].Model != "opus" || got.Chairman == nil || got.Budget == nil || got.Budget.MaxTokens != 20000 {
+			t.Errorf("preset not stored: %+v", got)
+		}
+
+		got.Chairman, got.Style, got.Mode = nil, "adversarial", core.PresetDebate
+		if err := store.UpdatePreset(got); err != nil {
+			t.Fatalf("failed to update preset: %v", err)
+		}
+		if updated, _ := store.GetPreset(preset.ID); updated.Chairman != nil || updated.Style != "adversarial" || updated.Mode != core.PresetDebate {
+			t.Errorf("preset not updated: %+v", updated)
+		}
+
+		if err := store.DeletePreset(preset.ID); err != nil {
+			t.Fatalf("failed to delete preset: %v", err)
+		}
+		if presets, _ := store.ListPresets(); len(presets) != 0 {
+			t.Errorf("expected no presets, got %d", len(presets))
+		}
+	})
+
+	t.Run("Schedules", func(t *testing.T) {
+		now := time.Now()
+		next := now.Add(time.Hour)
+		schedule := &core.Schedule{
+			ID:          "test-schedule",
+			Name:        "runbook-review",
+			Cron:        "0 9 * * 1",
+			Topic:       "Review our on-call runbook",
+			Members:     []core.MemberSpec{{Provider: "claude", Persona: "skeptic"}, {Provider: "gemini"}},
+			WorkspaceID: "ws-1",
+			CatchUp:     core.CatchUpSkip,
+			NextRunAt:   &next,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		if err := store.CreateSchedule(schedule); err != nil {
+			t.Fatalf("failed to create schedule: %v", err)
+		}
+
+		got, err := store.GetSchedule(schedule.ID)
+		if err != nil || got == nil {
+			t.Fatalf("failed to get schedule: %v", err)
+		}
+		if len(got.Members) != 2 || got.CatchUp != core.CatchUpSkip || got.LastRunAt != nil || got.NextRunAt == nil || !got.NextRunAt.Equal(next) {
+			t.Errorf("schedule not stored: %+v", got)
+		}
+
+		got.Paused, got.LastRunAt, got.LastCouncilID = true, &now, "test-council-sched"
+		if err := store.UpdateSchedule(got); err != nil {
+			t.Fatalf("failed to update schedule: %v", err)
+		}
+		if updated, _ := store.GetSchedule(schedule.ID); !updated.Paused || updated.LastRunAt == nil || updated.LastCouncilID != "test-council-sched" {
+			t.Errorf("schedule not updated: %+v", updated)
+		}
+
+		council := &core.Council{
+			ID:         "test-council-sched",
+			Topic:      schedule.Topic,
+			ScheduleID: schedule.ID,
+			Status:     core.StatusCompleted,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+		if err := store.CreateCouncil(council); err != nil {
+			t.Fatalf("failed to create council: %v", err)
+		}
+		if c, _ := store.GetCouncil(council.ID); c == nil || c.ScheduleID != schedule.ID {
+			t.Errorf("council schedule link not stored: %+v", c)
+		}
+		runs, err := store.ListCouncilsBySchedule(schedule.ID, 10, 0)
+		if err != nil || len(runs) != 1 || runs[0].ID != council.ID {
+			t.Errorf("ListCouncilsBySchedule() = %v, %v", runs, err)
+		}
+
+		if err := store.DeleteSchedule(schedule.ID); err != nil {
+			t.Fatalf("failed to delete schedule: %v", err)
+		}
+		if schedules, _ := store.ListSchedules(); len(schedules) != 0 {
+			t.Errorf("expected no schedules, got %d", len(schedules))
+		}
+	})
+
+	t.Run("StylePhases", func(t *testing.T) {
+		st := &Style{
+			ID:   "test-phased",
+			Name: "Phased",
+			Phases: []core.Phase{
+				{ID: "opening", Turns: 1, Prompt: "Open on {{.Topic}}"},
+				{ID: "dialogue", Roles: []core.PhaseRole{{Name: "Questioner", Prompt: "Ask"}, {Name: "Responder"}}, Prompt: "Answer"},
+			},
+		}
+		if err := store.CreateStyle(st); err != nil {
+			t.Fatalf("failed to create style: %v", err)
+		}
+
+		got, err := store.GetStyle(st.ID)
+		if err != nil || got == nil {
+			t.Fatalf("failed to get style: %v", err)
+		}
+		if len(got.Phases) != 2 || got.Phases[0].Turns != 1 || len(got.Phases[1].Roles) != 2 || got.Phases[1].Roles[0].Prompt != "Ask" {
+			t.Errorf("phases not stored: %+v", got.Phases)
+		}
+
+		got.Phases = nil
+		if err := store.UpdateStyle(got); err != nil {
+			t.Fatalf("failed to update style: %v", err)
+		}
+		if got, _ := store.GetStyle(st.ID); len(got.Phases) != 0 {
+			t.Errorf("expected phases cleared, got %+v", got.Phases)
+		}
+	})
 }
@@ -2,9 +2,16 @@
 package storage
 
 import (
+	"errors"
+	"time"
+
 	"github.com/alienxp03/conclave/internal/core"
 )
 
+// ErrCouncilNotFound is returned by GetCouncil for an unknown ID, where
+// the other getters return a nil record.
+var ErrCouncilNotFound = errors.New("council not found")
+
 // Storage defines the interface for debate persistence.
 type Storage interface {
 	// Initialize sets up the storage (creates tables, etc.)
@@ -24,9 +31,16 @@ type Storage interface {
 
 	// Turn operations
 	AddTurn(turn *core.Turn) error
+	UpdateTurn(turn *core.Turn) error
+	DeleteTurn(id string) error
 	GetTurns(debateID string) ([]*core.Turn, error)
 	GetLatestTurn(debateID string) (*core.Turn, error)
 
+	// Argument graph operations: claims extracted from debate turns
+	SaveTurnClaims(turn *core.Turn, claims []*core.Claim) error
+	GetDebateClaims(debateID string) (map[string][]*core.Claim, error)
+	DeleteDebateClaims(debateID string, turnNumber int) error
+
 	// Persona operations
 	GetPersona(id string) (*Persona, error)
 	ListPersonas(includeBuiltin bool) ([]*Persona, error)
@@ -46,11 +60,13 @@ type Storage interface {
 
 	// Response operations
 	AddResponse(response *core.Response) error
+	UpdateResponse(response *core.Response) error
 	GetResponses(councilID string) ([]*core.Response, error)
 
 	// Ranking operations
 	AddRanking(ranking *core.Ranking) error
 	GetRankings(councilID string) ([]*core.Ranking, error)
+	DeleteRankings(councilID string, round int) error
 
 	// Project operations
 	CreateProject(project *core.Project) error
@@ -58,4 +74,54 @@ type Storage interface {
 	UpdateProject(project *core.Project) error
 	DeleteProject(id string) error
 	ListProjects(limit, offset int) ([]*core.Project, error)
+
+	// Run control: pause or cancel requests for sessions running in another process
+	RequestStop(sessionID string, status core.DebateStatus) error
+	TakeStopRequest(sessionID string) (core.DebateStatus, error)
+	ClearStopRequest(sessionID string) error
+
+	// Run heartbeats: which process is running a session and when it was last alive
+	Heartbeat(sessionID, owner string) error
+	GetHeartbeat(sessionID string) (owner string, beatAt time.Time, err error)
+	ClearHeartbeat(sessionID string) error
+
+	// Recovery: sessions a previous process left in a given status
+	ListDebateIDsByStatus(status core.DebateStatus) ([]string, error)
+	ListCouncilIDsByStatus(status core.DebateStatus) ([]string, error)
+
+	// Fork lineage: sessions forked directly from a parent
+	ListDebateForks(parentID string) ([]*core.ForkNode, error)
+	ListCouncilForks(parentID string) ([]*core.ForkNode, error)
+
+	// Swap-sides reruns: the latest rerun of a debate with its stances swapped
+	GetSwappedDebate(id string) (*core.Debate, error)
+
+	// Tournament operations
+	CreateTournament(t *core.Tournament) error
+	GetTournament(id string) (*core.Tournament, error)
+	UpdateTournament(t *core.Tournament) error
+	ListTournaments(limit, offset int) ([]*core.Tournament, error)
+	AddTournamentMatch(m *core.TournamentMatch) error
+	UpdateTournamentMatch(m *core.TournamentMatch) error
+
+	// Leaderboard: Elo ratings from judged tournament matches
+	GetRating(entrant string) (*core.Rating, error)
+	SaveRating(r *core.Rating) error
+	ListRatings() ([]*core.Rating, error)
+
+	// Preset operations: named session settings
+	CreatePreset(p *core.Preset) error
+	GetPreset(id string) (*core.Preset, error)
+	GetPresetByName(name string) (*core.Preset, error)
+	UpdatePreset(p *core.Preset) error
+	DeletePreset(id string) error
+	ListPresets() ([]*core.Preset, error)
+
+	// Schedule operations: councils started on a cron schedule
+	CreateSchedule(sc *core.Schedule) error
+	GetSchedule(id string) (*core.Schedule, error)
+	UpdateSchedule(sc *core.Schedule) error
+	DeleteSchedule(id string) error
+	ListSchedules() ([]*core.Schedule, error)
+	ListCouncilsBySchedule(scheduleID string, limit, offset int) ([]*core.CouncilSummary, error)
 }
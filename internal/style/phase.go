@@ -0,0 +1,133 @@
+package style
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// PhaseTurns returns how many turns each agent takes in each of the style's
+// phases for a debate with maxTurns turns per agent. Flexible phases split
+// what the fixed phases leave over, earlier phases taking any remainder.
+func (s *Style) PhaseTurns(maxTurns int) []int {
+	counts := make([]int, len(s.Phases))
+	fixed, flexible := 0, 0
+	for i, p := range s.Phases {
+		if p.Turns > 0 {
+			counts[i] = p.Turns
+			fixed += p.Turns
+		} else {
+			flexible++
+		}
+	}
+	if flexible == 0 || maxTurns <= fixed {
+		return counts
+	}
+
+	left := maxTurns - fixed
+	extra := left % flexible
+	for i, p := range s.Phases {
+		if p.Turns > 0 {
+			continue
+		}
+		counts[i] = left / flexible
+		if extra > 0 {
+			counts[i]++
+			extra--
+		}
+	}
+	return counts
+}
+
+// TurnsPerAgent returns the turns each agent takes in a round. Styles
+// without phases use maxTurns; phased styles take the sum of their phases.
+func (s *Style) TurnsPerAgent(maxTurns int) int {
+	if len(s.Phases) == 0 {
+		return maxTurns
+	}
+	total := 0
+	for _, n := range s.PhaseTurns(maxTurns) {
+		total += n
+	}
+	return total
+}
+
+// PhaseAt returns the phase that turn (1-based within the round) falls in
+// and which pass through the speaking order it is within the phase
+// (1-based). It returns nil for styles without phases.
+func (s *Style) PhaseAt(turn, participants, maxTurns int) (*core.Phase, int) {
+	if len(s.Phases) == 0 || participants < 1 {
+		return nil, 0
+	}
+
+	counts := s.PhaseTurns(maxTurns)
+	last := -1
+	for i, n := range counts {
+		if n == 0 {
+			continue
+		}
+		last = i
+		span := n * participants
+		if turn <= span {
+			return &s.Phases[i], (turn-1)/participants + 1
+		}
+		turn -= span
+	}
+	if last < 0 {
+		return nil, 0
+	}
+	// Past the end of the round: stay in the last phase
+	return &s.Phases[last], counts[last]
+}
+
+// Validate checks that a style defines either the three classic prompts or
+// a list of phases, and that every template parses.
+func (s *Style) Validate() error {
+	if len(s.Phases) == 0 {
+		if s.OpeningPrompt == "" || s.ResponsePrompt == "" || s.ConclusionPrompt == "" {
+			return fmt.Errorf("a style needs opening, response and conclusion prompts, or phases")
+		}
+		for name, text := range map[string]string{"opening": s.OpeningPrompt, "response": s.ResponsePrompt, "conclusion": s.ConclusionPrompt} {
+			if _, err := template.New(name).Parse(text); err != nil {
+				return fmt.Errorf("invalid %s prompt: %w", name, err)
+			}
+		}
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for i, p := range s.Phases {
+		label := p.ID
+		if label == "" {
+			return fmt.Errorf("phase %d needs an id", i+1)
+		}
+		if seen[label] {
+			return fmt.Errorf("duplicate phase id: %s", label)
+		}
+		seen[label] = true
+		if p.Turns < 0 {
+			return fmt.Errorf("phase %s: turns cannot be negative", label)
+		}
+
+		for _, r := range p.Roles {
+			if strings.TrimSpace(r.Name) == "" {
+				return fmt.Errorf("phase %s: roles need a name", label)
+			}
+			if r.Prompt == "" && p.Prompt == "" {
+				return fmt.Errorf("phase %s: role %s needs a prompt (the phase has none)", label, r.Name)
+			}
+			if _, err := template.New(r.Name).Parse(r.Prompt); err != nil {
+				return fmt.Errorf("phase %s: invalid prompt for role %s: %w", label, r.Name, err)
+			}
+		}
+		if p.Prompt == "" && len(p.Roles) == 0 {
+			return fmt.Errorf("phase %s needs a prompt", label)
+		}
+		if _, err := template.New(label).Parse(p.Prompt); err != nil {
+			return fmt.Errorf("phase %s: invalid prompt: %w", label, err)
+		}
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 // Package style defines debate styles and formats.
 package style
 
+import "github.com/alienxp03/conclave/internal/core"
+
 // Style represents a debate format/approach.
 type Style struct {
 	ID              string `json:"id"`
@@ -9,6 +11,12 @@ type Style struct {
 	OpeningPrompt   string `json:"opening_prompt"`
 	ResponsePrompt  string `json:"response_prompt"`
 	ConclusionPrompt string `json:"conclusion_prompt"`
+	// Early consensus detection for debates in this style (empty uses the default)
+	ConsensusMethod    core.ConsensusMethod `json:"consensus_method,omitempty"`
+	ConsensusThreshold float64              `json:"consensus_threshold,omitempty"`
+	// Phases replace the three prompts above when set: each round runs
+	// the phases in order, with their own prompts, turns and roles
+	Phases []core.Phase `json:"phases,omitempty"`
 }
 
 // DefaultStyles returns the built-in debate styles.
@@ -20,7 +28,12 @@ func DefaultStyles() []Style {
 			Description: "Agents argue opposite sides of the topic",
 			OpeningPrompt: `You are participating in an adversarial debate on the topic: "{{.Topic}}"
 
-You must argue FOR your assigned position. Present your strongest opening argument.
+{{if .Stance}}Your assigned position: {{.Stance}}
+{{if .Positions}}
+Positions in this debate:
+{{.Positions}}
+{{end}}
+{{end}}You must argue FOR your assigned position. Present your strongest opening argument.
 Be persuasive but fair. Anticipate counterarguments.
 
 Your opening statement (2-3 paragraphs):`,
@@ -30,7 +43,12 @@ Your opponent just argued:
 ---
 {{.PreviousArgument}}
 ---
-
+{{if .Stance}}
+You argue {{.Stance}}.{{if .OtherAgentStance}} Your opponent argues {{.OtherAgentStance}}.{{end}}
+{{end}}{{if .ModeratorQuestion}}
+The moderator asks: {{.ModeratorQuestion}}
+Address this question directly.
+{{end}}
 Counter their points and strengthen your position. Find weaknesses in their argument.
 Stay focused on winning the debate while remaining intellectually honest.
 
@@ -40,7 +58,8 @@ Your response:`,
 Review the full debate:
 {{.DebateHistory}}
 
-Provide your final conclusion. State whether you've found any merit in your opponent's arguments.
+{{if .Stance}}You argued {{.Stance}}.
+{{end}}Provide your final conclusion. State whether you've found any merit in your opponent's arguments.
 If you can agree on common ground, state it clearly. If not, summarize your final position.
 
 Your conclusion:`,
@@ -61,7 +80,10 @@ Your partner shared:
 ---
 {{.PreviousArgument}}
 ---
-
+{{if .ModeratorQuestion}}
+The moderator asks: {{.ModeratorQuestion}}
+Address this question directly.
+{{end}}
 Build on their ideas. Add your perspective. Identify areas of agreement and explore differences constructively.
 Aim to synthesize viewpoints into something better than either alone.
 
@@ -95,7 +117,10 @@ Your partner's analysis:
 ---
 {{.PreviousArgument}}
 ---
-
+{{if .ModeratorQuestion}}
+The moderator asks: {{.ModeratorQuestion}}
+Address this question directly.
+{{end}}
 Review their analysis. Add perspectives they may have missed.
 Challenge any assumptions you find questionable. Refine the evaluation.
 
@@ -117,22 +142,45 @@ Your summary:`,
 			ID:          "socratic",
 			Name:        "Socratic",
 			Description: "One agent probes with questions, the other defends",
-			OpeningPrompt: `You are engaging in a Socratic dialogue on: "{{.Topic}}"
+			Phases: []core.Phase{
+				{
+					ID:    "opening",
+					Name:  "Opening",
+					Turns: 1,
+					Roles: []core.PhaseRole{
+						{
+							Name: "Questioner",
+							Prompt: `You are engaging in a Socratic dialogue on: "{{.Topic}}"
 
-{{if .IsQuestioner}}
 You are the QUESTIONER. Ask probing questions to explore the topic deeply.
 Challenge assumptions. Seek clarity. Help uncover hidden complexities.
 
-Your opening questions:
-{{else}}
+Your opening questions:`,
+						},
+						{
+							Name: "Responder",
+							Prompt: `You are engaging in a Socratic dialogue on: "{{.Topic}}"
+
 You are the RESPONDER. Present your position on the topic clearly.
 Be prepared to defend and refine your thinking through questioning.
+{{if .PreviousArgument}}
+The questioner asked:
+---
+{{.PreviousArgument}}
+---
+{{end}}
+Your opening position:`,
+						},
+					},
+				},
+				{
+					ID:   "dialogue",
+					Name: "Dialogue",
+					Roles: []core.PhaseRole{
+						{
+							Name: "Questioner",
+							Prompt: `You are in a Socratic dialogue on: "{{.Topic}}"
 
-Your opening position:
-{{end}}`,
-			ResponsePrompt: `You are in a Socratic dialogue on: "{{.Topic}}"
-
-{{if .IsQuestioner}}
 The responder said:
 ---
 {{.PreviousArgument}}
@@ -141,8 +189,12 @@ The responder said:
 Ask follow-up questions that probe deeper. Challenge their reasoning.
 Help them (and yourself) reach greater understanding.
 
-Your questions:
-{{else}}
+Your questions:`,
+						},
+						{
+							Name: "Responder",
+							Prompt: `You are in a Socratic dialogue on: "{{.Topic}}"
+
 The questioner asked:
 ---
 {{.PreviousArgument}}
@@ -151,9 +203,15 @@ The questioner asked:
 Answer thoughtfully. Refine your position if the questions reveal weaknesses.
 Be honest about uncertainties.
 
-Your response:
-{{end}}`,
-			ConclusionPrompt: `The Socratic dialogue on "{{.Topic}}" is concluding.
+Your response:`,
+						},
+					},
+				},
+				{
+					ID:    "reflection",
+					Name:  "Reflection",
+					Turns: 1,
+					Prompt: `The Socratic dialogue on "{{.Topic}}" is concluding.
 
 Full dialogue:
 {{.DebateHistory}}
@@ -162,6 +220,81 @@ What insights emerged from this inquiry? What was clarified?
 What questions remain open?
 
 Your reflection:`,
+				},
+			},
+		},
+		{
+			ID:          "formal",
+			Name:        "Formal",
+			Description: "Structured debate: opening statements, cross-examination, rebuttal and closing",
+			Phases: []core.Phase{
+				{
+					ID:    "opening",
+					Name:  "Opening Statements",
+					Turns: 1,
+					Prompt: `You are participating in a formal debate on: "{{.Topic}}"
+{{if .Stance}}
+Your assigned position: {{.Stance}}
+{{end}}
+This is the OPENING STATEMENTS phase. Set out your position and the two or three
+arguments you will rely on. Do not rebut anyone yet.
+
+Your opening statement (2-3 paragraphs):`,
+				},
+				{
+					ID:    "cross_examination",
+					Name:  "Cross-Examination",
+					Turns: 1,
+					Prompt: `You are in the CROSS-EXAMINATION phase of a formal debate on: "{{.Topic}}"
+{{if .Stance}}
+You argue {{.Stance}}.
+{{end}}
+Debate so far:
+{{.DebateHistory}}
+
+First answer, directly and briefly, any questions that were put to you.
+Then ask {{.OtherAgentName}} up to three pointed questions that expose the weakest
+points of their case. Do not make new arguments.
+
+Your answers and questions:`,
+				},
+				{
+					ID:   "rebuttal",
+					Name: "Rebuttal",
+					Prompt: `You are in the REBUTTAL phase of a formal debate on: "{{.Topic}}"
+{{if .Stance}}
+You argue {{.Stance}}.
+{{end}}
+The last contribution was:
+---
+{{.PreviousArgument}}
+---
+{{if .ModeratorQuestion}}
+The moderator asks: {{.ModeratorQuestion}}
+Address this question directly.
+{{end}}
+Rebut the strongest points made against your position, using what the
+cross-examination revealed. Reinforce your own arguments.
+
+Your rebuttal:`,
+				},
+				{
+					ID:    "closing",
+					Name:  "Closing Statements",
+					Turns: 1,
+					Prompt: `The formal debate on "{{.Topic}}" is ending. This is your CLOSING STATEMENT.
+{{if .Stance}}
+You argued {{.Stance}}.
+{{end}}
+Full debate:
+{{.DebateHistory}}
+
+Summarize why your position prevailed. Acknowledge any points you concede, and
+state clearly where you and the other side still disagree.
+
+Your closing statement:`,
+				},
+			},
 		},
 	}
 }
@@ -205,6 +338,7 @@ type StoredStyle struct {
 	OpeningPrompt    string
 	ResponsePrompt   string
 	ConclusionPrompt string
+	Phases           []core.Phase
 	IsBuiltin        bool
 }
 
@@ -231,6 +365,7 @@ func GetWithStore(id string, store StyleStore) *Style {
 				OpeningPrompt:    stored.OpeningPrompt,
 				ResponsePrompt:   stored.ResponsePrompt,
 				ConclusionPrompt: stored.ConclusionPrompt,
+				Phases:           stored.Phases,
 			}
 		}
 	}
@@ -1,29 +1,27 @@
 package style
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
 
 func TestDefaultStyles(t *testing.T) {
 	styles := DefaultStyles()
 
-	if len(styles) != 4 {
-		t.Errorf("wrong count: got %d, want 4", len(styles))
+	if len(styles) != 5 {
+		t.Errorf("wrong count: got %d, want 5", len(styles))
 	}
 
 	// Check required styles exist
-	required := []string{"adversarial", "collaborative", "analytical", "socratic"}
+	required := []string{"adversarial", "collaborative", "analytical", "socratic", "formal"}
 	for _, id := range required {
 		found := false
 		for _, s := range styles {
 			if s.ID == id {
 				found = true
-				if s.OpeningPrompt == "" {
-					t.Errorf("style %s has empty OpeningPrompt", id)
-				}
-				if s.ResponsePrompt == "" {
-					t.Errorf("style %s has empty ResponsePrompt", id)
-				}
-				if s.ConclusionPrompt == "" {
-					t.Errorf("style %s has empty ConclusionPrompt", id)
+				if err := s.Validate(); err != nil {
+					t.Errorf("style %s is invalid: %v", id, err)
 				}
 				break
 			}
@@ -55,8 +53,8 @@ func TestGet(t *testing.T) {
 
 func TestList(t *testing.T) {
 	ids := List()
-	if len(ids) != 4 {
-		t.Errorf("wrong count: got %d, want 4", len(ids))
+	if len(ids) != 5 {
+		t.Errorf("wrong count: got %d, want 5", len(ids))
 	}
 }
 
@@ -78,3 +76,106 @@ func TestDefault(t *testing.T) {
 		t.Errorf("wrong default style: got %s, want collaborative", d.ID)
 	}
 }
+
+func TestPhases(t *testing.T) {
+	s := &Style{Phases: []core.Phase{
+		{ID: "opening", Turns: 1, Prompt: "open"},
+		{ID: "rebuttal", Prompt: "rebut"},
+		{ID: "cross", Prompt: "cross"},
+		{ID: "closing", Turns: 1, Prompt: "close"},
+	}}
+
+	t.Run("PhaseTurns", func(t *testing.T) {
+		tests := []struct {
+			maxTurns int
+			want     []int
+		}{
+			{5, []int{1, 2, 1, 1}},
+			{6, []int{1, 2, 2, 1}},
+			{2, []int{1, 0, 0, 1}},
+			{0, []int{1, 0, 0, 1}},
+		}
+		for _, tt := range tests {
+			got := s.PhaseTurns(tt.maxTurns)
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("PhaseTurns(%d) = %v, want %v", tt.maxTurns, got, tt.want)
+					break
+				}
+			}
+		}
+		if got := s.TurnsPerAgent(2); got != 2 {
+			t.Errorf("TurnsPerAgent(2) = %d, want 2", got)
+		}
+		if got := Default().TurnsPerAgent(3); got != 3 {
+			t.Errorf("classic TurnsPerAgent(3) = %d, want 3", got)
+		}
+	})
+
+	t.Run("PhaseAt", func(t *testing.T) {
+		// Two agents, 5 turns each: opening 1-2, rebuttal 3-6, cross 7-8, closing 9-10
+		tests := []struct {
+			turn      int
+			wantPhase string
+			wantPass  int
+		}{
+			{1, "opening", 1},
+			{2, "opening", 1},
+			{3, "rebuttal", 1},
+			{6, "rebuttal", 2},
+			{7, "cross", 1},
+			{10, "closing", 1},
+			{11, "closing", 1},
+		}
+		for _, tt := range tests {
+			phase, pass := s.PhaseAt(tt.turn, 2, 5)
+			if phase == nil || phase.ID != tt.wantPhase || pass != tt.wantPass {
+				t.Errorf("PhaseAt(%d) = %v pass %d, want %s pass %d", tt.turn, phase, pass, tt.wantPhase, tt.wantPass)
+			}
+		}
+		if phase, _ := Default().PhaseAt(1, 2, 5); phase != nil {
+			t.Error("classic style has no phases")
+		}
+	})
+
+	t.Run("Roles", func(t *testing.T) {
+		socratic := Get("socratic")
+		opening := socratic.Phases[0]
+		if r := opening.RoleAt(0); r == nil || r.Name != "Questioner" {
+			t.Errorf("first speaker role = %v, want Questioner", r)
+		}
+		if r := opening.RoleAt(3); r == nil || r.Name != "Responder" {
+			t.Errorf("fourth speaker role = %v, want Responder", r)
+		}
+		if r := s.Phases[0].RoleAt(0); r != nil {
+			t.Errorf("phase without roles returned %v", r)
+		}
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			phases []core.Phase
+		}{
+			{"missing id", []core.Phase{{Prompt: "x"}}},
+			{"duplicate id", []core.Phase{{ID: "a", Prompt: "x"}, {ID: "a", Prompt: "y"}}},
+			{"missing prompt", []core.Phase{{ID: "a"}}},
+			{"role without prompt", []core.Phase{{ID: "a", Roles: []core.PhaseRole{{Name: "Q"}}}}},
+			{"bad template", []core.Phase{{ID: "a", Prompt: "{{.Topic"}}},
+			{"negative turns", []core.Phase{{ID: "a", Turns: -1, Prompt: "x"}}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if err := (&Style{Phases: tt.phases}).Validate(); err == nil {
+					t.Error("expected an error")
+				}
+			})
+		}
+		if err := s.Validate(); err != nil {
+			t.Errorf("valid phases rejected: %v", err)
+		}
+		if err := (&Style{OpeningPrompt: "a", ResponsePrompt: "b"}).Validate(); err == nil {
+			t.Error("expected an error for a missing conclusion prompt")
+		}
+	})
+}
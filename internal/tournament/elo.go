@@ -0,0 +1,43 @@
+package tournament
+
+import (
+	"math"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+)
+
+// EloK is how far one match can move a rating.
+const EloK = 32
+
+// ExpectedScore is the score (1 win, ½ draw, 0 loss) an entrant rated a is
+// expected to take from an entrant rated b.
+func ExpectedScore(a, b float64) float64 {
+	return 1 / (1 + math.Pow(10, (b-a)/400))
+}
+
+// UpdateRatings applies one match to both entrants' ratings and records.
+// scoreA is a's result: 1 for a win, 0.5 for a draw, 0 for a loss.
+func UpdateRatings(a, b *core.Rating, scoreA float64) {
+	expectedA := ExpectedScore(a.Rating, b.Rating)
+	delta := EloK * (scoreA - expectedA)
+	a.Rating += delta
+	b.Rating -= delta
+
+	now := time.Now()
+	for _, r := range []*core.Rating{a, b} {
+		r.Matches++
+		r.UpdatedAt = now
+	}
+	switch scoreA {
+	case 1:
+		a.Wins++
+		b.Losses++
+	case 0:
+		a.Losses++
+		b.Wins++
+	default:
+		a.Draws++
+		b.Draws++
+	}
+}
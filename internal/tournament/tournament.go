@@ -0,0 +1,629 @@
+// Package tournament runs judged debates between combinations of provider,
+// model and persona, and keeps an Elo leaderboard of the results.
+package tournament
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+	"github.com/alienxp03/conclave/internal/engine"
+	"github.com/alienxp03/conclave/internal/run"
+	"github.com/alienxp03/conclave/internal/storage"
+)
+
+// Defaults for tournament settings left unset.
+const (
+	DefaultStyle    = "adversarial"
+	DefaultMaxTurns = 3
+	DefaultWorkers  = 2
+)
+
+// Runner creates and runs tournaments on top of the debate engine.
+type Runner struct {
+	storage storage.Storage
+	engine  *engine.Engine
+	runs    *run.Manager // Tournaments running in the background
+	ratings sync.Mutex   // Serializes leaderboard updates across tournaments
+}
+
+// Callbacks report tournament progress. All fields are optional and may be
+// called from several workers at once.
+type Callbacks struct {
+	OnMatchStart    func(match *core.TournamentMatch)
+	OnMatchComplete func(match *core.TournamentMatch)
+}
+
+// New creates a tournament runner.
+func New(store storage.Storage, eng *engine.Engine) *Runner {
+	return &Runner{storage: store, engine: eng, runs: run.NewManager(nil)}
+}
+
+// CreateTournament validates a config and saves a pending tournament with
+// its first matches: every match of a round robin, or the first round of
+// a bracket seeded by current ratings.
+func (r *Runner) CreateTournament(config core.NewTournamentConfig) (*core.Tournament, error) {
+	var topics []string
+	for _, topic := range config.Topics {
+		if topic = strings.TrimSpace(topic); topic != "" {
+			topics = append(topics, topic)
+		}
+	}
+	if len(topics) == 0 {
+		return nil, fmt.Errorf("a tournament needs at least one topic")
+	}
+
+	entrants := core.AssignDefaultPersonas(config.Entrants)
+	if len(entrants) < 2 {
+		return nil, fmt.Errorf("a tournament needs at least 2 entrants, got %d", len(entrants))
+	}
+	seen := make(map[string]bool, len(entrants))
+	for _, e := range entrants {
+		if e.Provider == "" || e.Provider == core.ProviderHuman {
+			return nil, fmt.Errorf("invalid entrant %q: a model provider is required", e.String())
+		}
+		if seen[e.String()] {
+			return nil, fmt.Errorf("duplicate entrant: %s", e.String())
+		}
+		seen[e.String()] = true
+	}
+
+	if config.Judge.Provider == "" {
+		return nil, fmt.Errorf("a judge is required to score matches")
+	}
+
+	format := config.Format
+	switch format {
+	case "":
+		format = core.TournamentRoundRobin
+	case core.TournamentRoundRobin, core.TournamentBracket:
+	default:
+		return nil, fmt.Errorf("invalid tournament format: %s", format)
+	}
+
+	style := config.Style
+	if style == "" {
+		style = DefaultStyle
+	}
+	maxTurns := config.MaxTurns
+	if maxTurns <= 0 {
+		maxTurns = DefaultMaxTurns
+	}
+	workers := config.Workers
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+
+	name := strings.TrimSpace(config.Name)
+	if name == "" {
+		name = topics[0]
+	}
+
+	// A bracket stores its entrants in seed order, so later rounds can be
+	// rebuilt from the matches alone
+	if format == core.TournamentBracket {
+		entrants = r.seed(entrants)
+	}
+
+	now := time.Now()
+	t := &core.Tournament{
+		ID:        core.GenerateID(),
+		Name:      name,
+		Topics:    topics,
+		Entrants:  entrants,
+		Judge:     config.Judge,
+		Format:    format,
+		Style:     style,
+		MaxTurns:  maxTurns,
+		Workers:   workers,
+		Status:    core.StatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := r.storage.CreateTournament(t); err != nil {
+		return nil, fmt.Errorf("failed to save tournament: %w", err)
+	}
+
+	var matches []*core.TournamentMatch
+	if format == core.TournamentBracket {
+		matches = bracketRound(t, entrantKeys(entrants), 1)
+	} else {
+		matches = roundRobin(t)
+	}
+	if err := r.addMatches(t, matches); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// GetTournament retrieves a tournament and its matches.
+func (r *Runner) GetTournament(id string) (*core.Tournament, error) {
+	t, err := r.storage.GetTournament(id)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, fmt.Errorf("tournament not found: %s", id)
+	}
+	return t, nil
+}
+
+// ListTournaments returns tournaments, newest first.
+func (r *Runner) ListTournaments(limit, offset int) ([]*core.Tournament, error) {
+	return r.storage.ListTournaments(limit, offset)
+}
+
+// Leaderboard returns every rated entrant, highest rating first.
+func (r *Runner) Leaderboard() ([]*core.Rating, error) {
+	return r.storage.ListRatings()
+}
+
+// Start runs a tournament in the background until it ends or ctx is done,
+// which leaves it paused. It returns run.ErrAlreadyRunning if the tournament
+// is already running here.
+func (r *Runner) Start(ctx context.Context, t *core.Tournament) error {
+	return r.runs.StartContext(ctx, t.ID, func(ctx context.Context) error {
+		return r.Run(ctx, t, nil)
+	})
+}
+
+// Pause stops a tournament running in the background; matches in progress
+// are paused and their debates resume when the tournament does. It reports whether the
+// tournament was running.
+func (r *Runner) Pause(id string) bool {
+	return r.runs.Pause(id)
+}
+
+// IsRunning reports whether a tournament is running in the background.
+func (r *Runner) IsRunning(id string) bool {
+	return r.runs.Running(id)
+}
+
+// Wait blocks until a background tournament has stopped or ctx ends.
+func (r *Runner) Wait(ctx context.Context, id string) error {
+	return r.runs.Wait(ctx, id)
+}
+
+// WaitAll blocks until every background tournament has stopped or ctx ends.
+func (r *Runner) WaitAll(ctx context.Context) error {
+	return r.runs.WaitAll(ctx)
+}
+
+// Run plays every unfinished match of a tournament, t.Workers debates at a
+// time, and advances a bracket round by round. Running a failed or paused
+// tournament again retries the matches that did not finish.
+func (r *Runner) Run(ctx context.Context, t *core.Tournament, callbacks *Callbacks) error {
+	slog.Info("Starting tournament", "tournament_id", t.ID, "format", t.Format, "matches", len(t.Matches))
+
+	t.Status = core.StatusInProgress
+	t.CompletedAt = nil
+	if err := r.storage.UpdateTournament(t); err != nil {
+		return fmt.Errorf("failed to update tournament: %w", err)
+	}
+
+	round := 1
+	for {
+		var pending []*core.TournamentMatch
+		for _, m := range t.Matches {
+			if m.Round == round || t.Format == core.TournamentRoundRobin {
+				if m.Status != core.StatusCompleted {
+					pending = append(pending, m)
+				}
+			}
+		}
+		r.playMatches(ctx, t, pending, callbacks)
+
+		if ctx.Err() != nil {
+			return r.finish(t, core.StatusPaused, context.Cause(ctx))
+		}
+		if failed := countFailed(pending); failed > 0 {
+			return r.finish(t, core.StatusFailed, fmt.Errorf("%d of %d matches failed", failed, len(pending)))
+		}
+		if t.Format != core.TournamentBracket {
+			break
+		}
+
+		survivors := advancing(t, round)
+		if len(survivors) < 2 {
+			break
+		}
+		round++
+		if !hasRound(t, round) {
+			if err := r.addMatches(t, bracketRound(t, survivors, round)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return r.finish(t, core.StatusCompleted, nil)
+}
+
+// finish records how a run ended. cause is returned unchanged.
+func (r *Runner) finish(t *core.Tournament, status core.DebateStatus, cause error) error {
+	t.Status = status
+	if status == core.StatusCompleted {
+		now := time.Now()
+		t.CompletedAt = &now
+	}
+	if err := r.storage.UpdateTournament(t); err != nil {
+		return fmt.Errorf("failed to update tournament: %w", err)
+	}
+	slog.Info("Tournament ended", "tournament_id", t.ID, "status", status)
+	return cause
+}
+
+// playMatches runs matches on a pool of t.Workers goroutines and waits for
+// all of them. Workers stop picking up matches once ctx is done.
+func (r *Runner) playMatches(ctx context.Context, t *core.Tournament, matches []*core.TournamentMatch, callbacks *Callbacks) {
+	queue := make(chan *core.TournamentMatch)
+	var wg sync.WaitGroup
+	for i := 0; i < t.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for m := range queue {
+				r.playMatch(ctx, t, m, callbacks)
+			}
+		}()
+	}
+
+	for _, m := range matches {
+		if ctx.Err() != nil {
+			break
+		}
+		queue <- m
+	}
+	close(queue)
+	wg.Wait()
+}
+
+// playMatch runs one match's debate, records the judge's verdict and updates
+// the leaderboard. Errors are recorded on the match.
+func (r *Runner) playMatch(ctx context.Context, t *core.Tournament, m *core.TournamentMatch, callbacks *Callbacks) {
+	if callbacks != nil && callbacks.OnMatchStart != nil {
+		callbacks.OnMatchStart(m)
+	}
+
+	// A match stopped mid-debate picks its debate up again; a failed one starts over
+	resume := m.DebateID != "" && m.Status != core.StatusFailed
+	m.Status, m.Outcome, m.Winner, m.Error = core.StatusInProgress, "", "", ""
+	debate, err := r.playDebate(ctx, t, m, resume)
+	switch {
+	case ctx.Err() != nil:
+		m.Status = core.StatusPaused
+	case err != nil:
+		m.Status, m.Error = core.StatusFailed, err.Error()
+	default:
+		m.Outcome, m.Winner = matchResult(debate, m)
+		if m.Outcome == "" {
+			m.Status, m.Error = core.StatusFailed, "the judge gave no verdict"
+			break
+		}
+		now := time.Now()
+		m.Status, m.CompletedAt = core.StatusCompleted, &now
+		if err := r.rate(m); err != nil {
+			slog.Error("Failed to update ratings", "match_id", m.ID, "error", err)
+		}
+	}
+
+	if err := r.storage.UpdateTournamentMatch(m); err != nil {
+		slog.Error("Failed to save tournament match", "match_id", m.ID, "error", err)
+	}
+	if callbacks != nil && callbacks.OnMatchComplete != nil {
+		callbacks.OnMatchComplete(m)
+	}
+}
+
+// playDebate runs the debate for a match. With resume set, the match's
+// existing debate is finished if it can be; otherwise a new debate starts.
+func (r *Runner) playDebate(ctx context.Context, t *core.Tournament, m *core.TournamentMatch, resume bool) (*core.Debate, error) {
+	if resume {
+		debate, err := r.engine.GetDebate(m.DebateID)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case debate == nil:
+		case debate.Status == core.StatusCompleted:
+			return debate, nil
+		case debate.Status == core.StatusPending || debate.Status.Resumable():
+			if err := r.engine.RunDebate(ctx, debate.ID, nil); err != nil {
+				return nil, err
+			}
+			return r.engine.GetDebate(debate.ID)
+		}
+	}
+
+	a, err := core.ParseMemberSpec(m.EntrantA)
+	if err != nil {
+		return nil, err
+	}
+	b, err := core.ParseMemberSpec(m.EntrantB)
+	if err != nil {
+		return nil, err
+	}
+	judge := t.Judge
+
+	debate, err := r.engine.CreateDebate(ctx, core.NewDebateConfig{
+		Topic:         m.Topic,
+		Agents:        []core.MemberSpec{a, b},
+		SpeakingOrder: core.SpeakingOrderRoundRobin,
+		Judge:         &judge,
+		Style:         t.Style,
+		MaxTurns:      t.MaxTurns,
+	})
+	if err != nil {
+		return nil, err
+	}
+	m.DebateID = debate.ID
+	if err := r.storage.UpdateTournamentMatch(m); err != nil {
+		return nil, err
+	}
+
+	if err := r.engine.RunDebate(ctx, debate.ID, nil); err != nil {
+		return nil, err
+	}
+	return r.engine.GetDebate(debate.ID)
+}
+
+// matchResult reads a match's outcome from its debate's final verdict.
+// The outcome is empty when the judge did not give one.
+func matchResult(debate *core.Debate, m *core.TournamentMatch) (core.VerdictOutcome, string) {
+	if debate == nil || len(debate.Conclusions) == 0 {
+		return "", ""
+	}
+	verdict := debate.Conclusions[len(debate.Conclusions)-1].Verdict
+	if verdict == nil {
+		return "", ""
+	}
+	if verdict.Outcome != core.VerdictWinner {
+		return verdict.Outcome, ""
+	}
+	for i, agent := range debate.Participants() {
+		if agent.ID == verdict.WinnerID {
+			if i == 0 {
+				return verdict.Outcome, m.EntrantA
+			}
+			return verdict.Outcome, m.EntrantB
+		}
+	}
+	return "", ""
+}
+
+// rate applies a completed match to both entrants' ratings.
+func (r *Runner) rate(m *core.TournamentMatch) error {
+	r.ratings.Lock()
+	defer r.ratings.Unlock()
+
+	a, err := r.rating(m.EntrantA)
+	if err != nil {
+		return err
+	}
+	b, err := r.rating(m.EntrantB)
+	if err != nil {
+		return err
+	}
+
+	scoreA := 0.5
+	switch m.Winner {
+	case m.EntrantA:
+		scoreA = 1
+	case m.EntrantB:
+		scoreA = 0
+	}
+	UpdateRatings(a, b, scoreA)
+
+	if err := r.storage.SaveRating(a); err != nil {
+		return err
+	}
+	return r.storage.SaveRating(b)
+}
+
+// rating returns an entrant's rating, starting at core.DefaultRating.
+func (r *Runner) rating(entrant string) (*core.Rating, error) {
+	rating, err := r.storage.GetRating(entrant)
+	if err != nil {
+		return nil, err
+	}
+	if rating == nil {
+		rating = &core.Rating{Entrant: entrant, Rating: core.DefaultRating}
+	}
+	return rating, nil
+}
+
+// seed orders entrants by rating, highest first. Unrated entrants start at
+// core.DefaultRating; ties keep their declared order.
+func (r *Runner) seed(entrants []core.MemberSpec) []core.MemberSpec {
+	ratings := make(map[string]float64, len(entrants))
+	for _, e := range entrants {
+		ratings[e.String()] = core.DefaultRating
+		if rating, err := r.storage.GetRating(e.String()); err == nil && rating != nil {
+			ratings[e.String()] = rating.Rating
+		}
+	}
+
+	seeded := append([]core.MemberSpec(nil), entrants...)
+	sort.SliceStable(seeded, func(i, j int) bool {
+		return ratings[seeded[i].String()] > ratings[seeded[j].String()]
+	})
+	return seeded
+}
+
+func (r *Runner) addMatches(t *core.Tournament, matches []*core.TournamentMatch) error {
+	for _, m := range matches {
+		if err := r.storage.AddTournamentMatch(m); err != nil {
+			return fmt.Errorf("failed to save tournament match: %w", err)
+		}
+	}
+	t.Matches = append(t.Matches, matches...)
+	return nil
+}
+
+// roundRobin pairs every entrant with every other on every topic. Seats
+// alternate between matches so neither entrant always opens (or always
+// argues FOR in an adversarial style).
+func roundRobin(t *core.Tournament) []*core.TournamentMatch {
+	keys := entrantKeys(t.Entrants)
+	var matches []*core.TournamentMatch
+	for _, topic := range t.Topics {
+		for i := 0; i < len(keys); i++ {
+			for j := i + 1; j < len(keys); j++ {
+				a, b := keys[i], keys[j]
+				if len(matches)%2 == 1 {
+					a, b = b, a
+				}
+				matches = append(matches, newMatch(t, 1, topic, a, b))
+			}
+		}
+	}
+	return matches
+}
+
+// bracketRound pairs the entrants still in a bracket, best seed against
+// worst. With an odd number the top seed gets a bye. Each round debates the
+// next topic, cycling through the list.
+func bracketRound(t *core.Tournament, seeds []string, round int) []*core.TournamentMatch {
+	topic := t.Topics[(round-1)%len(t.Topics)]
+	first := len(seeds) % 2 // Skip the seed with a bye
+	var matches []*core.TournamentMatch
+	for i, j := first, len(seeds)-1; i < j; i, j = i+1, j-1 {
+		matches = append(matches, newMatch(t, round, topic, seeds[i], seeds[j]))
+	}
+	return matches
+}
+
+// advancing returns the entrants that go through to the round after round,
+// in seed order: the bye, then the winner of each match. A drawn match is
+// won by the better seed, which always sits in seat A.
+func advancing(t *core.Tournament, round int) []string {
+	seeds := entrantKeys(t.Entrants)
+	for r := 1; r <= round; r++ {
+		var next []string
+		if len(seeds)%2 == 1 {
+			next = append(next, seeds[0])
+		}
+		for _, m := range t.Matches {
+			if m.Round != r {
+				continue
+			}
+			if m.Winner != "" {
+				next = append(next, m.Winner)
+			} else {
+				next = append(next, m.EntrantA)
+			}
+		}
+		seeds = next
+	}
+	return seeds
+}
+
+func hasRound(t *core.Tournament, round int) bool {
+	for _, m := range t.Matches {
+		if m.Round == round {
+			return true
+		}
+	}
+	return false
+}
+
+func newMatch(t *core.Tournament, round int, topic, a, b string) *core.TournamentMatch {
+	return &core.TournamentMatch{
+		ID:           core.GenerateID(),
+		TournamentID: t.ID,
+		Round:        round,
+		Topic:        topic,
+		EntrantA:     a,
+		EntrantB:     b,
+		Status:       core.StatusPending,
+		CreatedAt:    time.Now(),
+	}
+}
+
+func entrantKeys(entrants []core.MemberSpec) []string {
+	keys := make([]string, len(entrants))
+	for i, e := range entrants {
+		keys[i] = e.String()
+	}
+	return keys
+}
+
+func countFailed(matches []*core.TournamentMatch) int {
+	failed := 0
+	for _, m := range matches {
+		if m.Status != core.StatusCompleted {
+			failed++
+		}
+	}
+	return failed
+}
+
+// Winner returns the tournament's champion: the last bracket survivor, or
+// the entrant with the most match points (win 1, draw ½) in a round robin.
+// It returns "" until the tournament has completed.
+func Winner(t *core.Tournament) string {
+	if t.Status != core.StatusCompleted || len(t.Matches) == 0 {
+		return ""
+	}
+	if t.Format == core.TournamentBracket {
+		last := t.Matches[len(t.Matches)-1].Round
+		if survivors := advancing(t, last); len(survivors) == 1 {
+			return survivors[0]
+		}
+		return ""
+	}
+
+	standings := Standings(t)
+	return standings[0].Entrant
+}
+
+// Standing is an entrant's record within one tournament.
+type Standing struct {
+	Entrant string  `json:"entrant"`
+	Points  float64 `json:"points"` // Win 1, draw ½
+	Wins    int     `json:"wins"`
+	Losses  int     `json:"losses"`
+	Draws   int     `json:"draws"`
+}
+
+// Standings tallies the completed matches of a tournament, most points
+// first; ties keep the entrants' declared order.
+func Standings(t *core.Tournament) []*Standing {
+	byEntrant := make(map[string]*Standing, len(t.Entrants))
+	standings := make([]*Standing, len(t.Entrants))
+	for i, key := range entrantKeys(t.Entrants) {
+		standings[i] = &Standing{Entrant: key}
+		byEntrant[key] = standings[i]
+	}
+
+	for _, m := range t.Matches {
+		a, b := byEntrant[m.EntrantA], byEntrant[m.EntrantB]
+		if m.Status != core.StatusCompleted || a == nil || b == nil {
+			continue
+		}
+		switch m.Winner {
+		case m.EntrantA:
+			a.Wins++
+			a.Points++
+			b.Losses++
+		case m.EntrantB:
+			b.Wins++
+			b.Points++
+			a.Losses++
+		default:
+			a.Draws++
+			b.Draws++
+			a.Points += 0.5
+			b.Points += 0.5
+		}
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		return standings[i].Points > standings[j].Points
+	})
+	return standings
+}
@@ -0,0 +1,300 @@
+package tournament
+
+import (
+	"context"
+	"errors"
+	"math"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alienxp03/conclave/internal/core"
+	"github.com/alienxp03/conclave/internal/engine"
+	"github.com/alienxp03/conclave/internal/provider"
+	"github.com/alienxp03/conclave/internal/run"
+	"github.com/alienxp03/conclave/internal/storage"
+	extprovider "github.com/alienxp03/conclave/provider"
+)
+
+var historyPattern = regexp.MustCompile(`--- (.+?) \(Turn \d+\) ---\n(.*)`)
+
+// mockProvider argues strongly or weakly depending on its name. The judge
+// declares the participant who argued strongly the winner, or a draw.
+type mockProvider struct {
+	name string
+}
+
+func (m *mockProvider) Name() string    { return m.name }
+func (m *mockProvider) Available() bool { return true }
+
+func (m *mockProvider) Execute(ctx context.Context, req *extprovider.Request) (*extprovider.Response, error) {
+	content := "A " + m.name + " argument."
+	if strings.Contains(req.Prompt, "You are an impartial judge") {
+		var lines []string
+		outcome := "DRAW"
+		for _, match := range historyPattern.FindAllStringSubmatch(req.Prompt, -1) {
+			score := "5"
+			if strings.Contains(match[2], "strong") {
+				score, outcome = "8", "WINNER "+match[1]
+			}
+			lines = append(lines, "SCORE: "+match[1]+" | reasoning="+score+" | evidence="+score+" | rebuttal="+score+" | clarity="+score)
+		}
+		content = strings.Join(lines, "\n") + "\nOUTCOME: " + outcome + "\nSUMMARY: Judged."
+	}
+	return &extprovider.Response{Content: content, Model: "test-model", Provider: m.name}, nil
+}
+
+func (m *mockProvider) HealthCheck(ctx context.Context) extprovider.HealthStatus {
+	return extprovider.HealthStatus{Available: true, CheckedAt: time.Now()}
+}
+
+// stuckProvider never answers; its debates run until they are stopped.
+type stuckProvider struct {
+	mockProvider
+}
+
+func (m *stuckProvider) Execute(ctx context.Context, req *extprovider.Request) (*extprovider.Response, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func setupTestRunner(t *testing.T) *Runner {
+	t.Helper()
+
+	store, err := storage.NewSQLiteStorage(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to create storage: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	if err := store.Initialize(); err != nil {
+		t.Fatalf("failed to initialize storage: %v", err)
+	}
+
+	registry := provider.NewRegistry()
+	for _, name := range []string{"strong", "weak", "judge"} {
+		registry.Register(&mockProvider{name: name})
+	}
+	registry.Register(&stuckProvider{mockProvider{name: "stuck"}})
+
+	return New(store, engine.New(store, registry, nil))
+}
+
+func TestRoundRobinTournament(t *testing.T) {
+	runner := setupTestRunner(t)
+
+	tour, err := runner.CreateTournament(core.NewTournamentConfig{
+		Topics:   []string{"Tabs or spaces?"},
+		Entrants: []core.MemberSpec{{Provider: "weak", Persona: "skeptic"}, {Provider: "strong", Persona: "optimist"}, {Provider: "weak", Persona: "pragmatist"}},
+		Judge:    core.MemberSpec{Provider: "judge", Persona: "analyst"},
+		MaxTurns: 1,
+	})
+	if err != nil {
+		t.Fatalf("CreateTournament() error = %v", err)
+	}
+	if len(tour.Matches) != 3 || tour.Format != core.TournamentRoundRobin || tour.Style != DefaultStyle {
+		t.Fatalf("unexpected tournament: %d matches, format %s, style %s", len(tour.Matches), tour.Format, tour.Style)
+	}
+
+	var completed atomic.Int32
+	callbacks := &Callbacks{OnMatchComplete: func(m *core.TournamentMatch) { completed.Add(1) }}
+	if err := runner.Run(context.Background(), tour, callbacks); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	stored, err := runner.GetTournament(tour.ID)
+	if err != nil {
+		t.Fatalf("GetTournament() error = %v", err)
+	}
+	if stored.Status != core.StatusCompleted || completed.Load() != 3 {
+		t.Fatalf("Status = %s after %d matches", stored.Status, completed.Load())
+	}
+	for _, m := range stored.Matches {
+		if m.Status != core.StatusCompleted || m.DebateID == "" {
+			t.Errorf("match %s vs %s not played: %+v", m.EntrantA, m.EntrantB, m)
+		}
+		strongPlayed := m.EntrantA == "strong:optimist" || m.EntrantB == "strong:optimist"
+		if strongPlayed && m.Winner != "strong:optimist" {
+			t.Errorf("strong entrant did not win %s vs %s: %q", m.EntrantA, m.EntrantB, m.Winner)
+		}
+		if !strongPlayed && m.Outcome != core.VerdictDraw {
+			t.Errorf("expected a draw between weak entrants, got %s", m.Outcome)
+		}
+	}
+	if winner := Winner(stored); winner != "strong:optimist" {
+		t.Errorf("Winner() = %q", winner)
+	}
+
+	board, err := runner.Leaderboard()
+	if err != nil {
+		t.Fatalf("Leaderboard() error = %v", err)
+	}
+	if len(board) != 3 || board[0].Entrant != "strong:optimist" || board[0].Wins != 2 || board[0].Rating <= core.DefaultRating {
+		t.Errorf("unexpected leaderboard top: %+v", board[0])
+	}
+}
+
+func TestStartAndPauseTournament(t *testing.T) {
+	runner := setupTestRunner(t)
+
+	tour, err := runner.CreateTournament(core.NewTournamentConfig{
+		Topics:   []string{"Tabs or spaces?"},
+		Entrants: []core.MemberSpec{{Provider: "stuck", Persona: "skeptic"}, {Provider: "weak", Persona: "optimist"}},
+		Judge:    core.MemberSpec{Provider: "judge", Persona: "analyst"},
+		MaxTurns: 1,
+	})
+	if err != nil {
+		t.Fatalf("CreateTournament() error = %v", err)
+	}
+
+	// Wait for the match's debate to be running before stopping it
+	debateID := ""
+	waitPlaying := func() {
+		t.Helper()
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+			stored, _ := runner.GetTournament(tour.ID)
+			if id := stored.Matches[0].DebateID; id != "" {
+				if debate, _ := runner.engine.GetDebate(id); debate != nil && debate.Status == core.StatusInProgress {
+					debateID = id
+					return
+				}
+			}
+		}
+		t.Fatal("match never started")
+	}
+	waitPaused := func(want string) {
+		t.Helper()
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := runner.Wait(ctx, tour.ID); err != nil {
+			t.Fatalf("Wait() error = %v", err)
+		}
+		stored, _ := runner.GetTournament(tour.ID)
+		if stored.Status != core.StatusPaused || stored.Matches[0].Status != core.StatusPaused {
+			t.Errorf("%s: status = %s, match %s; want paused", want, stored.Status, stored.Matches[0].Status)
+		}
+	}
+
+	// Pausing stops the run and its match
+	if err := runner.Start(context.Background(), tour); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if err := runner.Start(context.Background(), tour); !errors.Is(err, run.ErrAlreadyRunning) {
+		t.Errorf("second Start() error = %v, want ErrAlreadyRunning", err)
+	}
+	waitPlaying()
+	if !runner.Pause(tour.ID) {
+		t.Fatal("expected the tournament to be running")
+	}
+	waitPaused("after Pause")
+
+	if debate, _ := runner.engine.GetDebate(debateID); debate.Status != core.StatusPaused {
+		t.Errorf("debate status = %s, want paused", debate.Status)
+	}
+
+	// So does cancelling the parent context, as on server shutdown; the
+	// resumed match continues its paused debate
+	pausedID := debateID
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := runner.Start(ctx, tour); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	waitPlaying()
+	cancel()
+	waitPaused("after shutdown")
+	if runner.IsRunning(tour.ID) {
+		t.Error("tournament should not be running")
+	}
+	if debateID != pausedID {
+		t.Errorf("resumed match started debate %s, want %s", debateID, pausedID)
+	}
+	if debates, _ := runner.engine.ListDebates(10, 0); len(debates) != 1 {
+		t.Errorf("got %d debates, want 1", len(debates))
+	}
+}
+
+func TestBracketTournament(t *testing.T) {
+	runner := setupTestRunner(t)
+
+	tour, err := runner.CreateTournament(core.NewTournamentConfig{
+		Topics:   []string{"First topic", "Final topic"},
+		Entrants: []core.MemberSpec{{Provider: "weak", Persona: "optimist"}, {Provider: "strong", Persona: "skeptic"}, {Provider: "weak", Persona: "pragmatist"}},
+		Judge:    core.MemberSpec{Provider: "judge", Persona: "analyst"},
+		Format:   core.TournamentBracket,
+		MaxTurns: 1,
+		Workers:  1,
+	})
+	if err != nil {
+		t.Fatalf("CreateTournament() error = %v", err)
+	}
+	// Unrated entrants keep their order: the top seed has a bye
+	if len(tour.Matches) != 1 || tour.Matches[0].EntrantA != "strong:skeptic" || tour.Matches[0].EntrantB != "weak:pragmatist" {
+		t.Fatalf("unexpected first round: %+v", tour.Matches)
+	}
+
+	if err := runner.Run(context.Background(), tour, nil); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	stored, _ := runner.GetTournament(tour.ID)
+	if len(stored.Matches) != 2 {
+		t.Fatalf("expected 2 matches, got %d", len(stored.Matches))
+	}
+	final := stored.Matches[1]
+	if final.Round != 2 || final.Topic != "Final topic" || final.EntrantA != "weak:optimist" || final.Winner != "strong:skeptic" {
+		t.Errorf("unexpected final: %+v", final)
+	}
+	if winner := Winner(stored); winner != "strong:skeptic" {
+		t.Errorf("Winner() = %q", winner)
+	}
+}
+
+func TestCreateTournamentValidation(t *testing.T) {
+	runner := setupTestRunner(t)
+	judge := core.MemberSpec{Provider: "judge"}
+
+	tests := []struct {
+		name   string
+		config core.NewTournamentConfig
+		want   string
+	}{
+		{"no topics", core.NewTournamentConfig{Topics: []string{" "}, Entrants: []core.MemberSpec{{Provider: "strong"}, {Provider: "weak"}}, Judge: judge}, "topic"},
+		{"one entrant", core.NewTournamentConfig{Topics: []string{"T"}, Entrants: []core.MemberSpec{{Provider: "strong"}}, Judge: judge}, "at least 2 entrants"},
+		{"duplicate", core.NewTournamentConfig{Topics: []string{"T"}, Entrants: []core.MemberSpec{{Provider: "strong", Persona: "optimist"}, {Provider: "strong", Persona: "optimist"}}, Judge: judge}, "duplicate"},
+		{"no judge", core.NewTournamentConfig{Topics: []string{"T"}, Entrants: []core.MemberSpec{{Provider: "strong"}, {Provider: "weak"}}}, "judge"},
+		{"bad format", core.NewTournamentConfig{Topics: []string{"T"}, Entrants: []core.MemberSpec{{Provider: "strong"}, {Provider: "weak"}}, Judge: judge, Format: "swiss"}, "format"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := runner.CreateTournament(tt.config)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateRatings(t *testing.T) {
+	a := &core.Rating{Entrant: "a", Rating: core.DefaultRating}
+	b := &core.Rating{Entrant: "b", Rating: core.DefaultRating}
+
+	UpdateRatings(a, b, 1)
+	if a.Rating != 1516 || b.Rating != 1484 || a.Wins != 1 || b.Losses != 1 {
+		t.Errorf("after a win: a=%+v b=%+v", a, b)
+	}
+
+	// A draw costs the favourite rating
+	UpdateRatings(a, b, 0.5)
+	if a.Rating >= 1516 || a.Draws != 1 || b.Draws != 1 || a.Matches != 2 {
+		t.Errorf("after a draw: a=%+v b=%+v", a, b)
+	}
+	if total := a.Rating + b.Rating; math.Abs(total-2*core.DefaultRating) > 1e-9 {
+		t.Errorf("ratings are not zero-sum: %f", total)
+	}
+
+	if got := ExpectedScore(1900, 1500); math.Abs(got-0.909) > 0.001 {
+		t.Errorf("ExpectedScore(1900, 1500) = %f", got)
+	}
+}
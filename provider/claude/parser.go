@@ -25,6 +25,7 @@ type JSONResponse struct {
 		CacheReadInputTokens     int `json:"cache_read_input_tokens,omitempty"`
 	} `json:"usage,omitempty"`
 	Result      string  `json:"result,omitempty"`    // For simpler responses
+	IsError     bool    `json:"is_error,omitempty"`  // Set on error results (e.g. error_max_turns)
 	SessionID   string  `json:"session_id,omitempty"`
 	DurationMs  int64   `json:"duration_ms,omitempty"`  // CLI adds duration at top level
 	NumTurns    int     `json:"num_turns,omitempty"`
@@ -32,14 +33,28 @@ type JSONResponse struct {
 }
 
 // ParseJSON parses Claude CLI JSON output.
+// Error results reported by the CLI are returned as a *provider.CLIError.
 func ParseJSON(data string, duration time.Duration) (*provider.Response, error) {
 	var raw JSONResponse
 	if err := json.Unmarshal([]byte(data), &raw); err != nil {
-		// Not JSON, return as plain text response
-		return &provider.Response{
-			Content: data,
-			Raw:     data,
-		}, nil
+		// Verbose mode emits an array of events; the last "result" event carries the answer
+		result, ok := findResultEvent(data)
+		if !ok {
+			// Not JSON, return as plain text response
+			return &provider.Response{
+				Content: data,
+				Raw:     data,
+			}, nil
+		}
+		raw = *result
+	}
+
+	if raw.IsError {
+		message := raw.Result
+		if message == "" {
+			message = raw.Subtype
+		}
+		return nil, &provider.CLIError{Provider: "claude", Message: message}
 	}
 
 	resp := &provider.Response{
@@ -76,8 +91,23 @@ func ParseJSON(data string, duration time.Duration) (*provider.Response, error)
 			StopReason:   raw.StopReason,
 			SessionID:    raw.SessionID,
 			Duration:     actualDuration,
+			CostUSD:      raw.TotalCostUSD,
 		}
 	}
 
 	return resp, nil
 }
+
+// findResultEvent returns the last "result" event from a JSON array of events.
+func findResultEvent(data string) (*JSONResponse, bool) {
+	var events []JSONResponse
+	if err := json.Unmarshal([]byte(data), &events); err != nil {
+		return nil, false
+	}
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type == "result" {
+			return &events[i], true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,56 @@
+package provider_test
+
+import (
+	"flag"
+	"path/filepath"
+	"testing"
+
+	"github.com/alienxp03/conclave/provider/claude"
+	"github.com/alienxp03/conclave/provider/gemini"
+	"github.com/alienxp03/conclave/provider/openai"
+	"github.com/alienxp03/conclave/provider/opencode"
+	"github.com/alienxp03/conclave/provider/providertest"
+	"github.com/alienxp03/conclave/provider/qwen"
+)
+
+var update = flag.Bool("update", false, "rewrite parser fixture expectations from current output")
+
+// parsers maps each fixture directory under testdata/parsers to its parser.
+var parsers = map[string]providertest.ParseFunc{
+	"claude":   claude.ParseJSON,
+	"gemini":   gemini.ParseJSON,
+	"codex":    openai.ParseJSON,
+	"opencode": opencode.ParseJSON,
+	"qwen":     qwen.ParseJSON,
+}
+
+func TestParserConformance(t *testing.T) {
+	for name, parse := range parsers {
+		t.Run(name, func(t *testing.T) {
+			providertest.RunParserFixtures(t, filepath.Join("testdata", "parsers", name), parse, *update)
+		})
+	}
+}
+
+func TestCheckCategory(t *testing.T) {
+	tests := []struct {
+		category string
+		got      providertest.Expectation
+		ok       bool
+	}{
+		{providertest.CategoryError, providertest.Expectation{ErrorKind: providertest.ErrorKindCLI}, true},
+		{providertest.CategoryError, providertest.Expectation{Content: "Overloaded"}, false},
+		{providertest.CategoryError, providertest.Expectation{ErrorKind: providertest.ErrorKindParse}, false},
+		{providertest.CategorySuccess, providertest.Expectation{Content: "Yes."}, true},
+		{providertest.CategorySuccess, providertest.Expectation{ErrorKind: providertest.ErrorKindCLI}, false},
+		{providertest.CategorySuccess, providertest.Expectation{}, false},
+		{providertest.CategorySuccess, providertest.Expectation{Content: "Step 1:", StopReason: "MAX_TOKENS"}, false},
+		{providertest.CategoryTruncated, providertest.Expectation{Content: "Step 1:", StopReason: "length"}, true},
+		{providertest.CategoryMultiEvent, providertest.Expectation{Content: "Done."}, true},
+	}
+	for _, tt := range tests {
+		if err := providertest.CheckCategory(tt.category, tt.got); (err == nil) != tt.ok {
+			t.Errorf("CheckCategory(%s, %+v) = %v, want ok=%v", tt.category, tt.got, err, tt.ok)
+		}
+	}
+}
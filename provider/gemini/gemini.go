@@ -61,17 +61,23 @@ func (p *Provider) Execute(ctx context.Context, req *provider.Request) (*provide
 	resp, parseErr := ParseJSON(rawOutput, duration)
 	if parseErr != nil {
 		// Fall back to raw output if parsing fails
-		return &provider.Response{
+		resp = &provider.Response{
 			Content:  rawOutput,
 			Provider: p.Name(),
 			Model:    model,
-		}, nil
+			Metadata: &provider.Metadata{
+				Duration: duration,
+			},
+		}
+		provider.FillMissingUsage(req.Prompt, resp)
+		return resp, nil
 	}
 
 	resp.Provider = p.Name()
 	if model != "" && resp.Model == "" {
 		resp.Model = model
 	}
+	provider.FillMissingUsage(req.Prompt, resp)
 
 	return resp, nil
 }
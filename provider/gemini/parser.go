@@ -52,9 +52,16 @@ type JSONResponse struct {
 		TotalTokenCount      int `json:"totalTokenCount"`
 	} `json:"usageMetadata,omitempty"`
 	Text string `json:"text,omitempty"` // For simpler responses
+	// Error is set when the CLI reports a failure instead of a response
+	Error *struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+		Code    int    `json:"code,omitempty"`
+	} `json:"error,omitempty"`
 }
 
 // ParseJSON parses Gemini CLI JSON output.
+// Errors reported by the CLI are returned as a *provider.CLIError.
 func ParseJSON(data string, duration time.Duration) (*provider.Response, error) {
 	var raw JSONResponse
 	if err := json.Unmarshal([]byte(data), &raw); err != nil {
@@ -64,6 +71,14 @@ func ParseJSON(data string, duration time.Duration) (*provider.Response, error)
 		}, nil
 	}
 
+	if raw.Error != nil && raw.Response == "" {
+		message := raw.Error.Message
+		if message == "" {
+			message = raw.Error.Type
+		}
+		return nil, &provider.CLIError{Provider: "gemini", Message: message}
+	}
+
 	resp := &provider.Response{
 		Raw: data,
 	}
@@ -23,7 +23,8 @@ func New(cfg provider.Config) *Provider {
 }
 
 // Execute sends a request and returns the raw response.
-// Generic providers don't attempt to parse structured metadata.
+// Generic providers don't attempt to parse structured metadata, so token
+// usage is always estimated.
 func (p *Provider) Execute(ctx context.Context, req *provider.Request) (*provider.Response, error) {
 	// Build arguments
 	args := []string{}
@@ -60,7 +61,7 @@ func (p *Provider) Execute(ctx context.Context, req *provider.Request) (*provide
 	}
 	duration := time.Since(start)
 
-	return &provider.Response{
+	resp := &provider.Response{
 		Content:  content,
 		Model:    model,
 		Provider: p.Name(),
@@ -68,7 +69,10 @@ func (p *Provider) Execute(ctx context.Context, req *provider.Request) (*provide
 			Duration: duration,
 		},
 		Raw: content,
-	}, nil
+	}
+	provider.FillMissingUsage(req.Prompt, resp)
+
+	return resp, nil
 }
 
 // HealthCheck performs a quick health check using the provider execution path.
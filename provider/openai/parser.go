@@ -55,17 +55,23 @@ type JSONEvent struct {
 		CachedInput      int   `json:"cached_input_tokens,omitempty"`
 	} `json:"usage,omitempty"`
 	StopReason string `json:"stop_reason,omitempty"`
+	// Failure details (turn.failed events)
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
 	// Text content for streaming
 	Text string `json:"text,omitempty"`
 }
 
 // ParseJSON parses OpenAI/Codex CLI JSON output (supports both streaming and structured formats).
+// Failures reported in the event stream are returned as a *provider.CLIError.
 func ParseJSON(data string, duration time.Duration) (*provider.Response, error) {
 	resp := &provider.Response{Raw: data}
 
 	// Try parsing as newline-delimited JSON events first (streaming format)
 	lines := strings.Split(data, "\n")
 	var foundEvents bool
+	var failure string
 
 	for _, line := range lines {
 		line = strings.TrimSpace(line)
@@ -75,11 +81,24 @@ func ParseJSON(data string, duration time.Duration) (*provider.Response, error)
 
 		var event JSONEvent
 		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			// Error events carry "message" as a plain string
+			var errEvent struct {
+				Type    string `json:"type"`
+				Message string `json:"message"`
+			}
+			if json.Unmarshal([]byte(line), &errEvent) == nil && errEvent.Type == "error" {
+				foundEvents = true
+				failure = errEvent.Message
+			}
 			continue
 		}
 
 		foundEvents = true
 
+		if event.Type == "turn.failed" && event.Error != nil {
+			failure = event.Error.Message
+		}
+
 		// Extract text content from message or text field
 		if event.Message != nil && event.Message.Content != "" {
 			resp.Content += event.Message.Content
@@ -146,6 +165,10 @@ func ParseJSON(data string, duration time.Duration) (*provider.Response, error)
 		}
 	}
 
+	if foundEvents && resp.Content == "" && failure != "" {
+		return nil, &provider.CLIError{Provider: "codex", Message: failure}
+	}
+
 	if foundEvents && resp.Content != "" {
 		// Use provided duration if not in JSON
 		if resp.Metadata != nil && resp.Metadata.Duration == 0 {
@@ -27,12 +27,21 @@ type JSONEvent struct {
 			} `json:"cache,omitempty"`
 		} `json:"tokens,omitempty"`
 	} `json:"part,omitempty"`
+	// Error is set on "error" events
+	Error *struct {
+		Name string `json:"name"`
+		Data *struct {
+			Message string `json:"message"`
+		} `json:"data,omitempty"`
+	} `json:"error,omitempty"`
 }
 
 // ParseJSON parses Opencode CLI JSON lines output.
+// Error events without any text content are returned as a *provider.CLIError.
 func ParseJSON(data string, duration time.Duration) (*provider.Response, error) {
 	resp := &provider.Response{Raw: data}
 	lines := strings.Split(data, "\n")
+	var failure string
 
 	for _, line := range lines {
 		line = strings.TrimSpace(line)
@@ -46,6 +55,13 @@ func ParseJSON(data string, duration time.Duration) (*provider.Response, error)
 			continue
 		}
 
+		if event.Type == "error" && event.Error != nil {
+			failure = event.Error.Name
+			if event.Error.Data != nil && event.Error.Data.Message != "" {
+				failure = event.Error.Data.Message
+			}
+		}
+
 		if event.Type == "text" && event.Part != nil {
 			resp.Content += event.Part.Text
 		}
@@ -64,6 +80,10 @@ func ParseJSON(data string, duration time.Duration) (*provider.Response, error)
 		}
 	}
 
+	if resp.Content == "" && failure != "" {
+		return nil, &provider.CLIError{Provider: "opencode", Message: failure}
+	}
+
 	if resp.Content == "" {
 		slog.Debug("No text content found in Opencode output, using raw output as fallback")
 		// Fallback if no text events found or parsing failed
@@ -94,6 +94,16 @@ type Metadata struct {
 
 	// SessionID is a unique identifier for this session (if supported by the provider).
 	SessionID string `json:"session_id,omitempty"`
+
+	// Estimated is true when token counts were approximated by a TokenEstimator
+	// because the provider did not report usage. InputEstimated and
+	// OutputEstimated say which side was approximated.
+	Estimated       bool `json:"estimated,omitempty"`
+	InputEstimated  bool `json:"input_estimated,omitempty"`
+	OutputEstimated bool `json:"output_estimated,omitempty"`
+
+	// CostUSD is the cost of the request in US dollars, when the provider reports it.
+	CostUSD float64 `json:"cost_usd,omitempty"`
 }
 
 // Config holds configuration for creating a provider.
@@ -0,0 +1,288 @@
+// Package providertest provides a golden-fixture conformance harness for
+// provider output parsers.
+//
+// Fixtures live in a directory per provider, with one subdirectory per CLI
+// version (or output schema generation):
+//
+//	testdata/parsers/<provider>/<version>/<case>.out   captured CLI stdout
+//	testdata/parsers/<provider>/<version>/<case>.json  expected parse result
+//
+// Adding coverage for a new CLI version is a data-only change: drop the
+// captured output next to the existing fixtures and run the tests with
+// -update to write the expectation, then review the generated file.
+package providertest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alienxp03/conclave/provider"
+)
+
+// ParseFunc is the signature shared by every provider's ParseJSON function.
+type ParseFunc func(data string, duration time.Duration) (*provider.Response, error)
+
+// Fixture categories. Every provider must have at least one fixture of each.
+const (
+	CategorySuccess    = "success"     // Normal, complete response
+	CategoryError      = "error"       // CLI reported a failure in its output
+	CategoryTruncated  = "truncated"   // Output cut off mid-stream
+	CategoryMultiEvent = "multi_event" // Streaming/event output spanning several records
+)
+
+// Categories lists every fixture category in a stable order.
+var Categories = []string{CategorySuccess, CategoryError, CategoryTruncated, CategoryMultiEvent}
+
+// Error kinds reported in expectations.
+const (
+	ErrorKindNone  = ""
+	ErrorKindCLI   = "cli_error"   // *provider.CLIError
+	ErrorKindParse = "parse_error" // Any other error
+)
+
+// FixtureDuration is the duration passed to parsers when running fixtures.
+const FixtureDuration = 1500 * time.Millisecond
+
+// Expectation is the common schema for the expected result of parsing a fixture.
+type Expectation struct {
+	Category     string `json:"category"`
+	Content      string `json:"content"`
+	Model        string `json:"model,omitempty"`
+	InputTokens  int    `json:"input_tokens"`
+	OutputTokens int    `json:"output_tokens"`
+	TotalTokens  int    `json:"total_tokens"`
+	DurationMs   int64  `json:"duration_ms"`
+	StopReason   string `json:"stop_reason,omitempty"`
+	SessionID    string `json:"session_id,omitempty"`
+	ErrorKind    string `json:"error_kind,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// Fixture is a single captured output and its expected parse result.
+type Fixture struct {
+	Name       string // "<version>/<case>"
+	InputPath  string
+	ExpectPath string
+}
+
+// LoadFixtures returns all fixtures under dir, sorted by name.
+func LoadFixtures(dir string) ([]Fixture, error) {
+	var fixtures []Fixture
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".out" {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		fixtures = append(fixtures, Fixture{
+			Name:       filepath.ToSlash(strings.TrimSuffix(rel, ".out")),
+			InputPath:  path,
+			ExpectPath: strings.TrimSuffix(path, ".out") + ".json",
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(fixtures, func(i, j int) bool { return fixtures[i].Name < fixtures[j].Name })
+	return fixtures, nil
+}
+
+// Observe parses input and records the result in the expectation schema.
+func Observe(parse ParseFunc, input string) Expectation {
+	resp, err := parse(input, FixtureDuration)
+
+	var got Expectation
+	if err != nil {
+		got.ErrorKind = ErrorKindParse
+		var cliErr *provider.CLIError
+		if errors.As(err, &cliErr) {
+			got.ErrorKind = ErrorKindCLI
+		}
+		got.ErrorMessage = err.Error()
+		return got
+	}
+	if resp == nil {
+		return got
+	}
+
+	got.Content = resp.Content
+	got.Model = resp.Model
+	if resp.Metadata != nil {
+		got.InputTokens = resp.Metadata.InputTokens
+		got.OutputTokens = resp.Metadata.OutputTokens
+		got.TotalTokens = resp.Metadata.TotalTokens
+		got.DurationMs = resp.Metadata.Duration.Milliseconds()
+		got.StopReason = resp.Metadata.StopReason
+		got.SessionID = resp.Metadata.SessionID
+	}
+	return got
+}
+
+// RunParserFixtures runs every fixture under dir against parse as a subtest.
+// When update is true, expectation files are rewritten from the observed
+// results (the category of an existing expectation is preserved).
+func RunParserFixtures(t *testing.T, dir string, parse ParseFunc, update bool) {
+	t.Helper()
+
+	fixtures, err := LoadFixtures(dir)
+	if err != nil {
+		t.Fatalf("failed to load fixtures from %s: %v", dir, err)
+	}
+	if len(fixtures) == 0 {
+		t.Fatalf("no fixtures found in %s", dir)
+	}
+
+	seen := make(map[string]bool)
+	for _, fx := range fixtures {
+		t.Run(fx.Name, func(t *testing.T) {
+			data, err := os.ReadFile(fx.InputPath)
+			if err != nil {
+				t.Fatalf("failed to read fixture: %v", err)
+			}
+			// ExecuteCommand trims CLI output before it reaches the parser.
+			input := strings.TrimSpace(string(data))
+
+			want, err := readExpectation(fx.ExpectPath)
+			if err != nil && !(update && os.IsNotExist(err)) {
+				t.Fatalf("failed to read expectation (run with -update to create it): %v", err)
+			}
+
+			// The category labels the fixture; CheckCategory below asserts
+			// that the parse result actually fits it
+			got := Observe(parse, input)
+			got.Category = want.Category
+			if got.Category == "" {
+				got.Category = categoryFromName(fx.Name)
+			}
+
+			if update {
+				if err := writeExpectation(fx.ExpectPath, got); err != nil {
+					t.Fatalf("failed to write expectation: %v", err)
+				}
+				want = got
+			}
+
+			if !validCategory(want.Category) {
+				t.Fatalf("invalid category %q (want one of %v)", want.Category, Categories)
+			}
+			seen[want.Category] = true
+
+			if err := CheckCategory(want.Category, got); err != nil {
+				t.Errorf("parse result does not fit the %s category: %v", want.Category, err)
+			}
+			if got != want {
+				t.Errorf("parse result mismatch\n got: %s\nwant: %s", describe(got), describe(want))
+			}
+
+			// Every successful parse must preserve the raw output for debugging.
+			if got.ErrorKind == ErrorKindNone {
+				if resp, _ := parse(input, FixtureDuration); resp != nil && resp.Raw != input {
+					t.Errorf("Raw output not preserved")
+				}
+			}
+		})
+	}
+
+	for _, category := range Categories {
+		if !seen[category] {
+			t.Errorf("no %q fixture in %s", category, dir)
+		}
+	}
+}
+
+// truncationStopReasons are the stop reasons providers report when output
+// was cut off at the token limit.
+var truncationStopReasons = []string{"max_tokens", "length"}
+
+// CheckCategory reports whether a parse result fits a fixture category.
+// Error fixtures must fail with a *provider.CLIError. Every other category
+// must parse to content without an error, since parsers keep what they can
+// of truncated output, and only truncated fixtures may report a truncation
+// stop reason.
+func CheckCategory(category string, got Expectation) error {
+	if category == CategoryError {
+		if got.ErrorKind != ErrorKindCLI {
+			return fmt.Errorf("want a %s, got %s", ErrorKindCLI, describeErrorKind(got.ErrorKind))
+		}
+		return nil
+	}
+
+	if got.ErrorKind != ErrorKindNone {
+		return fmt.Errorf("want no error, got %s: %s", got.ErrorKind, got.ErrorMessage)
+	}
+	if got.Content == "" {
+		return fmt.Errorf("want content, got none")
+	}
+	for _, reason := range truncationStopReasons {
+		if strings.EqualFold(got.StopReason, reason) && category != CategoryTruncated {
+			return fmt.Errorf("stop reason %q marks the output truncated", got.StopReason)
+		}
+	}
+	return nil
+}
+
+func describeErrorKind(kind string) string {
+	if kind == ErrorKindNone {
+		return "no error"
+	}
+	return kind
+}
+
+func readExpectation(path string) (Expectation, error) {
+	var exp Expectation
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return exp, err
+	}
+	if err := json.Unmarshal(data, &exp); err != nil {
+		return exp, fmt.Errorf("invalid expectation %s: %w", path, err)
+	}
+	return exp, nil
+}
+
+func writeExpectation(path string, exp Expectation) error {
+	data, err := json.MarshalIndent(exp, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// categoryFromName infers the category from a case name prefix (e.g. "error_rate_limit").
+func categoryFromName(name string) string {
+	base := filepath.Base(name)
+	for _, category := range Categories {
+		if strings.HasPrefix(base, category) {
+			return category
+		}
+	}
+	return ""
+}
+
+func validCategory(category string) bool {
+	for _, c := range Categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+func describe(exp Expectation) string {
+	data, _ := json.Marshal(exp)
+	return string(data)
+}
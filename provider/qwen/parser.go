@@ -24,7 +24,12 @@ type JSONResponse struct {
 // Event represents an event in the newer Qwen CLI JSON array output.
 type Event struct {
 	Type    string `json:"type"`
+	Subtype string `json:"subtype,omitempty"`
+	IsError bool   `json:"is_error,omitempty"`
 	Result  string `json:"result,omitempty"`
+	Error   *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
 	Message *struct {
 		Content []struct {
 			Type string `json:"type"`
@@ -39,6 +44,7 @@ type Event struct {
 }
 
 // ParseJSON parses Qwen CLI JSON output.
+// Error results reported by the CLI are returned as a *provider.CLIError.
 func ParseJSON(data string, duration time.Duration) (*provider.Response, error) {
 	// Try parsing as array of events first (newer format)
 	var events []Event
@@ -51,6 +57,14 @@ func ParseJSON(data string, duration time.Duration) (*provider.Response, error)
 
 		// Scan all events to collect information
 		for _, event := range events {
+			if event.Type == "result" && event.IsError {
+				message := event.Subtype
+				if event.Error != nil && event.Error.Message != "" {
+					message = event.Error.Message
+				}
+				return nil, &provider.CLIError{Provider: "qwen", Message: message}
+			}
+
 			if event.Type == "result" && event.Result != "" {
 				resultText = event.Result
 				if event.Usage != nil {
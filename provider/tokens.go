@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// TokenEstimator approximates the number of tokens a model would count for a text.
+// Estimators are used when a CLI tool does not report usage.
+type TokenEstimator interface {
+	EstimateTokens(model, text string) int
+}
+
+// TokenEstimatorFunc adapts a function to the TokenEstimator interface.
+type TokenEstimatorFunc func(model, text string) int
+
+// EstimateTokens calls f(model, text).
+func (f TokenEstimatorFunc) EstimateTokens(model, text string) int {
+	return f(model, text)
+}
+
+var (
+	estimatorMu      sync.RWMutex
+	defaultEstimator TokenEstimator = HeuristicEstimator{}
+)
+
+// SetTokenEstimator replaces the estimator used by FillMissingUsage.
+// Passing nil restores the built-in HeuristicEstimator.
+func SetTokenEstimator(e TokenEstimator) {
+	estimatorMu.Lock()
+	defer estimatorMu.Unlock()
+	if e == nil {
+		e = HeuristicEstimator{}
+	}
+	defaultEstimator = e
+}
+
+// GetTokenEstimator returns the estimator used by FillMissingUsage.
+func GetTokenEstimator() TokenEstimator {
+	estimatorMu.RLock()
+	defer estimatorMu.RUnlock()
+	return defaultEstimator
+}
+
+// FillMissingUsage estimates input and output tokens for responses whose
+// provider did not report usage. Counts reported by the provider are never
+// overwritten. Metadata.InputEstimated and OutputEstimated mark the side
+// that was estimated, and Metadata.Estimated is set when either was.
+func FillMissingUsage(prompt string, resp *Response) {
+	if resp == nil {
+		return
+	}
+	if resp.Metadata == nil {
+		resp.Metadata = &Metadata{}
+	}
+	meta := resp.Metadata
+	if meta.InputTokens > 0 && meta.OutputTokens > 0 {
+		return
+	}
+
+	estimator := GetTokenEstimator()
+	if meta.InputTokens == 0 && prompt != "" {
+		meta.InputTokens = estimator.EstimateTokens(resp.Model, prompt)
+		meta.InputEstimated = true
+	}
+	if meta.OutputTokens == 0 && resp.Content != "" {
+		meta.OutputTokens = estimator.EstimateTokens(resp.Model, resp.Content)
+		meta.OutputEstimated = true
+	}
+	meta.Estimated = meta.Estimated || meta.InputEstimated || meta.OutputEstimated
+	if meta.Estimated && meta.TotalTokens < meta.InputTokens+meta.OutputTokens {
+		meta.TotalTokens = meta.InputTokens + meta.OutputTokens
+	}
+}
+
+// HeuristicEstimator is a fast BPE-like approximation that needs no vocabulary.
+// Text is split the way byte-pair tokenizers typically split it (words with their
+// leading space, digit groups, punctuation, non-Latin runes) and each piece is
+// charged according to the model family's average characters per token.
+type HeuristicEstimator struct{}
+
+// familyProfile describes how a model family's tokenizer tends to split text.
+type familyProfile struct {
+	charsPerToken float64 // Average letters per token inside a word
+	digitsPerTok  int     // Digits merged into a single token
+}
+
+var (
+	profileDefault = familyProfile{charsPerToken: 4.0, digitsPerTok: 3}
+	profileClaude  = familyProfile{charsPerToken: 3.5, digitsPerTok: 3}
+	profileGemini  = familyProfile{charsPerToken: 4.0, digitsPerTok: 1}
+	profileOpenAI  = familyProfile{charsPerToken: 4.2, digitsPerTok: 3}
+	profileQwen    = familyProfile{charsPerToken: 3.8, digitsPerTok: 1}
+)
+
+// profileForModel picks a tokenizer profile from a model name.
+func profileForModel(model string) familyProfile {
+	m := strings.ToLower(model)
+	switch {
+	case m == "":
+		return profileDefault
+	case strings.Contains(m, "claude"), strings.Contains(m, "sonnet"),
+		strings.Contains(m, "opus"), strings.Contains(m, "haiku"):
+		return profileClaude
+	case strings.Contains(m, "gemini"), strings.Contains(m, "gemma"):
+		return profileGemini
+	case strings.Contains(m, "gpt"), strings.Contains(m, "codex"),
+		strings.HasPrefix(m, "o1"), strings.HasPrefix(m, "o3"), strings.HasPrefix(m, "o4"):
+		return profileOpenAI
+	case strings.Contains(m, "qwen"):
+		return profileQwen
+	default:
+		return profileDefault
+	}
+}
+
+// EstimateTokens returns an approximate token count for text under model.
+func (HeuristicEstimator) EstimateTokens(model, text string) int {
+	if text == "" {
+		return 0
+	}
+	profile := profileForModel(model)
+
+	tokens := 0.0
+	letters, digits := 0, 0
+	flushLetters := func() {
+		if letters > 0 {
+			// Short words are a single token; longer words split into sub-words.
+			pieces := float64(letters) / profile.charsPerToken
+			if pieces < 1 {
+				pieces = 1
+			}
+			tokens += pieces
+			letters = 0
+		}
+	}
+	flushDigits := func() {
+		if digits > 0 {
+			tokens += float64((digits + profile.digitsPerTok - 1) / profile.digitsPerTok)
+			digits = 0
+		}
+	}
+
+	prevNewline := false
+	for _, r := range text {
+		switch {
+		case r < unicode.MaxASCII && unicode.IsLetter(r):
+			flushDigits()
+			letters++
+			prevNewline = false
+		case unicode.IsDigit(r):
+			flushLetters()
+			digits++
+			prevNewline = false
+		case r == '\n':
+			flushLetters()
+			flushDigits()
+			// Runs of newlines usually merge into one token.
+			if !prevNewline {
+				tokens++
+			}
+			prevNewline = true
+		case unicode.IsSpace(r):
+			// A single space is merged into the following word.
+			flushLetters()
+			flushDigits()
+		case unicode.IsLetter(r):
+			// Non-Latin scripts (CJK, etc.) are close to one token per rune.
+			flushLetters()
+			flushDigits()
+			tokens++
+			prevNewline = false
+		default:
+			flushLetters()
+			flushDigits()
+			tokens++
+			prevNewline = false
+		}
+	}
+	flushLetters()
+	flushDigits()
+
+	count := int(tokens + 0.5)
+	if count < 1 {
+		count = 1
+	}
+	return count
+}
@@ -0,0 +1,105 @@
+package provider
+
+import "testing"
+
+func TestHeuristicEstimator(t *testing.T) {
+	est := HeuristicEstimator{}
+
+	tests := []struct {
+		name     string
+		model    string
+		text     string
+		min, max int
+	}{
+		{name: "empty", text: "", min: 0, max: 0},
+		{name: "single word", text: "hello", min: 1, max: 2},
+		{name: "sentence", text: "The quick brown fox jumps over the lazy dog.", min: 9, max: 14},
+		{name: "digits", text: "1234567890", min: 3, max: 5},
+		{name: "gemini digits", model: "gemini-2.5-pro", text: "1234567890", min: 10, max: 10},
+		{name: "cjk", text: "你好世界", min: 4, max: 4},
+		{name: "code", text: "func main() { fmt.Println(\"hi\") }", min: 10, max: 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := est.EstimateTokens(tt.model, tt.text)
+			if got < tt.min || got > tt.max {
+				t.Errorf("EstimateTokens(%q, %q) = %d, want between %d and %d", tt.model, tt.text, got, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestHeuristicEstimatorModelFamilies(t *testing.T) {
+	est := HeuristicEstimator{}
+	text := "Incomprehensibilities notwithstanding, characterization internationalization."
+
+	claude := est.EstimateTokens("claude-sonnet-4", text)
+	gpt := est.EstimateTokens("gpt-5-codex", text)
+	if claude <= gpt {
+		t.Errorf("expected claude estimate (%d) to exceed gpt estimate (%d) for long words", claude, gpt)
+	}
+}
+
+func TestFillMissingUsage(t *testing.T) {
+	t.Run("fills missing counts", func(t *testing.T) {
+		resp := &Response{Content: "Some generated answer", Model: "sonnet"}
+		FillMissingUsage("Please answer the question", resp)
+
+		if resp.Metadata == nil {
+			t.Fatal("expected metadata to be created")
+		}
+		if !resp.Metadata.Estimated {
+			t.Error("expected Estimated to be true")
+		}
+		if resp.Metadata.InputTokens == 0 || resp.Metadata.OutputTokens == 0 {
+			t.Errorf("expected non-zero estimates, got input=%d output=%d", resp.Metadata.InputTokens, resp.Metadata.OutputTokens)
+		}
+		if resp.Metadata.TotalTokens != resp.Metadata.InputTokens+resp.Metadata.OutputTokens {
+			t.Errorf("TotalTokens = %d, want %d", resp.Metadata.TotalTokens, resp.Metadata.InputTokens+resp.Metadata.OutputTokens)
+		}
+	})
+
+	t.Run("keeps reported counts", func(t *testing.T) {
+		resp := &Response{
+			Content:  "answer",
+			Metadata: &Metadata{InputTokens: 10, OutputTokens: 5, TotalTokens: 15},
+		}
+		FillMissingUsage("prompt", resp)
+
+		if resp.Metadata.Estimated {
+			t.Error("expected Estimated to be false when usage was reported")
+		}
+		if resp.Metadata.InputTokens != 10 || resp.Metadata.OutputTokens != 5 || resp.Metadata.TotalTokens != 15 {
+			t.Errorf("reported usage was modified: %+v", resp.Metadata)
+		}
+	})
+
+	t.Run("marks the estimated side", func(t *testing.T) {
+		resp := &Response{
+			Content:  "answer",
+			Metadata: &Metadata{OutputTokens: 5},
+		}
+		FillMissingUsage("prompt", resp)
+
+		meta := resp.Metadata
+		if !meta.Estimated || !meta.InputEstimated || meta.OutputEstimated {
+			t.Errorf("expected only the input to be estimated: %+v", meta)
+		}
+		if meta.OutputTokens != 5 {
+			t.Errorf("reported output was modified: %+v", meta)
+		}
+	})
+
+	t.Run("custom estimator", func(t *testing.T) {
+		SetTokenEstimator(TokenEstimatorFunc(func(model, text string) int { return 42 }))
+		defer SetTokenEstimator(nil)
+
+		resp := &Response{Content: "answer"}
+		FillMissingUsage("prompt", resp)
+
+		if resp.Metadata.InputTokens != 42 || resp.Metadata.OutputTokens != 42 {
+			t.Errorf("custom estimator not used: %+v", resp.Metadata)
+		}
+	})
+}
@@ -1,5 +1,5 @@
 import { useEffect, useState } from 'react';
-import type { Turn, Debate } from '../types';
+import type { Turn, Debate, AwaitingInput } from '../types';
 
 interface StreamingTurn {
   agentId: string;
@@ -12,6 +12,7 @@ export function useDebateStream(debateId: string | undefined, initialTurns: Turn
   const [turns, setTurns] = useState<Turn[]>(initialTurns);
   const [streamingTurn, setStreamingTurn] = useState<StreamingTurn | null>(null);
   const [debate, setDebate] = useState<Debate | null>(null);
+  const [awaiting, setAwaiting] = useState<AwaitingInput | null>(null);
   const [error, setError] = useState<string | null>(null);
 
   // Update turns if initialTurns changes (e.g. after a query refetch)
@@ -50,6 +51,11 @@ export function useDebateStream(debateId: string | undefined, initialTurns: Turn
       const turn: Turn = JSON.parse(e.data);
       setTurns((prev) => [...prev, turn]);
       setStreamingTurn(null);
+      setAwaiting(null);
+    });
+
+    eventSource.addEventListener('awaiting_input', (e) => {
+      setAwaiting(JSON.parse(e.data));
     });
 
     eventSource.addEventListener('debate_complete', (e) => {
@@ -74,5 +80,5 @@ export function useDebateStream(debateId: string | undefined, initialTurns: Turn
     };
   }, [debateId]);
 
-  return { turns, streamingTurn, debate, error };
+  return { turns, streamingTurn, debate, awaiting, error };
 }
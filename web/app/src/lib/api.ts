@@ -1,4 +1,4 @@
-import type { Debate, Provider, CreateDebateRequest, Turn, Persona, Style, Council, CouncilResponse, CouncilRanking, CreateCouncilRequest, CouncilSummary, SystemInfo, DebateStats, Project, DebateSummary } from '../types';
+import type { Debate, Provider, CreateDebateRequest, Turn, Persona, Style, Council, CouncilResponse, CouncilRanking, CreateCouncilRequest, CouncilSummary, SystemInfo, DebateStats, Project, DebateSummary, RunAction, AwaitingInput, ForkRequest, ForkNode, SideComparison, ArgumentGraph, Tournament, CreateTournamentRequest, Rating, Preset, PresetRequest, Schedule, ScheduleWithRuns, ScheduleRequest } from '../types';
 
 const API_BASE = '/api';
 
@@ -33,7 +33,7 @@ class ApiClient {
     return response.json();
   }
 
-  async getDebate(id: string): Promise<{ debate: Debate; turns: Turn[]; stats?: DebateStats }> {
+  async getDebate(id: string): Promise<{ debate: Debate; turns: Turn[]; stats?: DebateStats; awaiting_input?: AwaitingInput }> {
     const response = await fetch(`${API_BASE}/debates/${id}`);
     if (!response.ok) throw new Error('Failed to fetch debate');
     return response.json();
@@ -92,6 +92,102 @@ class ApiClient {
     if (!response.ok) throw new Error('Failed to delete project');
   }
 
+  async getPresets(): Promise<Preset[]> {
+    const response = await fetch(`${API_BASE}/presets`);
+    if (!response.ok) throw new Error('Failed to fetch presets');
+    return response.json();
+  }
+
+  async createPreset(request: PresetRequest): Promise<Preset> {
+    const response = await fetch(`${API_BASE}/presets`, {
+      method: 'POST',
+      headers: {
+        'Content-Type': 'application/json',
+      },
+      body: JSON.stringify(request),
+    });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || 'Failed to create preset');
+    }
+    return response.json();
+  }
+
+  async updatePreset(id: string, request: PresetRequest): Promise<Preset> {
+    const response = await fetch(`${API_BASE}/presets/${id}`, {
+      method: 'PUT',
+      headers: {
+        'Content-Type': 'application/json',
+      },
+      body: JSON.stringify(request),
+    });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || 'Failed to update preset');
+    }
+    return response.json();
+  }
+
+  async deletePreset(id: string): Promise<void> {
+    const response = await fetch(`${API_BASE}/presets/${id}`, {
+      method: 'DELETE',
+    });
+    if (!response.ok) throw new Error('Failed to delete preset');
+  }
+
+  async getSchedules(): Promise<Schedule[]> {
+    const response = await fetch(`${API_BASE}/schedules`);
+    if (!response.ok) throw new Error('Failed to fetch schedules');
+    return response.json();
+  }
+
+  async getSchedule(id: string): Promise<ScheduleWithRuns> {
+    const response = await fetch(`${API_BASE}/schedules/${id}`);
+    if (!response.ok) throw new Error('Failed to fetch schedule');
+    return response.json();
+  }
+
+  async createSchedule(request: ScheduleRequest): Promise<Schedule> {
+    const response = await fetch(`${API_BASE}/schedules`, {
+      method: 'POST',
+      headers: {
+        'Content-Type': 'application/json',
+      },
+      body: JSON.stringify(request),
+    });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || 'Failed to create schedule');
+    }
+    return response.json();
+  }
+
+  async setSchedulePaused(id: string, paused: boolean): Promise<Schedule> {
+    const response = await fetch(`${API_BASE}/schedules/${id}/${paused ? 'pause' : 'resume'}`, {
+      method: 'POST',
+    });
+    if (!response.ok) throw new Error('Failed to update schedule');
+    return response.json();
+  }
+
+  async triggerSchedule(id: string): Promise<Council> {
+    const response = await fetch(`${API_BASE}/schedules/${id}/trigger`, {
+      method: 'POST',
+    });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || 'Failed to trigger schedule');
+    }
+    return response.json();
+  }
+
+  async deleteSchedule(id: string): Promise<void> {
+    const response = await fetch(`${API_BASE}/schedules/${id}`, {
+      method: 'DELETE',
+    });
+    if (!response.ok) throw new Error('Failed to delete schedule');
+  }
+
   async createDebate(request: CreateDebateRequest): Promise<Debate> {
     const response = await fetch(`${API_BASE}/debates`, {
       method: 'POST',
@@ -127,15 +223,113 @@ class ApiClient {
     if (!response.ok) throw new Error('Failed to update debate title');
   }
 
-  async addDebateFollowUp(id: string, content: string): Promise<void> {
+  async addDebateFollowUp(id: string, content: string, targets?: string[]): Promise<void> {
     const response = await fetch(`${API_BASE}/debates/${id}/followup`, {
       method: 'POST',
       headers: { 'Content-Type': 'application/json' },
-      body: JSON.stringify({ content }),
+      body: JSON.stringify({ content, targets }),
     });
     if (!response.ok) throw new Error('Failed to add follow-up');
   }
 
+  async submitDebateTurn(id: string, content: string): Promise<Turn> {
+    const response = await fetch(`${API_BASE}/debates/${id}/turn`, {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ content }),
+    });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || 'Failed to submit turn');
+    }
+    return response.json();
+  }
+
+  async controlDebate(id: string, action: RunAction): Promise<void> {
+    const response = await fetch(`${API_BASE}/debates/${id}/${action}`, {
+      method: 'POST',
+    });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || `Failed to ${action} debate`);
+    }
+  }
+
+  async regenerateTurn(debateId: string, turnId: string): Promise<Turn> {
+    const response = await fetch(`${API_BASE}/debates/${debateId}/turns/${turnId}/regenerate`, {
+      method: 'POST',
+    });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || 'Failed to regenerate turn');
+    }
+    return response.json();
+  }
+
+  async selectTurnVersion(debateId: string, turnId: string, version: number): Promise<Turn> {
+    const response = await fetch(`${API_BASE}/debates/${debateId}/turns/${turnId}/select`, {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ version }),
+    });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || 'Failed to select turn version');
+    }
+    return response.json();
+  }
+
+  async forkDebate(id: string, request: ForkRequest): Promise<Debate> {
+    const response = await fetch(`${API_BASE}/debates/${id}/fork`, {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify(request),
+    });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || 'Failed to fork debate');
+    }
+    return response.json();
+  }
+
+  async getDebateForks(id: string): Promise<ForkNode> {
+    const response = await fetch(`${API_BASE}/debates/${id}/forks`);
+    if (!response.ok) throw new Error('Failed to fetch debate forks');
+    return response.json();
+  }
+
+  async swapSides(id: string, autoRun = true): Promise<Debate> {
+    const response = await fetch(`${API_BASE}/debates/${id}/swap-sides`, {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ auto_run: autoRun }),
+    });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || 'Failed to swap sides');
+    }
+    return response.json();
+  }
+
+  async compareSides(id: string): Promise<SideComparison> {
+    const response = await fetch(`${API_BASE}/debates/${id}/sides`);
+    if (!response.ok) throw new Error('Failed to compare sides');
+    return response.json();
+  }
+
+  // Extracts claims from turns not processed yet, so it can take a while
+  async getDebateArguments(id: string): Promise<ArgumentGraph> {
+    const response = await fetch(`${API_BASE}/debates/${id}/arguments`);
+    if (!response.ok) throw new Error('Failed to fetch argument graph');
+    return response.json();
+  }
+
+  async getDebateArgumentsText(id: string, format: 'dot' | 'mermaid'): Promise<string> {
+    const response = await fetch(`${API_BASE}/debates/${id}/arguments?format=${format}`);
+    if (!response.ok) throw new Error('Failed to export argument graph');
+    return response.text();
+  }
+
   // Create an EventSource for streaming debate updates
   createDebateStream(debateId: string): EventSource {
     return new EventSource(`${API_BASE}/debates/${debateId}/stream`);
@@ -189,18 +383,134 @@ class ApiClient {
     if (!response.ok) throw new Error('Failed to delete council');
   }
 
-  async addCouncilFollowUp(id: string, content: string): Promise<void> {
+  async addCouncilFollowUp(id: string, content: string, targets?: string[], synthesize?: boolean): Promise<void> {
     const response = await fetch(`${API_BASE}/councils/${id}/followup`, {
       method: 'POST',
       headers: { 'Content-Type': 'application/json' },
-      body: JSON.stringify({ content }),
+      body: JSON.stringify({ content, targets, synthesize }),
     });
     if (!response.ok) throw new Error('Failed to add follow-up');
   }
 
+  async askChairman(id: string, question: string): Promise<CouncilResponse> {
+    const response = await fetch(`${API_BASE}/councils/${id}/ask`, {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ question }),
+    });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || 'Failed to ask the chairman');
+    }
+    return response.json();
+  }
+
+  async controlCouncil(id: string, action: RunAction): Promise<void> {
+    const response = await fetch(`${API_BASE}/councils/${id}/${action}`, {
+      method: 'POST',
+    });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || `Failed to ${action} council`);
+    }
+  }
+
+  async regenerateCouncilResponse(councilId: string, responseId: string): Promise<CouncilResponse> {
+    const response = await fetch(`${API_BASE}/councils/${councilId}/responses/${responseId}/regenerate`, {
+      method: 'POST',
+    });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || 'Failed to regenerate response');
+    }
+    return response.json();
+  }
+
+  async selectCouncilResponseVersion(councilId: string, responseId: string, version: number): Promise<CouncilResponse> {
+    const response = await fetch(`${API_BASE}/councils/${councilId}/responses/${responseId}/select`, {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ version }),
+    });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || 'Failed to select response version');
+    }
+    return response.json();
+  }
+
+  async forkCouncil(id: string, request: ForkRequest): Promise<Council> {
+    const response = await fetch(`${API_BASE}/councils/${id}/fork`, {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify(request),
+    });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || 'Failed to fork council');
+    }
+    return response.json();
+  }
+
+  async getCouncilForks(id: string): Promise<ForkNode> {
+    const response = await fetch(`${API_BASE}/councils/${id}/forks`);
+    if (!response.ok) throw new Error('Failed to fetch council forks');
+    return response.json();
+  }
+
   createCouncilStream(councilId: string): EventSource {
     return new EventSource(`${API_BASE}/councils/${councilId}/stream`);
   }
+
+  // Tournament methods
+  async getTournaments(): Promise<Tournament[]> {
+    const response = await fetch(`${API_BASE}/tournaments`);
+    if (!response.ok) throw new Error('Failed to fetch tournaments');
+    return response.json();
+  }
+
+  async getTournament(id: string): Promise<Tournament> {
+    const response = await fetch(`${API_BASE}/tournaments/${id}`);
+    if (!response.ok) throw new Error('Failed to fetch tournament');
+    return response.json();
+  }
+
+  async createTournament(request: CreateTournamentRequest): Promise<Tournament> {
+    const response = await fetch(`${API_BASE}/tournaments`, {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify(request),
+    });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || 'Failed to create tournament');
+    }
+    return response.json();
+  }
+
+  async resumeTournament(id: string): Promise<Tournament> {
+    const response = await fetch(`${API_BASE}/tournaments/${id}/resume`, { method: 'POST' });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || 'Failed to resume tournament');
+    }
+    return response.json();
+  }
+
+  async pauseTournament(id: string): Promise<Tournament> {
+    const response = await fetch(`${API_BASE}/tournaments/${id}/pause`, { method: 'POST' });
+    if (!response.ok) {
+      const errorData = await response.json().catch(() => ({}));
+      throw new Error(errorData.error || 'Failed to pause tournament');
+    }
+    return response.json();
+  }
+
+  async getLeaderboard(): Promise<Rating[]> {
+    const response = await fetch(`${API_BASE}/leaderboard`);
+    if (!response.ok) throw new Error('Failed to fetch leaderboard');
+    return response.json();
+  }
 }
 
 export const api = new ApiClient();
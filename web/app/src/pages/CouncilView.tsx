@@ -21,10 +21,21 @@ function formatDuration(ms: number): string {
   return `${ms}ms`;
 }
 
-function buildMetadata(item: { input_tokens?: number; output_tokens?: number; duration_ms?: number }): string | undefined {
+function buildMetadata(item: {
+  input_tokens?: number;
+  output_tokens?: number;
+  duration_ms?: number;
+  tokens_estimated?: boolean;
+  input_estimated?: boolean;
+  output_estimated?: boolean;
+}): string | undefined {
   const parts: string[] = [];
   if (item.input_tokens || item.output_tokens) {
-    parts.push(`↑${formatTokens(item.input_tokens || 0)} ↓${formatTokens(item.output_tokens || 0)}`);
+    // Older records only say that some count was estimated
+    const legacy = item.tokens_estimated && !item.input_estimated && !item.output_estimated;
+    const inApprox = item.input_estimated || legacy ? '~' : '';
+    const outApprox = item.output_estimated || legacy ? '~' : '';
+    parts.push(`↑${inApprox}${formatTokens(item.input_tokens || 0)} ↓${outApprox}${formatTokens(item.output_tokens || 0)}`);
   }
   if (item.duration_ms && item.duration_ms > 0) {
     parts.push(formatDuration(item.duration_ms));
@@ -68,6 +79,14 @@ export function CouncilView() {
     },
   });
 
+  const askMutation = useMutation({
+    mutationFn: (question: string) => api.askChairman(id!, question),
+    onSuccess: () => {
+      setFollowUp('');
+      queryClient.invalidateQueries({ queryKey: ['council', id] });
+    },
+  });
+
   // Streaming effect
   useEffect(() => {
     if (!id || data?.council.status !== 'in_progress') return;
@@ -376,7 +395,7 @@ export function CouncilView() {
           <RoundContainer
             key={round}
             roundNumber={round}
-            stage={roundSynthesis ? '✓ Synthesized' : `${roundResponses.filter(r => r.member_id !== 'user').length}/${council.members.length} responses`}
+            stage={roundSynthesis ? '✓ Synthesized' : `${roundResponses.filter(r => r.member_id !== 'user' && r.response_type !== 'answer').length}/${council.members.length} responses`}
           >
             {/* User message */}
             {roundResponses.filter(r => r.member_id === 'user').map(userMsg => (
@@ -527,6 +546,25 @@ export function CouncilView() {
                 )}
               </>
             )}
+
+            {/* Questions answered by the chairman */}
+            {roundResponses.filter(r => r.response_type === 'answer').map(answer => (
+              <div key={answer.id} className="space-y-4">
+                <Message.Root role="user" name="You (Question)" timestamp={answer.created_at}>
+                  {answer.question}
+                </Message.Root>
+                <Message.Root
+                  role="agent"
+                  name={council.chairman.name}
+                  avatar="🏛️"
+                  agentColor="primary"
+                  timestamp={answer.created_at}
+                  metadata={buildMetadata(answer)}
+                >
+                  {answer.content}
+                </Message.Root>
+              </div>
+            ))}
           </RoundContainer>
         );
       })}
@@ -573,7 +611,7 @@ export function CouncilView() {
             </div>
             <div>
               <h3 className="text-lg font-bold text-[#d3c6aa]">Guide the Council</h3>
-              <p className="text-sm text-[#859289]">Add a follow-up directive or ask the members to re-evaluate based on new info.</p>
+              <p className="text-sm text-[#859289]">Add a follow-up directive or ask the members to re-evaluate based on new info. Ask the chairman for a quick answer from the record without reconvening.</p>
             </div>
           </div>
 
@@ -586,7 +624,15 @@ export function CouncilView() {
               rows={3}
               className="w-full bg-brand-bg border-2 border-brand-border rounded-xl p-4 text-[#d3c6aa] focus:border-brand-primary outline-none transition-all"
             />
-            <div className="flex justify-end">
+            <div className="flex justify-end gap-3">
+              <button
+                type="button"
+                onClick={() => askMutation.mutate(followUp.trim())}
+                disabled={!followUp.trim() || askMutation.isPending || followUpMutation.isPending}
+                className="px-6 py-3 bg-brand-bg border-2 border-brand-border hover:border-brand-primary text-[#d3c6aa] font-bold rounded-lg transition-all transform active:scale-95 disabled:opacity-50"
+              >
+                {askMutation.isPending ? 'Asking...' : 'Ask Chairman'}
+              </button>
               <button
                 type="submit"
                 disabled={!followUp.trim() || followUpMutation.isPending}
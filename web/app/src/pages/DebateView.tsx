@@ -8,7 +8,7 @@ import { api } from '../lib/api';
 import { useDebateStream } from '../hooks/useDebateStream';
 import { Message } from '../components/Message';
 import { RoundContainer } from '../components/RoundContainer';
-import type { Turn, Conclusion, DebateStats } from '../types';
+import type { Turn, Conclusion, DebateStats, AgentStats } from '../types';
 
 // Helper to format tokens
 function formatTokens(n: number): string {
@@ -30,6 +30,7 @@ export function DebateView() {
   const queryClient = useQueryClient();
   const [deleting, setDeleting] = useState(false);
   const [followUp, setFollowUp] = useState('');
+  const [humanTurn, setHumanTurn] = useState('');
   const scrollRef = useRef<HTMLDivElement>(null);
 
   const { data, isLoading, error } = useQuery({
@@ -42,14 +43,15 @@ export function DebateView() {
     },
   });
 
-  const { turns: streamingTurns, streamingTurn, debate: streamedDebate } = useDebateStream(
-    data?.debate.status === 'in_progress' ? id : undefined,
+  const { turns: streamingTurns, streamingTurn, debate: streamedDebate, awaiting: streamedAwaiting } = useDebateStream(
+    data?.debate.status === 'in_progress' || data?.debate.status === 'awaiting_input' ? id : undefined,
     data?.turns
   );
 
   const debate = streamedDebate || data?.debate;
   const turns = streamingTurns.length > 0 ? streamingTurns : data?.turns || [];
   const stats: DebateStats | undefined = data?.stats;
+  const awaiting = streamedAwaiting || data?.awaiting_input;
 
   const followUpMutation = useMutation({
     mutationFn: (content: string) => api.addDebateFollowUp(id!, content),
@@ -59,6 +61,20 @@ export function DebateView() {
     },
   });
 
+  const humanTurnMutation = useMutation({
+    mutationFn: (content: string) => api.submitDebateTurn(id!, content),
+    onSuccess: () => {
+      setHumanTurn('');
+      queryClient.invalidateQueries({ queryKey: ['debate', id] });
+    },
+  });
+
+  const handleHumanTurn = (e: React.FormEvent) => {
+    e.preventDefault();
+    if (!humanTurn.trim() || humanTurnMutation.isPending) return;
+    humanTurnMutation.mutate(humanTurn.trim());
+  };
+
   // Auto-scroll to bottom on new turns
   useEffect(() => {
     if (scrollRef.current) {
@@ -136,6 +152,30 @@ export function DebateView() {
     );
   }
 
+  const participants = debate.agents?.length ? debate.agents : [debate.agent_a, debate.agent_b];
+  const agentStats: AgentStats[] =
+    stats?.agents ??
+    (stats
+      ? [
+          {
+            agent_id: debate.agent_a.id,
+            input_tokens: stats.agent_a_input_tokens,
+            output_tokens: stats.agent_a_output_tokens,
+            total_tokens: stats.agent_a_total_tokens,
+            duration_ms: stats.agent_a_duration_ms,
+            turn_count: stats.agent_a_turn_count,
+          },
+          {
+            agent_id: debate.agent_b.id,
+            input_tokens: stats.agent_b_input_tokens,
+            output_tokens: stats.agent_b_output_tokens,
+            total_tokens: stats.agent_b_total_tokens,
+            duration_ms: stats.agent_b_duration_ms,
+            turn_count: stats.agent_b_turn_count,
+          },
+        ]
+      : []);
+
   const statusColor = {
     completed: 'bg-[#a7c080]/10 text-[#a7c080]',
     in_progress: 'bg-[#7fbbb3]/10 text-[#7fbbb3]',
@@ -181,6 +221,9 @@ export function DebateView() {
               <span>
                 {rounds.length} Round{rounds.length !== 1 ? 's' : ''} • {turns.length} Turn{turns.length !== 1 ? 's' : ''}
               </span>
+              {debate.stop_reason && (
+                <span className="text-yellow-400">Stopped early: {debate.stop_reason}</span>
+              )}
             </div>
           </div>
           <div className="flex space-x-2">
@@ -239,14 +282,19 @@ export function DebateView() {
 
         {/* Agents */}
         <div className="mt-6 grid grid-cols-2 gap-4">
-          <div className="border border-brand-primary border-opacity-30 rounded-lg p-4 bg-brand-primary bg-opacity-5">
-            <div className="font-medium text-brand-primary text-lg">{debate.agent_a.name}</div>
-            <div className="text-sm text-[#859289] mt-1">{debate.agent_a.persona}</div>
-          </div>
-          <div className="border border-brand-secondary border-opacity-30 rounded-lg p-4 bg-brand-secondary bg-opacity-5">
-            <div className="font-medium text-brand-secondary text-lg">{debate.agent_b.name}</div>
-            <div className="text-sm text-[#859289] mt-1">{debate.agent_b.persona}</div>
-          </div>
+          {participants.map((agent, index) =>
+            index % 2 === 0 ? (
+              <div key={agent.id} className="border border-brand-primary border-opacity-30 rounded-lg p-4 bg-brand-primary bg-opacity-5">
+                <div className="font-medium text-brand-primary text-lg">{agent.name}</div>
+                <div className="text-sm text-[#859289] mt-1">{agent.persona}</div>
+              </div>
+            ) : (
+              <div key={agent.id} className="border border-brand-secondary border-opacity-30 rounded-lg p-4 bg-brand-secondary bg-opacity-5">
+                <div className="font-medium text-brand-secondary text-lg">{agent.name}</div>
+                <div className="text-sm text-[#859289] mt-1">{agent.persona}</div>
+              </div>
+            )
+          )}
         </div>
 
         {debate.cwd && (
@@ -261,7 +309,17 @@ export function DebateView() {
         {/* Usage Stats */}
         {stats && stats.total_tokens > 0 && (
           <div className="mt-6 border-t border-brand-border pt-6">
-            <h3 className="text-sm font-medium text-[#859289] mb-3">Usage Statistics</h3>
+            <h3 className="text-sm font-medium text-[#859289] mb-3">
+              Usage Statistics
+              {stats.tokens_estimated && (
+                <span
+                  className="ml-2 text-xs opacity-70"
+                  title={`${stats.estimated_turn_count} of ${stats.turn_count} turns have estimated token counts`}
+                >
+                  (includes estimates)
+                </span>
+              )}
+            </h3>
             <div className="grid grid-cols-3 gap-4 text-sm">
               {/* Total */}
               <div className="bg-brand-bg rounded-lg p-3 border border-brand-border">
@@ -275,31 +333,40 @@ export function DebateView() {
                   <div className="text-[#859289] text-xs mt-1">{formatDuration(stats.total_duration_ms)}</div>
                 )}
               </div>
-              {/* Agent A */}
-              <div className="bg-brand-primary bg-opacity-5 rounded-lg p-3 border border-brand-primary border-opacity-30">
-                <div className="text-brand-primary text-xs mb-1">{debate.agent_a.name.split(' ')[0]}</div>
-                <div className="text-[#d3c6aa] font-medium">
-                  <span title="Input tokens">↑{formatTokens(stats.agent_a_input_tokens)}</span>
-                  {' '}
-                  <span title="Output tokens">↓{formatTokens(stats.agent_a_output_tokens)}</span>
-                </div>
-                {stats.agent_a_duration_ms > 0 && (
-                  <div className="text-[#859289] text-xs mt-1">{formatDuration(stats.agent_a_duration_ms)}</div>
-                )}
-              </div>
-              {/* Agent B */}
-              <div className="bg-brand-secondary bg-opacity-5 rounded-lg p-3 border border-brand-secondary border-opacity-30">
-                <div className="text-brand-secondary text-xs mb-1">{debate.agent_b.name.split(' ')[0]}</div>
-                <div className="text-[#d3c6aa] font-medium">
-                  <span title="Input tokens">↑{formatTokens(stats.agent_b_input_tokens)}</span>
-                  {' '}
-                  <span title="Output tokens">↓{formatTokens(stats.agent_b_output_tokens)}</span>
-                </div>
-                {stats.agent_b_duration_ms > 0 && (
-                  <div className="text-[#859289] text-xs mt-1">{formatDuration(stats.agent_b_duration_ms)}</div>
-                )}
-              </div>
+              {agentStats.map((agentStat, index) => {
+                const agent = participants.find((p) => p.id === agentStat.agent_id);
+                const isPrimary = index % 2 === 0;
+                return (
+                  <div
+                    key={agentStat.agent_id}
+                    className={
+                      isPrimary
+                        ? 'bg-brand-primary bg-opacity-5 rounded-lg p-3 border border-brand-primary border-opacity-30'
+                        : 'bg-brand-secondary bg-opacity-5 rounded-lg p-3 border border-brand-secondary border-opacity-30'
+                    }
+                  >
+                    <div className={isPrimary ? 'text-brand-primary text-xs mb-1' : 'text-brand-secondary text-xs mb-1'}>
+                      {agent?.name.split(' ')[0]}
+                    </div>
+                    <div className="text-[#d3c6aa] font-medium">
+                      <span title="Input tokens">↑{formatTokens(agentStat.input_tokens)}</span>
+                      {' '}
+                      <span title="Output tokens">↓{formatTokens(agentStat.output_tokens)}</span>
+                    </div>
+                    {agentStat.duration_ms > 0 && (
+                      <div className="text-[#859289] text-xs mt-1">{formatDuration(agentStat.duration_ms)}</div>
+                    )}
+                  </div>
+                );
+              })}
             </div>
+            {/* Moderator stats if any */}
+            {stats.moderator_total_tokens > 0 && (
+              <div className="mt-2 text-xs text-[#859289]">
+                Moderator: ↑{formatTokens(stats.moderator_input_tokens)} ↓{formatTokens(stats.moderator_output_tokens)}
+                {stats.moderator_duration_ms > 0 && ` • ${formatDuration(stats.moderator_duration_ms)}`}
+              </div>
+            )}
             {/* Conclusion stats if any */}
             {stats.conclusion_total_tokens > 0 && (
               <div className="mt-2 text-xs text-[#859289]">
@@ -378,6 +445,9 @@ export function DebateView() {
                       <span className="text-xs font-bold uppercase tracking-wider">
                         {conclusion.agreed ? 'Consensus Reached' : 'Divergent Views'}
                       </span>
+                      {conclusion.agreed && conclusion.model_votes_only && (
+                        <span className="text-xs text-[#9da9a0]">AI vote only</span>
+                      )}
                       {conclusion.early_consensus && (
                         <span className="text-xs bg-brand-primary/20 text-brand-primary px-2 py-0.5 rounded-full">
                           Early Consensus
@@ -430,13 +500,25 @@ export function DebateView() {
                   );
                 }
 
-                const isAgentA = turn.agent_id === debate.agent_a.id;
-                const agent = isAgentA ? debate.agent_a : debate.agent_b;
+                const isJudge = debate.judge?.id === turn.agent_id;
+                const isModerator = debate.moderator?.id === turn.agent_id;
+                const agentIndex = Math.max(0, participants.findIndex((p) => p.id === turn.agent_id));
+                const agent =
+                  isJudge && debate.judge
+                    ? debate.judge
+                    : isModerator && debate.moderator
+                      ? debate.moderator
+                      : participants[agentIndex];
+                const isAgentA = agentIndex % 2 === 0;
 
                 // Build metadata string with tokens if available
                 let metadata = `Turn ${turn.number}`;
                 if (turn.input_tokens || turn.output_tokens) {
-                  metadata += ` • ↑${formatTokens(turn.input_tokens || 0)} ↓${formatTokens(turn.output_tokens || 0)}`;
+                  // Older turns only record that some count was estimated
+                  const legacy = turn.tokens_estimated && !turn.input_estimated && !turn.output_estimated;
+                  const inApprox = turn.input_estimated || legacy ? '~' : '';
+                  const outApprox = turn.output_estimated || legacy ? '~' : '';
+                  metadata += ` • ↑${inApprox}${formatTokens(turn.input_tokens || 0)} ↓${outApprox}${formatTokens(turn.output_tokens || 0)}`;
                   if (turn.duration_ms && turn.duration_ms > 0) {
                     metadata += ` • ${formatDuration(turn.duration_ms)}`;
                   }
@@ -447,7 +529,7 @@ export function DebateView() {
                     key={turn.id}
                     role="agent"
                     name={agent.name}
-                    avatar={isAgentA ? '💭' : '🧠'}
+                    avatar={isJudge ? '⚖️' : isModerator ? '🎙️' : isAgentA ? '💭' : '🧠'}
                     agentColor={isAgentA ? 'primary' : 'secondary'}
                     timestamp={turn.created_at}
                     metadata={metadata}
@@ -461,9 +543,9 @@ export function DebateView() {
               {streamingTurn && streamingTurn.round === roundNum && (
                 <Message.Root
                   role="agent"
-                  name={streamingTurn.agentId === debate.agent_a.id ? debate.agent_a.name : debate.agent_b.name}
-                  avatar={streamingTurn.agentId === debate.agent_a.id ? '💭' : '🧠'}
-                  agentColor={streamingTurn.agentId === debate.agent_a.id ? 'primary' : 'secondary'}
+                  name={participants.find((p) => p.id === streamingTurn.agentId)?.name ?? debate.agent_a.name}
+                  avatar={participants.findIndex((p) => p.id === streamingTurn.agentId) % 2 === 1 ? '🧠' : '💭'}
+                  agentColor={participants.findIndex((p) => p.id === streamingTurn.agentId) % 2 === 1 ? 'secondary' : 'primary'}
                   metadata={`Turn ${streamingTurn.number}`}
                   isStreaming
                 >
@@ -479,12 +561,37 @@ export function DebateView() {
                     <div className="flex-1">
                       <div className="font-semibold text-sm mb-1">
                         {roundConclusion.agreed ? 'Consensus Reached' : 'Divergent Views'}
+                        {roundConclusion.agreed && roundConclusion.model_votes_only && (
+                          <span className="ml-2 text-xs font-normal text-[#9da9a0]">AI vote only</span>
+                        )}
                       </div>
                       <div className="text-xs text-[#9da9a0]">
                         <ReactMarkdown remarkPlugins={[remarkGfm, remarkBreaks]}>
                           {roundConclusion.summary}
                         </ReactMarkdown>
                       </div>
+                      {roundConclusion.consensus && (
+                        <div className="mt-2 text-xs text-[#9da9a0] opacity-70">
+                          Consensus check ({roundConclusion.consensus.method}):{' '}
+                          {roundConclusion.consensus.score.toFixed(2)} / {roundConclusion.consensus.threshold.toFixed(2)}
+                          {' — '}
+                          {roundConclusion.consensus.rationale}
+                        </div>
+                      )}
+                      {roundConclusion.verdict && (
+                        <div className="mt-2 space-y-1 text-xs text-[#9da9a0]">
+                          {roundConclusion.verdict.scores.map((score) => (
+                            <div key={score.agent_id} title={score.feedback}>
+                              {roundConclusion.verdict?.winner_id === score.agent_id ? '🏆 ' : ''}
+                              {participants.find((p) => p.id === score.agent_id)?.name}: {score.total} pts
+                              {' '}
+                              <span className="opacity-70">
+                                ({Object.entries(score.scores).map(([criterion, n]) => `${criterion} ${n}`).join(', ')})
+                              </span>
+                            </div>
+                          ))}
+                        </div>
+                      )}
                     </div>
                   </div>
                 </Message.Root>
@@ -524,6 +631,40 @@ export function DebateView() {
           </div>
         )}
 
+        {/* Human Turn Input */}
+        {awaiting && (
+          <div className="animate-fadeIn bg-brand-card rounded-2xl border-2 border-brand-secondary p-8 shadow-2xl">
+            <div className="mb-6">
+              <h3 className="text-lg font-bold text-[#d3c6aa]">Your turn as {awaiting.masked_name}</h3>
+              <p className="text-sm text-[#859289]">
+                Turn {awaiting.turn_number} of {awaiting.total_turns}, round {awaiting.round}. The other participants see your argument under this name.
+              </p>
+            </div>
+
+            <form onSubmit={handleHumanTurn} className="space-y-4">
+              <textarea
+                value={humanTurn}
+                onChange={(e) => setHumanTurn(e.target.value)}
+                placeholder="Make your argument..."
+                rows={6}
+                className="w-full bg-brand-bg border-2 border-brand-border rounded-xl p-4 text-[#d3c6aa] focus:border-brand-secondary outline-none transition-all"
+              />
+              {humanTurnMutation.isError && (
+                <p className="text-sm text-brand-accent">{(humanTurnMutation.error as Error).message}</p>
+              )}
+              <div className="flex justify-end">
+                <button
+                  type="submit"
+                  disabled={!humanTurn.trim() || humanTurnMutation.isPending}
+                  className="px-6 py-3 bg-brand-secondary text-[#2b3339] font-bold rounded-lg transition-all transform active:scale-95 disabled:opacity-50"
+                >
+                  {humanTurnMutation.isPending ? 'Submitting...' : 'Submit Turn'}
+                </button>
+              </div>
+            </form>
+          </div>
+        )}
+
         {/* Follow-up Input */}
         {debate.status === 'completed' && !debate.read_only && (
           <div className="animate-fadeIn bg-brand-card rounded-2xl border border-brand-border p-8 shadow-2xl">
@@ -36,6 +36,10 @@ export function History() {
         return 'bg-brand-primary/10 text-brand-primary';
       case 'in_progress':
         return 'bg-brand-blue/10 text-brand-blue';
+      case 'awaiting_input':
+      case 'paused':
+      case 'interrupted':
+        return 'bg-brand-secondary/10 text-brand-secondary';
       case 'failed':
         return 'bg-brand-accent/10 text-brand-accent';
       default:
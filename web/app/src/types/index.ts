@@ -1,4 +1,6 @@
-export type DebateStatus = 'pending' | 'in_progress' | 'completed' | 'failed';
+export type DebateStatus = 'pending' | 'in_progress' | 'awaiting_input' | 'paused' | 'interrupted' | 'completed' | 'failed' | 'cancelled';
+
+export type RunAction = 'pause' | 'resume' | 'cancel' | 'rerun';
 
 export interface Agent {
   id: string;
@@ -6,9 +8,10 @@ export interface Agent {
   provider: string;
   model: string;
   persona: string;
+  stance?: string; // FOR, AGAINST or a free-text position
 }
 
-export type TurnType = 'debate' | 'conclusion' | 'vote' | 'user';
+export type TurnType = 'debate' | 'conclusion' | 'vote' | 'judge' | 'moderator' | 'user';
 
 export interface Turn {
   id: string;
@@ -26,9 +29,110 @@ export interface Turn {
   duration_ms?: number;
   model?: string;
   stop_reason?: string;
+  tokens_estimated?: boolean;
+  input_estimated?: boolean;
+  output_estimated?: boolean;
+  cost_usd?: number;
   // Failure tracking
   status?: string;
   error?: string;
+  // Regenerated versions; the fields above hold the selected one
+  versions?: Version[];
+  selected_version?: number;
+  novelty?: Novelty; // Set on debate turns
+  steelman?: SteelmanScore; // Set on steelman debate turns that answer another agent
+  references?: CodeReference[]; // Code the turn cites, checked against the workspace
+  targeting?: Targeting; // Set on user follow-ups addressed to some agents only
+}
+
+// Who a targeted follow-up addresses
+export interface Targeting {
+  agent_ids: string[];
+  synthesize?: boolean; // Councils: the chairman synthesizes the targeted answers
+}
+
+// A file, line range or symbol cited in a turn or response
+export interface CodeReference {
+  text: string; // As written, e.g. "internal/engine/engine.go:40-52"
+  path?: string; // Empty for a symbol
+  line_start?: number;
+  line_end?: number;
+  symbol?: string;
+  verified: boolean;
+  resolved?: string; // Workspace file it matched (file:line for symbols)
+  reason?: string; // Why it could not be verified
+}
+
+// How faithfully a turn restated the argument it answers
+export interface SteelmanScore {
+  target_id: string;
+  target_turn: number;
+  rater_id: string; // The judge, or the target agent
+  restatement: string;
+  score: number; // Fidelity 1-10; 0 if no restatement was found
+  rationale: string;
+  passed: boolean;
+  attempts: number;
+}
+
+// How much a turn adds over its agent's earlier turns
+export interface Novelty {
+  score: number; // Share of word trigrams not used before (1 = all new)
+  overlap: number;
+  ngrams: number;
+}
+
+// One argument extracted from a debate turn
+export interface Claim {
+  id: string; // "t<turn>c<n>"
+  turn_id: string;
+  turn_number: number;
+  agent_id: string;
+  text: string;
+  evidence?: string[];
+  rebuts?: string[]; // IDs of earlier claims this one answers
+}
+
+export interface ArgumentGraph {
+  debate_id: string;
+  claims: Claim[];
+  pending?: number; // Turns not extracted yet
+  unanswered: string[]; // Claims no other agent rebutted
+  error?: string; // Why extraction stopped early
+}
+
+// One generated version of a turn or council response
+export interface Version {
+  content: string;
+  created_at: string;
+  input_tokens?: number;
+  output_tokens?: number;
+  total_tokens?: number;
+  duration_ms?: number;
+  model?: string;
+  stop_reason?: string;
+  tokens_estimated?: boolean;
+  input_estimated?: boolean;
+  output_estimated?: boolean;
+  cost_usd?: number;
+  status?: string;
+  error?: string;
+}
+
+// Limits on what a debate or council may spend; omitted limits are unlimited
+export interface Budget {
+  max_tokens?: number;
+  max_cost_usd?: number;
+  max_duration_ms?: number;
+}
+
+export interface AgentStats {
+  agent_id: string;
+  input_tokens: number;
+  output_tokens: number;
+  total_tokens: number;
+  duration_ms: number;
+  turn_count: number;
 }
 
 export interface DebateStats {
@@ -38,7 +142,12 @@ export interface DebateStats {
   total_tokens: number;
   total_duration_ms: number;
   turn_count: number;
-  // Per-agent breakdown
+  // Estimation tracking
+  tokens_estimated: boolean;
+  estimated_turn_count: number;
+  // Per-agent breakdown (every participant)
+  agents?: AgentStats[];
+  // First two participants
   agent_a_input_tokens: number;
   agent_a_output_tokens: number;
   agent_a_total_tokens: number;
@@ -50,6 +159,11 @@ export interface DebateStats {
   agent_b_duration_ms: number;
   agent_b_turn_count: number;
   // Conclusion/voting stats
+  moderator_input_tokens: number;
+  moderator_output_tokens: number;
+  moderator_total_tokens: number;
+  moderator_duration_ms: number;
+  moderator_turn_count: number;
   conclusion_input_tokens: number;
   conclusion_output_tokens: number;
   conclusion_total_tokens: number;
@@ -58,8 +172,88 @@ export interface DebateStats {
 }
 
 export interface Vote {
-  agrees: boolean;
+  agent_id: string;
+  agrees: boolean; // Option votes agree when they chose the winning option
   reasoning?: string;
+  option?: string; // Chosen option when the debate votes over options
+  confidence?: number; // 0-1, option votes only
+}
+
+// Vote over options instead of AGREE/DISAGREE; no options extracts them from the debate
+export interface VoteConfig {
+  options?: string[];
+}
+
+export interface OptionTally {
+  option: string;
+  votes: number;
+  confidence: number; // Sum of the voters' confidence
+  agent_ids?: string[];
+}
+
+export interface VoteTally {
+  options: OptionTally[];
+  winner?: string; // Empty on a tie
+}
+
+export type VerdictOutcome = 'winner' | 'consensus' | 'draw';
+
+export interface JudgeScore {
+  agent_id: string;
+  scores: Record<string, number>;
+  total: number;
+  feedback?: string;
+}
+
+export interface JudgeVerdict {
+  judge_id: string;
+  outcome: VerdictOutcome;
+  winner_id?: string;
+  scores: JudgeScore[];
+}
+
+export type ConsensusMethod = 'hybrid' | 'keyword' | 'llm' | 'stance';
+
+export interface ConsensusConfig {
+  method: ConsensusMethod;
+  threshold?: number;
+  keywords?: string[];
+}
+
+export interface ConsensusResult {
+  method: ConsensusMethod;
+  score: number;
+  threshold: number;
+  reached: boolean;
+  rationale: string;
+}
+
+export interface StalemateConfig {
+  disabled?: boolean;
+  threshold?: number;
+  confirm?: boolean;
+}
+
+export interface StalemateResult {
+  novelty: number[];
+  threshold: number;
+  confirmed?: boolean;
+  rationale: string;
+}
+
+export type ConclusionType = '' | 'stalemate';
+
+export interface SteelmanConfig {
+  min_score?: number; // 1-10, defaults to 7
+  max_retries?: number; // Defaults to 1
+}
+
+export interface SteelmanSummary {
+  agent_id: string;
+  turns: number;
+  average: number;
+  passed: number;
+  retries: number;
 }
 
 export interface Conclusion {
@@ -71,8 +265,18 @@ export interface Conclusion {
   agent_b_summary?: string;
   agent_a_vote?: Vote;
   agent_b_vote?: Vote;
+  votes?: Vote[];
+  model_votes_only?: boolean; // agreed reflects the AI participants only; the human seat does not vote
+  tally?: VoteTally; // Set when the debate votes over options
+  verdict?: JudgeVerdict;
+  consensus?: ConsensusResult;
+  type?: ConclusionType;
+  stalemate?: StalemateResult; // Set when type is 'stalemate'
+  steelman?: SteelmanSummary[]; // Set when the debate has the steelman step
 }
 
+export type SpeakingOrder = 'round_robin' | 'random' | 'moderator';
+
 export interface Debate {
   id: string;
   title: string;
@@ -82,6 +286,19 @@ export interface Debate {
   project_instructions?: string;
   agent_a: Agent;
   agent_b: Agent;
+  agents?: Agent[];
+  speaking_order?: SpeakingOrder;
+  judge?: Agent;
+  moderator?: Agent; // Speaks between rotations
+  consensus?: ConsensusConfig;
+  vote?: VoteConfig;
+  stalemate?: StalemateConfig;
+  steelman?: SteelmanConfig;
+  budget?: Budget;
+  stop_reason?: string; // Why the last run ended early, e.g. its budget ran out
+  parent_id?: string;
+  fork_point?: number; // Last parent turn copied into this fork
+  swap_of?: string; // Debate this one reruns with the stances swapped
   status: DebateStatus;
   style: string;
   total_turns: number;
@@ -106,6 +323,7 @@ export interface DebateSummary {
   style: string;
   agent_a: string;
   agent_b: string;
+  agent_count: number;
   turn_count: number;
   read_only: boolean;
   created_at: string;
@@ -131,6 +349,24 @@ export interface Style {
   id: string;
   name: string;
   description: string;
+  consensus_method?: ConsensusMethod;
+  consensus_threshold?: number;
+  phases?: Phase[];
+}
+
+// A stage of a round in a multi-phase style
+export interface Phase {
+  id: string;
+  name: string;
+  turns?: number; // Per agent; 0 or unset shares the leftover turns
+  prompt?: string;
+  roles?: PhaseRole[];
+}
+
+// Assigned by speaking position: first speaker plays the first role
+export interface PhaseRole {
+  name: string;
+  prompt?: string;
 }
 
 export interface CreateDebateRequest {
@@ -142,6 +378,16 @@ export interface CreateDebateRequest {
   agent_b_provider: string;
   agent_b_model: string;
   agent_b_persona: string;
+  agent_a_stance?: string;
+  agent_b_stance?: string;
+  moderator_provider?: string;
+  moderator_model?: string;
+  moderator_persona?: string;
+  budget?: Budget;
+  vote?: VoteConfig;
+  stalemate?: StalemateConfig;
+  steelman?: SteelmanConfig;
+  preset_id?: string;
   style: string;
   max_turns: number;
   auto_run?: boolean;
@@ -153,16 +399,143 @@ export interface CouncilSummary {
   topic: string;
   cwd: string;
   project_id?: string;
+  schedule_id?: string;
   status: DebateStatus;
   member_count: number;
   created_at: string;
 }
 
+// The human turn a debate is waiting for
+export interface AwaitingInput {
+  agent_id: string;
+  masked_name: string;
+  round: number;
+  turn_number: number;
+  total_turns: number;
+}
+
 export interface StreamEvent {
-  type: 'turn_start' | 'content' | 'turn_complete' | 'debate_complete' | 'error';
+  type: 'turn_start' | 'content' | 'turn_complete' | 'awaiting_input' | 'debate_complete' | 'error';
   data: any;
 }
 
+// Replaces one seat's provider, model or persona in a fork
+export interface AgentSwap {
+  seat: number; // 0-based
+  provider?: string;
+  model?: string;
+  persona?: string;
+}
+
+export interface ForkRequest {
+  at: number; // Last turn (debates) or round (councils) to copy; 0 copies everything
+  content?: string; // Debates only
+  style?: string; // Debates only
+  swaps?: AgentSwap[];
+}
+
+export interface ForkNode {
+  id: string;
+  title: string;
+  status: DebateStatus;
+  fork_point?: number;
+  created_at: string;
+  forks?: ForkNode[];
+}
+
+// Comparison of a debate with its swap-sides rerun
+export type SideDependence = 'side' | 'provider' | 'neither' | 'inconclusive';
+
+export interface SideResult {
+  debate_id: string;
+  status: DebateStatus;
+  agreed: boolean;
+  outcome?: 'winner' | 'consensus' | 'draw';
+  winner?: string;
+  winner_stance?: string;
+}
+
+export interface SideComparison {
+  original: SideResult;
+  swapped: SideResult;
+  depends_on: SideDependence;
+}
+
+export type TournamentFormat = 'round_robin' | 'bracket';
+
+// provider[/model][:persona]; its string form identifies the entrant on the leaderboard
+export interface TournamentEntrant {
+  provider: string;
+  model?: string;
+  persona?: string;
+}
+
+export interface TournamentMatch {
+  id: string;
+  tournament_id: string;
+  round: number; // Bracket round; always 1 in a round robin
+  topic: string;
+  entrant_a: string;
+  entrant_b: string;
+  debate_id?: string;
+  status: DebateStatus;
+  outcome?: VerdictOutcome;
+  winner?: string;
+  error?: string;
+  created_at: string;
+  completed_at?: string;
+}
+
+export interface TournamentStanding {
+  entrant: string;
+  points: number;
+  wins: number;
+  losses: number;
+  draws: number;
+}
+
+export interface Tournament {
+  id: string;
+  name: string;
+  topics: string[];
+  entrants: TournamentEntrant[];
+  judge: TournamentEntrant;
+  format: TournamentFormat;
+  style: string;
+  max_turns: number;
+  workers: number;
+  status: DebateStatus;
+  matches?: TournamentMatch[];
+  standings?: TournamentStanding[]; // Set when fetching a single tournament
+  winner?: string;
+  created_at: string;
+  updated_at: string;
+  completed_at?: string;
+}
+
+export interface CreateTournamentRequest {
+  name?: string;
+  topics: string[];
+  entrants: TournamentEntrant[];
+  judge: TournamentEntrant;
+  format?: TournamentFormat;
+  style?: string;
+  max_turns?: number;
+  workers?: number;
+  auto_run?: boolean;
+}
+
+// Elo rating from every judged tournament match an entrant has played
+export interface Rating {
+  entrant: string;
+  rating: number;
+  matches: number;
+  wins: number;
+  losses: number;
+  draws: number;
+  updated_at: string;
+}
+
 export interface MemberSpec {
   Provider: string;
   Model?: string;
@@ -174,6 +547,8 @@ export interface CreateCouncilRequest {
   ProjectID?: string;
   Members: MemberSpec[];
   Chairman?: MemberSpec;
+  Budget?: Budget;
+  preset_id?: string;
   auto_run?: boolean;
 }
 
@@ -188,6 +563,10 @@ export interface CouncilSynthesis {
   duration_ms?: number;
   model?: string;
   stop_reason?: string;
+  tokens_estimated?: boolean;
+  input_estimated?: boolean;
+  output_estimated?: boolean;
+  cost_usd?: number;
 }
 
 export interface Council {
@@ -199,6 +578,11 @@ export interface Council {
   project_instructions?: string;
   members: Agent[];
   chairman: Agent;
+  parent_id?: string;
+  fork_point?: number; // Last parent round copied into this fork
+  schedule_id?: string; // Schedule that started this council
+  budget?: Budget;
+  stop_reason?: string;
   status: DebateStatus;
   syntheses?: CouncilSynthesis[];
   created_at: string;
@@ -225,6 +609,16 @@ export interface CouncilResponse {
   duration_ms?: number;
   model?: string;
   stop_reason?: string;
+  tokens_estimated?: boolean;
+  input_estimated?: boolean;
+  output_estimated?: boolean;
+  cost_usd?: number;
+  versions?: Version[];
+  selected_version?: number;
+  response_type?: 'response' | 'answer'; // answer: the chairman's reply to a question about a completed council
+  references?: CodeReference[]; // Code the response cites, checked against the workspace
+  targeting?: Targeting; // Set on user follow-ups addressed to some members only
+  question?: string; // Set on answers
 }
 
 export interface CouncilRanking {
@@ -243,6 +637,10 @@ export interface CouncilRanking {
   duration_ms?: number;
   model?: string;
   stop_reason?: string;
+  tokens_estimated?: boolean;
+  input_estimated?: boolean;
+  output_estimated?: boolean;
+  cost_usd?: number;
 }
 
 export interface Project {
@@ -250,6 +648,84 @@ export interface Project {
   name: string;
   description: string;
   instructions: string;
+  budget?: Budget; // Default for the project's debates and councils
+  created_at: string;
+  updated_at: string;
+}
+
+export type PresetMode = 'council' | 'debate';
+
+export interface PresetMember {
+  provider: string;
+  model?: string;
+  persona?: string;
+  stance?: string;
+}
+
+// Named session settings; a create request with preset_id takes the ones it leaves unset
+export interface Preset {
+  id: string;
+  name: string;
+  description?: string;
+  mode: PresetMode;
+  members?: PresetMember[];
+  chairman?: PresetMember;
+  style?: string;
+  max_turns?: number;
+  project_id?: string;
+  budget?: Budget;
   created_at: string;
   updated_at: string;
 }
+
+export interface PresetRequest {
+  name: string;
+  description?: string;
+  mode?: PresetMode;
+  members?: PresetMember[];
+  chairman?: PresetMember;
+  style?: string;
+  max_turns?: number;
+  project_id?: string;
+  budget?: Budget;
+}
+
+export type CatchUpPolicy = 'once' | 'skip';
+
+// A council started on a cron schedule while `conclave serve` runs
+export interface Schedule {
+  id: string;
+  name: string;
+  cron: string; // Five fields or a macro like @weekly, in server local time
+  topic: string;
+  members: PresetMember[];
+  chairman?: PresetMember;
+  workspace_id?: string;
+  project_id?: string;
+  budget?: Budget;
+  catch_up: CatchUpPolicy; // What to do about a run missed while the server was down
+  paused: boolean;
+  last_run_at?: string;
+  next_run_at?: string;
+  last_council_id?: string;
+  last_error?: string;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface ScheduleWithRuns extends Schedule {
+  runs: CouncilSummary[]; // Newest first
+}
+
+export interface ScheduleRequest {
+  name?: string;
+  cron: string;
+  topic: string;
+  members: PresetMember[];
+  chairman?: PresetMember;
+  workspace_id?: string;
+  project_id?: string;
+  budget?: Budget;
+  catch_up?: CatchUpPolicy;
+  paused?: boolean;
+}
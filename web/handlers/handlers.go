@@ -5,8 +5,10 @@ import (
 	"context"
 	"embed"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"html/template"
+	"io"
 	"log/slog"
 	"net/http"
 	"os"
@@ -20,8 +22,11 @@ import (
 	"github.com/alienxp03/conclave/internal/export"
 	"github.com/alienxp03/conclave/internal/persona"
 	"github.com/alienxp03/conclave/internal/provider"
+	"github.com/alienxp03/conclave/internal/run"
+	"github.com/alienxp03/conclave/internal/schedule"
 	"github.com/alienxp03/conclave/internal/storage"
 	"github.com/alienxp03/conclave/internal/style"
+	"github.com/alienxp03/conclave/internal/tournament"
 	"github.com/alienxp03/conclave/internal/workspace"
 	baseprovider "github.com/alienxp03/conclave/provider"
 )
@@ -33,11 +38,18 @@ var templateFS embed.FS
 type Handler struct {
 	engine        *engine.Engine
 	councilEngine *council.Engine
+	tournaments   *tournament.Runner
+	schedules     *schedule.Scheduler
 	registry      *provider.Registry
 	storage       storage.Storage
 	templates     *template.Template
 	workspaces    *workspace.Manager
 	healthCache   *providerHealthCache
+
+	// background bounds work that outlives a request, such as tournaments;
+	// Close cancels it
+	background     context.Context
+	stopBackground context.CancelCauseFunc
 }
 
 // New creates a new Handler.
@@ -66,6 +78,8 @@ func New(store storage.Storage, registry *provider.Registry, workspaces *workspa
 				return "bg-blue-100 text-blue-800"
 			case core.StatusFailed:
 				return "bg-red-100 text-red-800"
+			case core.StatusPaused, core.StatusInterrupted, core.StatusAwaitingInput:
+				return "bg-yellow-100 text-yellow-800"
 			default:
 				return "bg-gray-100 text-gray-800"
 			}
@@ -82,15 +96,57 @@ func New(store storage.Storage, registry *provider.Registry, workspaces *workspa
 		panic(err)
 	}
 
+	eng := engine.New(store, registry, workspaces)
+	councilEng := council.New(store, registry, workspaces)
+	background, stopBackground := context.WithCancelCause(context.Background())
 	return &Handler{
-		engine:        engine.New(store, registry, workspaces),
-		councilEngine: council.New(store, registry),
+		engine:        eng,
+		councilEngine: councilEng,
+		tournaments:   tournament.New(store, eng),
+		schedules:     schedule.New(store, councilEng),
 		registry:      registry,
 		storage:       store,
 		templates:     tmpl,
 		workspaces:    workspaces,
 		healthCache:   newProviderHealthCache(defaultProviderHealthCachePath(), providerHealthCacheTTL),
+
+		background:     background,
+		stopBackground: stopBackground,
+	}
+}
+
+// Close pauses the tournaments running in the background and waits up to
+// timeout for them to save their state, so they can be resumed after a
+// restart. Call it on shutdown.
+func (h *Handler) Close(timeout time.Duration) {
+	h.stopBackground(run.ErrPaused)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := h.tournaments.WaitAll(ctx); err != nil {
+		slog.Warn("Tournaments did not stop in time", "error", err)
+	}
+}
+
+// Recover resumes or interrupts, per policy, the debates and councils a
+// previous server process left in progress. Call it once before serving.
+func (h *Handler) Recover(policy core.RecoveryPolicy) {
+	debates, err := h.engine.RecoverDebates(policy)
+	if err != nil {
+		slog.Error("Failed to recover debates", "error", err)
+	}
+	councils, err := h.councilEngine.RecoverCouncils(policy)
+	if err != nil {
+		slog.Error("Failed to recover councils", "error", err)
 	}
+	if debates+councils > 0 {
+		slog.Info("Recovered sessions left in progress", "debates", debates, "councils", councils, "policy", policy)
+	}
+}
+
+// StartScheduler starts scheduled councils as they fall due, catching up on
+// runs missed while the server was down, until ctx is done.
+func (h *Handler) StartScheduler(ctx context.Context) {
+	h.schedules.Start(ctx)
 }
 
 // RegisterRoutes registers all HTTP routes.
@@ -108,6 +164,11 @@ func (h *Handler) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("GET /api/projects/{id}", h.handleAPIGetProject)
 	mux.HandleFunc("PUT /api/projects/{id}", h.handleAPIUpdateProject)
 	mux.HandleFunc("DELETE /api/projects/{id}", h.handleAPIDeleteProject)
+	mux.HandleFunc("GET /api/presets", h.handleAPIListPresets)
+	mux.HandleFunc("POST /api/presets", h.handleAPICreatePreset)
+	mux.HandleFunc("GET /api/presets/{id}", h.handleAPIGetPreset)
+	mux.HandleFunc("PUT /api/presets/{id}", h.handleAPIUpdatePreset)
+	mux.HandleFunc("DELETE /api/presets/{id}", h.handleAPIDeletePreset)
 
 	// Council routes
 	mux.HandleFunc("GET /api/councils", h.handleAPIListCouncils)
@@ -117,13 +178,44 @@ func (h *Handler) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("PUT /api/councils/{id}/title", h.handleAPIUpdateCouncilTitle)
 	mux.HandleFunc("DELETE /api/councils/{id}", h.handleAPIDeleteCouncil)
 
+	// Tournament API routes
+	mux.HandleFunc("GET /api/tournaments", h.handleAPIListTournaments)
+	mux.HandleFunc("POST /api/tournaments", h.handleAPICreateTournament)
+	mux.HandleFunc("GET /api/tournaments/{id}", h.handleAPIGetTournament)
+	mux.HandleFunc("POST /api/tournaments/{id}/resume", h.handleAPIResumeTournament)
+	mux.HandleFunc("POST /api/tournaments/{id}/pause", h.handleAPIPauseTournament)
+	mux.HandleFunc("GET /api/leaderboard", h.handleAPILeaderboard)
+
+	// Schedule API routes
+	mux.HandleFunc("GET /api/schedules", h.handleAPIListSchedules)
+	mux.HandleFunc("POST /api/schedules", h.handleAPICreateSchedule)
+	mux.HandleFunc("GET /api/schedules/{id}", h.handleAPIGetSchedule)
+	mux.HandleFunc("POST /api/schedules/{id}/trigger", h.handleAPITriggerSchedule)
+	mux.HandleFunc("POST /api/schedules/{id}/{action}", h.handleAPIScheduleControl)
+	mux.HandleFunc("DELETE /api/schedules/{id}", h.handleAPIDeleteSchedule)
+
 	// New API routes
 	mux.HandleFunc("GET /api/personas", h.handleAPIListPersonas)
 	mux.HandleFunc("GET /api/styles", h.handleAPIListStyles)
 	mux.HandleFunc("GET /api/system/info", h.handleAPISystemInfo)
 	mux.HandleFunc("POST /api/debates", h.handleAPICreateDebate)
 	mux.HandleFunc("POST /api/debates/{id}/followup", h.handleAPIDebateFollowUp)
+	mux.HandleFunc("POST /api/debates/{id}/turn", h.handleAPIDebateTurn)
 	mux.HandleFunc("POST /api/councils/{id}/followup", h.handleAPICouncilFollowUp)
+	mux.HandleFunc("POST /api/councils/{id}/ask", h.handleAPIAskChairman)
+	mux.HandleFunc("POST /api/debates/{id}/fork", h.handleAPIForkDebate)
+	mux.HandleFunc("GET /api/debates/{id}/forks", h.handleAPIDebateForks)
+	mux.HandleFunc("POST /api/debates/{id}/swap-sides", h.handleAPISwapSides)
+	mux.HandleFunc("GET /api/debates/{id}/sides", h.handleAPICompareSides)
+	mux.HandleFunc("GET /api/debates/{id}/arguments", h.handleAPIDebateArguments)
+	mux.HandleFunc("POST /api/councils/{id}/fork", h.handleAPIForkCouncil)
+	mux.HandleFunc("GET /api/councils/{id}/forks", h.handleAPICouncilForks)
+	mux.HandleFunc("POST /api/debates/{id}/turns/{turnID}/regenerate", h.handleAPIRegenerateTurn)
+	mux.HandleFunc("POST /api/debates/{id}/turns/{turnID}/select", h.handleAPISelectTurnVersion)
+	mux.HandleFunc("POST /api/councils/{id}/responses/{responseID}/regenerate", h.handleAPIRegenerateResponse)
+	mux.HandleFunc("POST /api/councils/{id}/responses/{responseID}/select", h.handleAPISelectResponseVersion)
+	mux.HandleFunc("POST /api/debates/{id}/{action}", h.handleAPIDebateControl)
+	mux.HandleFunc("POST /api/councils/{id}/{action}", h.handleAPICouncilControl)
 	mux.HandleFunc("DELETE /api/debates/{id}", h.handleAPIDeleteDebate)
 
 	// Actions (POST/DELETE endpoints)
@@ -273,9 +365,42 @@ func (h *Handler) handleCreateDebate(w http.ResponseWriter, r *http.Request) {
 		AgentBProvider: r.FormValue("agent_b_provider"),
 		AgentBModel:    r.FormValue("agent_b_model"),
 		AgentBPersona:  r.FormValue("agent_b_persona"),
+		AgentAStance:   r.FormValue("agent_a_stance"),
+		AgentBStance:   r.FormValue("agent_b_stance"),
 		Style:          r.FormValue("style"),
 		MaxTurns:       maxTurns,
+		SpeakingOrder:  core.SpeakingOrder(r.FormValue("speaking_order")),
 	}
+	if judgeProvider := r.FormValue("judge_provider"); judgeProvider != "" {
+		config.Judge = &core.MemberSpec{
+			Provider: judgeProvider,
+			Model:    r.FormValue("judge_model"),
+			Persona:  r.FormValue("judge_persona"),
+		}
+	}
+	if moderatorProvider := r.FormValue("moderator_provider"); moderatorProvider != "" {
+		config.Moderator = &core.MemberSpec{
+			Provider: moderatorProvider,
+			Model:    r.FormValue("moderator_model"),
+			Persona:  r.FormValue("moderator_persona"),
+		}
+	}
+	if method := r.FormValue("consensus_method"); method != "" {
+		threshold, _ := strconv.ParseFloat(r.FormValue("consensus_threshold"), 64)
+		config.Consensus = &core.ConsensusConfig{
+			Method:    core.ConsensusMethod(method),
+			Threshold: threshold,
+		}
+	}
+	maxTokens, _ := strconv.Atoi(r.FormValue("max_tokens"))
+	maxCost, _ := strconv.ParseFloat(r.FormValue("max_cost_usd"), 64)
+	maxDuration, _ := time.ParseDuration(r.FormValue("max_duration"))
+	budget := &core.Budget{
+		MaxTokens:     maxTokens,
+		MaxCostUSD:    maxCost,
+		MaxDurationMs: maxDuration.Milliseconds(),
+	}
+	config.Budget = budget.WithDefaults(nil)
 
 	debate, err := h.engine.CreateDebate(r.Context(), config)
 	if err != nil {
@@ -288,11 +413,9 @@ func (h *Handler) handleCreateDebate(w http.ResponseWriter, r *http.Request) {
 
 	if autoRun {
 		// Run debate in background
-		go func() {
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
-			defer cancel()
-			h.engine.RunDebate(ctx, debate.ID, nil)
-		}()
+		if err := h.engine.StartDebate(debate.ID, nil); err != nil {
+			slog.Warn("Failed to start debate", "id", debate.ID, "error", err)
+		}
 	}
 
 	// Redirect to debate view
@@ -304,11 +427,10 @@ func (h *Handler) handleRunDebate(w http.ResponseWriter, r *http.Request) {
 	id := r.PathValue("id")
 
 	// Run debate in background
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
-		defer cancel()
-		h.engine.RunDebate(ctx, id, nil)
-	}()
+	if err := h.engine.StartDebate(id, nil); err != nil {
+		h.htmxError(w, err.Error())
+		return
+	}
 
 	w.Header().Set("HX-Trigger", "debateStarted")
 	w.WriteHeader(http.StatusAccepted)
@@ -554,13 +676,17 @@ func (h *Handler) handleAPIDebate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Compute usage stats from turns
-	stats := core.ComputeDebateStats(turns, debate.AgentA.ID, debate.AgentB.ID)
+	stats := core.ComputeDebateStats(turns, debate.AgentIDs()...)
 
-	h.json(w, map[string]interface{}{
+	resp := map[string]interface{}{
 		"debate": debate,
 		"turns":  turns,
 		"stats":  stats,
-	})
+	}
+	if awaiting, err := h.engine.AwaitingInput(id); err == nil && awaiting != nil {
+		resp["awaiting_input"] = awaiting
+	}
+	h.json(w, resp)
 }
 
 func (h *Handler) handleAPIListPersonas(w http.ResponseWriter, r *http.Request) {
@@ -628,9 +754,10 @@ func (h *Handler) handleAPIGetProject(w http.ResponseWriter, r *http.Request) {
 
 func (h *Handler) handleAPICreateProject(w http.ResponseWriter, r *http.Request) {
 	var req struct {
-		Name         string `json:"name"`
-		Description  string `json:"description"`
-		Instructions string `json:"instructions"`
+		Name         string       `json:"name"`
+		Description  string       `json:"description"`
+		Instructions string       `json:"instructions"`
+		Budget       *core.Budget `json:"budget"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -648,6 +775,7 @@ func (h *Handler) handleAPICreateProject(w http.ResponseWriter, r *http.Request)
 		Name:         strings.TrimSpace(req.Name),
 		Description:  strings.TrimSpace(req.Description),
 		Instructions: req.Instructions,
+		Budget:       req.Budget.WithDefaults(nil),
 		CreatedAt:    now,
 		UpdatedAt:    now,
 	}
@@ -673,9 +801,10 @@ func (h *Handler) handleAPIUpdateProject(w http.ResponseWriter, r *http.Request)
 	}
 
 	var req struct {
-		Name         string `json:"name"`
-		Description  string `json:"description"`
-		Instructions string `json:"instructions"`
+		Name         string       `json:"name"`
+		Description  string       `json:"description"`
+		Instructions string       `json:"instructions"`
+		Budget       *core.Budget `json:"budget"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -691,6 +820,7 @@ func (h *Handler) handleAPIUpdateProject(w http.ResponseWriter, r *http.Request)
 	project.Name = strings.TrimSpace(req.Name)
 	project.Description = strings.TrimSpace(req.Description)
 	project.Instructions = req.Instructions
+	project.Budget = req.Budget.WithDefaults(nil)
 	project.UpdatedAt = time.Now()
 
 	if err := h.storage.UpdateProject(project); err != nil {
@@ -721,10 +851,168 @@ func (h *Handler) handleAPIDeleteProject(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// presetRequest is the body of preset create and update requests.
+type presetRequest struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Mode        core.PresetMode   `json:"mode"`
+	Members     []core.MemberSpec `json:"members"`
+	Chairman    *core.MemberSpec  `json:"chairman"`
+	Style       string            `json:"style"`
+	MaxTurns    int               `json:"max_turns"`
+	ProjectID   string            `json:"project_id"`
+	Budget      *core.Budget      `json:"budget"`
+}
+
+// applyPresetRequest copies the request onto a preset and validates it.
+func (h *Handler) applyPresetRequest(req presetRequest, preset *core.Preset) error {
+	preset.Name = req.Name
+	preset.Description = strings.TrimSpace(req.Description)
+	preset.Mode = req.Mode
+	preset.Members = req.Members
+	preset.Chairman = req.Chairman
+	preset.Style = strings.TrimSpace(req.Style)
+	preset.MaxTurns = req.MaxTurns
+	preset.ProjectID = req.ProjectID
+	preset.Budget = req.Budget.WithDefaults(nil)
+	if err := preset.Validate(); err != nil {
+		return err
+	}
+
+	if preset.ProjectID != "" {
+		project, err := h.storage.GetProject(preset.ProjectID)
+		if err != nil {
+			return err
+		}
+		if project == nil {
+			return fmt.Errorf("project not found")
+		}
+	}
+	if existing, err := h.storage.GetPresetByName(preset.Name); err != nil {
+		return err
+	} else if existing != nil && existing.ID != preset.ID {
+		return fmt.Errorf("a preset named %q already exists", preset.Name)
+	}
+	return nil
+}
+
+func (h *Handler) handleAPIListPresets(w http.ResponseWriter, r *http.Request) {
+	presets, err := h.storage.ListPresets()
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if presets == nil {
+		presets = []*core.Preset{}
+	}
+	h.json(w, presets)
+}
+
+func (h *Handler) handleAPIGetPreset(w http.ResponseWriter, r *http.Request) {
+	preset, err := h.storage.GetPreset(r.PathValue("id"))
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if preset == nil {
+		h.jsonError(w, "preset not found", http.StatusNotFound)
+		return
+	}
+	h.json(w, preset)
+}
+
+func (h *Handler) handleAPICreatePreset(w http.ResponseWriter, r *http.Request) {
+	var req presetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	now := time.Now()
+	preset := &core.Preset{ID: core.GenerateID(), CreatedAt: now, UpdatedAt: now}
+	if err := h.applyPresetRequest(req, preset); err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.storage.CreatePreset(preset); err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.json(w, preset)
+}
+
+func (h *Handler) handleAPIUpdatePreset(w http.ResponseWriter, r *http.Request) {
+	preset, err := h.storage.GetPreset(r.PathValue("id"))
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if preset == nil {
+		h.jsonError(w, "preset not found", http.StatusNotFound)
+		return
+	}
+
+	var req presetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := h.applyPresetRequest(req, preset); err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	preset.UpdatedAt = time.Now()
+
+	if err := h.storage.UpdatePreset(preset); err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.json(w, preset)
+}
+
+func (h *Handler) handleAPIDeletePreset(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	preset, err := h.storage.GetPreset(id)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if preset == nil {
+		h.jsonError(w, "preset not found", http.StatusNotFound)
+		return
+	}
+
+	if err := h.storage.DeletePreset(id); err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getRequestPreset loads the preset a create request names. It writes the
+// error response and returns nil if the preset cannot be used.
+func (h *Handler) getRequestPreset(w http.ResponseWriter, id string) *core.Preset {
+	preset, err := h.storage.GetPreset(id)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return nil
+	}
+	if preset == nil {
+		h.jsonError(w, "preset not found", http.StatusBadRequest)
+		return nil
+	}
+	return preset
+}
+
 func (h *Handler) handleAPICreateDebate(w http.ResponseWriter, r *http.Request) {
 	type CreateRequest struct {
 		core.NewDebateConfig
-		AutoRun bool `json:"auto_run"`
+		PresetID string `json:"preset_id"` // Fills the settings left unset
+		AutoRun  bool   `json:"auto_run"`
 	}
 
 	var req CreateRequest
@@ -732,6 +1020,13 @@ func (h *Handler) handleAPICreateDebate(w http.ResponseWriter, r *http.Request)
 		h.jsonError(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	if req.PresetID != "" {
+		preset := h.getRequestPreset(w, req.PresetID)
+		if preset == nil {
+			return
+		}
+		preset.ApplyToDebate(&req.NewDebateConfig)
+	}
 
 	if req.Topic == "" {
 		h.jsonError(w, "topic is required", http.StatusBadRequest)
@@ -745,11 +1040,9 @@ func (h *Handler) handleAPICreateDebate(w http.ResponseWriter, r *http.Request)
 	}
 
 	if req.AutoRun {
-		go func() {
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
-			defer cancel()
-			h.engine.RunDebate(ctx, debate.ID, nil)
-		}()
+		if err := h.engine.StartDebate(debate.ID, nil); err != nil {
+			slog.Warn("Failed to start debate", "id", debate.ID, "error", err)
+		}
 	}
 
 	h.json(w, debate)
@@ -812,9 +1105,7 @@ func (h *Handler) handleAPIDeleteDebate(w http.ResponseWriter, r *http.Request)
 
 func (h *Handler) handleAPIDebateFollowUp(w http.ResponseWriter, r *http.Request) {
 	id := r.PathValue("id")
-	var req struct {
-		Content string `json:"content"`
-	}
+	var req core.FollowUp
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.jsonError(w, err.Error(), http.StatusBadRequest)
 		return
@@ -825,7 +1116,7 @@ func (h *Handler) handleAPIDebateFollowUp(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	if err := h.engine.AddFollowUp(r.Context(), id, req.Content); err != nil {
+	if err := h.engine.AddFollowUp(r.Context(), id, req); err != nil {
 		h.jsonError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -833,7 +1124,9 @@ func (h *Handler) handleAPIDebateFollowUp(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusAccepted)
 }
 
-func (h *Handler) handleAPICouncilFollowUp(w http.ResponseWriter, r *http.Request) {
+// handleAPIDebateTurn submits the human participant's turn and continues
+// the debate in the background.
+func (h *Handler) handleAPIDebateTurn(w http.ResponseWriter, r *http.Request) {
 	id := r.PathValue("id")
 	var req struct {
 		Content string `json:"content"`
@@ -843,87 +1136,76 @@ func (h *Handler) handleAPICouncilFollowUp(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if req.Content == "" {
+	if strings.TrimSpace(req.Content) == "" {
 		h.jsonError(w, "content is required", http.StatusBadRequest)
 		return
 	}
 
-	if err := h.councilEngine.AddFollowUp(r.Context(), id, req.Content); err != nil {
+	debate, err := h.storage.GetDebate(id)
+	if err != nil {
 		h.jsonError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-
-	w.WriteHeader(http.StatusAccepted)
-}
-
-// Council Handlers
-
-func (h *Handler) handleAPIListCouncils(w http.ResponseWriter, r *http.Request) {
-	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
-	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
-
-	if limit <= 0 {
-		limit = 20
+	if debate == nil {
+		h.jsonError(w, "debate not found", http.StatusNotFound)
+		return
 	}
 
-	councils, err := h.storage.ListCouncils(limit, offset)
+	turn, err := h.engine.SubmitHumanTurn(id, req.Content)
 	if err != nil {
-		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		h.jsonError(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err := h.engine.StartDebate(id, nil); err != nil {
+		h.jsonError(w, err.Error(), http.StatusConflict)
 		return
 	}
 
-	h.json(w, councils)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(turn)
 }
 
-func (h *Handler) handleAPIGetCouncil(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) handleAPICouncilFollowUp(w http.ResponseWriter, r *http.Request) {
 	id := r.PathValue("id")
-
-	council, err := h.storage.GetCouncil(id)
-	if err != nil {
-		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+	var req core.FollowUp
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	responses, err := h.storage.GetResponses(id)
-	if err != nil {
-		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+	if req.Content == "" {
+		h.jsonError(w, "content is required", http.StatusBadRequest)
 		return
 	}
 
-	rankings, err := h.storage.GetRankings(id)
-	if err != nil {
+	if err := h.councilEngine.AddFollowUp(r.Context(), id, req); err != nil {
 		h.jsonError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	// Replace masked names with real names for display
-	h.councilEngine.ReplaceMaskedNamesInCouncilData(council, responses, rankings, council.Syntheses)
-
-	h.json(w, map[string]interface{}{
-		"council":   council,
-		"responses": responses,
-		"rankings":  rankings,
-	})
+	w.WriteHeader(http.StatusAccepted)
 }
 
-func (h *Handler) handleAPIUpdateCouncilTitle(w http.ResponseWriter, r *http.Request) {
+// handleAPIAskChairman has the chairman of a completed council answer a
+// question from the council's record and returns the saved answer.
+func (h *Handler) handleAPIAskChairman(w http.ResponseWriter, r *http.Request) {
 	id := r.PathValue("id")
 	var req struct {
-		Title string `json:"title"`
+		Question string `json:"question"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.jsonError(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	title := strings.TrimSpace(req.Title)
-	if title == "" {
-		h.jsonError(w, "title is required", http.StatusBadRequest)
+	if strings.TrimSpace(req.Question) == "" {
+		h.jsonError(w, "question is required", http.StatusBadRequest)
 		return
 	}
 
 	council, err := h.storage.GetCouncil(id)
-	if err != nil {
+	if err != nil && !errors.Is(err, storage.ErrCouncilNotFound) {
 		h.jsonError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -932,82 +1214,548 @@ func (h *Handler) handleAPIUpdateCouncilTitle(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	if err := h.storage.UpdateCouncilTitle(id, title); err != nil {
-		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+	answer, err := h.councilEngine.AskChairman(r.Context(), id, req.Question)
+	if err != nil {
+		h.jsonError(w, err.Error(), askErrorStatus(err))
 		return
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(answer)
 }
 
-func (h *Handler) handleAPICreateCouncil(w http.ResponseWriter, r *http.Request) {
-	type CreateRequest struct {
-		core.NewCouncilConfig
-		AutoRun bool `json:"auto_run"`
-	}
-
-	var req CreateRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+// handleAPIForkDebate copies a debate up to a turn into a new debate.
+func (h *Handler) handleAPIForkDebate(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	var cfg core.ForkConfig
+	if err := json.NewDecoder(r.Body).Decode(&cfg); err != nil {
 		h.jsonError(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	if req.Topic == "" {
-		h.jsonError(w, "topic is required", http.StatusBadRequest)
+	debate, err := h.storage.GetDebate(id)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	if len(req.Members) < 2 {
-		h.jsonError(w, "at least 2 members are required", http.StatusBadRequest)
+	if debate == nil {
+		h.jsonError(w, "debate not found", http.StatusNotFound)
 		return
 	}
 
-	c, err := h.councilEngine.CreateCouncil(r.Context(), req.NewCouncilConfig)
+	fork, err := h.engine.ForkDebate(id, cfg)
 	if err != nil {
-		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	if req.AutoRun {
-		go func() {
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
-			defer cancel()
-			h.councilEngine.RunCouncil(ctx, c)
-		}()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(fork)
+}
+
+// handleAPIDebateForks returns the fork tree containing a debate.
+func (h *Handler) handleAPIDebateForks(w http.ResponseWriter, r *http.Request) {
+	tree, err := h.engine.DebateForkTree(r.PathValue("id"))
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusNotFound)
+		return
 	}
 
-	h.json(w, c)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tree)
 }
 
-func (h *Handler) handleAPIDeleteCouncil(w http.ResponseWriter, r *http.Request) {
+// handleAPISwapSides creates a rerun of a debate with the agents' stances
+// swapped, optionally starting it.
+func (h *Handler) handleAPISwapSides(w http.ResponseWriter, r *http.Request) {
 	id := r.PathValue("id")
+	var req struct {
+		AutoRun bool `json:"auto_run"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
-	council, err := h.storage.GetCouncil(id)
+	debate, err := h.storage.GetDebate(id)
 	if err != nil {
 		h.jsonError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	if council == nil {
-		h.jsonError(w, "council not found", http.StatusNotFound)
+	if debate == nil {
+		h.jsonError(w, "debate not found", http.StatusNotFound)
 		return
 	}
 
-	if err := h.storage.DeleteCouncil(id); err != nil {
-		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+	swapped, err := h.engine.SwapSides(id)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	if req.AutoRun {
+		if err := h.engine.StartDebate(swapped.ID, nil); err != nil {
+			slog.Warn("Failed to start debate", "id", swapped.ID, "error", err)
+		}
+	}
 
-	w.WriteHeader(http.StatusNoContent)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(swapped)
 }
 
-func (h *Handler) handleCouncilStream(w http.ResponseWriter, r *http.Request) {
-	id := r.PathValue("id")
-	slog.Debug("New council stream connection", "id", id, "remote_addr", r.RemoteAddr)
+// handleAPICompareSides compares a debate with its swap-sides rerun.
+func (h *Handler) handleAPICompareSides(w http.ResponseWriter, r *http.Request) {
+	cmp, err := h.engine.CompareSides(r.PathValue("id"))
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusNotFound)
+		return
+	}
 
-	// Prepare SSE headers
-	w.Header().Set("Content-Type", "text/event-stream")
-	w.Header().Set("Cache-Control", "no-cache")
-	w.Header().Set("Connection", "keep-alive")
-	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(cmp)
+}
+
+// handleAPIDebateArguments returns a debate's argument graph, extracting
+// the claims of turns not processed yet. ?format=dot or ?format=mermaid
+// returns the graph in that notation instead of JSON.
+func (h *Handler) handleAPIDebateArguments(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	debate, err := h.engine.GetDebate(id)
+	if err != nil || debate == nil {
+		h.jsonError(w, "debate not found", http.StatusNotFound)
+		return
+	}
+
+	format := export.Format(r.URL.Query().Get("format"))
+	switch format {
+	case "", export.FormatJSON, export.FormatDOT, export.FormatMermaid:
+	default:
+		h.jsonError(w, fmt.Sprintf("unsupported argument graph format: %s (use json, dot or mermaid)", format), http.StatusBadRequest)
+		return
+	}
+
+	// A failed extraction still returns the claims extracted so far
+	graph, err := h.engine.Arguments(r.Context(), id)
+	if graph == nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	var extractErr string
+	if err != nil {
+		slog.Warn("Argument extraction incomplete", "debate_id", id, "pending", graph.Pending, "error", err)
+		extractErr = err.Error()
+	}
+
+	switch format {
+	case export.FormatDOT, export.FormatMermaid:
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if err := export.ExportArguments(debate, graph, format, w); err != nil {
+			slog.Error("Argument graph export failed", "debate_id", id, "format", format, "error", err)
+		}
+		return
+	}
+
+	unanswered := []string{}
+	for _, c := range graph.Unanswered() {
+		unanswered = append(unanswered, c.ID)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(struct {
+		*core.ArgumentGraph
+		Unanswered []string `json:"unanswered"`
+		Error      string   `json:"error,omitempty"`
+	}{graph, unanswered, extractErr})
+}
+
+// handleAPIForkCouncil copies a council up to a round into a new council.
+func (h *Handler) handleAPIForkCouncil(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	var cfg core.ForkConfig
+	if err := json.NewDecoder(r.Body).Decode(&cfg); err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.storage.GetCouncil(id); err != nil {
+		h.jsonError(w, "council not found", http.StatusNotFound)
+		return
+	}
+
+	fork, err := h.councilEngine.ForkCouncil(id, cfg)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(fork)
+}
+
+// handleAPICouncilForks returns the fork tree containing a council.
+func (h *Handler) handleAPICouncilForks(w http.ResponseWriter, r *http.Request) {
+	tree, err := h.councilEngine.CouncilForkTree(r.PathValue("id"))
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tree)
+}
+
+// handleAPIRegenerateTurn regenerates a debate turn and returns it with
+// its versions. It blocks until the agent has answered.
+func (h *Handler) handleAPIRegenerateTurn(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	debate, err := h.storage.GetDebate(id)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if debate == nil {
+		h.jsonError(w, "debate not found", http.StatusNotFound)
+		return
+	}
+
+	turn, err := h.engine.RegenerateTurn(r.Context(), id, r.PathValue("turnID"))
+	if err != nil {
+		h.jsonError(w, err.Error(), versionErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(turn)
+}
+
+// handleAPISelectTurnVersion picks the version of a turn later steps use.
+func (h *Handler) handleAPISelectTurnVersion(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	var req struct {
+		Version int `json:"version"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	debate, err := h.storage.GetDebate(id)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if debate == nil {
+		h.jsonError(w, "debate not found", http.StatusNotFound)
+		return
+	}
+
+	turn, err := h.engine.SelectTurnVersion(id, r.PathValue("turnID"), req.Version)
+	if err != nil {
+		h.jsonError(w, err.Error(), versionErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(turn)
+}
+
+// handleAPIRegenerateResponse regenerates a council member's response and
+// returns it with its versions. It blocks until the member has answered.
+func (h *Handler) handleAPIRegenerateResponse(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, err := h.storage.GetCouncil(id); err != nil {
+		h.jsonError(w, "council not found", http.StatusNotFound)
+		return
+	}
+
+	response, err := h.councilEngine.RegenerateResponse(r.Context(), id, r.PathValue("responseID"))
+	if err != nil {
+		h.jsonError(w, err.Error(), versionErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// handleAPISelectResponseVersion picks the version of a response later
+// stages use.
+func (h *Handler) handleAPISelectResponseVersion(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	var req struct {
+		Version int `json:"version"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.storage.GetCouncil(id); err != nil {
+		h.jsonError(w, "council not found", http.StatusNotFound)
+		return
+	}
+
+	response, err := h.councilEngine.SelectResponseVersion(id, r.PathValue("responseID"), req.Version)
+	if err != nil {
+		h.jsonError(w, err.Error(), versionErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// versionErrorStatus maps a regenerate or select error to an HTTP status.
+func versionErrorStatus(err error) int {
+	if errors.Is(err, run.ErrAlreadyRunning) {
+		return http.StatusConflict
+	}
+	return http.StatusBadRequest
+}
+
+// askErrorStatus maps an AskChairman error to an HTTP status: a council
+// that cannot take questions yet is a conflict, anything else a failure to
+// answer.
+func askErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, run.ErrAlreadyRunning), errors.Is(err, council.ErrNotCompleted), errors.Is(err, council.ErrBudgetReached):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
+
+// handleAPIDebateControl pauses, resumes, cancels, or reruns the conclusion
+// of a debate.
+func (h *Handler) handleAPIDebateControl(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	debate, err := h.storage.GetDebate(id)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if debate == nil {
+		h.jsonError(w, "debate not found", http.StatusNotFound)
+		return
+	}
+
+	switch r.PathValue("action") {
+	case "pause":
+		err = h.engine.PauseDebate(id)
+	case "resume":
+		err = h.engine.ResumeDebate(id, nil)
+	case "cancel":
+		err = h.engine.CancelDebate(id)
+	case "rerun":
+		err = h.engine.RerunConclusion(id, nil)
+	default:
+		h.jsonError(w, "unknown action: "+r.PathValue("action"), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// handleAPICouncilControl pauses, resumes, cancels, or reruns the last
+// round's rankings and synthesis of a council.
+func (h *Handler) handleAPICouncilControl(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	c, err := h.storage.GetCouncil(id)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if c == nil {
+		h.jsonError(w, "council not found", http.StatusNotFound)
+		return
+	}
+
+	switch r.PathValue("action") {
+	case "pause":
+		err = h.councilEngine.PauseCouncil(id)
+	case "resume":
+		err = h.councilEngine.ResumeCouncil(id)
+	case "cancel":
+		err = h.councilEngine.CancelCouncil(id)
+	case "rerun":
+		err = h.councilEngine.RerunStages(id)
+	default:
+		h.jsonError(w, "unknown action: "+r.PathValue("action"), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// Council Handlers
+
+func (h *Handler) handleAPIListCouncils(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+	if limit <= 0 {
+		limit = 20
+	}
+
+	councils, err := h.storage.ListCouncils(limit, offset)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.json(w, councils)
+}
+
+func (h *Handler) handleAPIGetCouncil(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	council, err := h.storage.GetCouncil(id)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	responses, err := h.storage.GetResponses(id)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rankings, err := h.storage.GetRankings(id)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Replace masked names with real names for display
+	h.councilEngine.ReplaceMaskedNamesInCouncilData(council, responses, rankings, council.Syntheses)
+
+	h.json(w, map[string]interface{}{
+		"council":   council,
+		"responses": responses,
+		"rankings":  rankings,
+	})
+}
+
+func (h *Handler) handleAPIUpdateCouncilTitle(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	var req struct {
+		Title string `json:"title"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		h.jsonError(w, "title is required", http.StatusBadRequest)
+		return
+	}
+
+	council, err := h.storage.GetCouncil(id)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if council == nil {
+		h.jsonError(w, "council not found", http.StatusNotFound)
+		return
+	}
+
+	if err := h.storage.UpdateCouncilTitle(id, title); err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) handleAPICreateCouncil(w http.ResponseWriter, r *http.Request) {
+	type CreateRequest struct {
+		core.NewCouncilConfig
+		PresetID string `json:"preset_id"` // Fills the settings left unset
+		AutoRun  bool   `json:"auto_run"`
+	}
+
+	var req CreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.PresetID != "" {
+		preset := h.getRequestPreset(w, req.PresetID)
+		if preset == nil {
+			return
+		}
+		preset.ApplyToCouncil(&req.NewCouncilConfig)
+	}
+
+	if req.Topic == "" {
+		h.jsonError(w, "topic is required", http.StatusBadRequest)
+		return
+	}
+	if len(req.Members) < 2 {
+		h.jsonError(w, "at least 2 members are required", http.StatusBadRequest)
+		return
+	}
+
+	c, err := h.councilEngine.CreateCouncil(r.Context(), req.NewCouncilConfig)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if req.AutoRun {
+		if err := h.councilEngine.StartCouncil(c); err != nil {
+			slog.Warn("Failed to start council", "id", c.ID, "error", err)
+		}
+	}
+
+	h.json(w, c)
+}
+
+func (h *Handler) handleAPIDeleteCouncil(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	council, err := h.storage.GetCouncil(id)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if council == nil {
+		h.jsonError(w, "council not found", http.StatusNotFound)
+		return
+	}
+
+	if err := h.storage.DeleteCouncil(id); err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) handleCouncilStream(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	slog.Debug("New council stream connection", "id", id, "remote_addr", r.RemoteAddr)
+
+	// Prepare SSE headers
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
 
 	flusher, ok := w.(http.Flusher)
 	if !ok {
@@ -1147,6 +1895,11 @@ func (h *Handler) handleCouncilStream(w http.ResponseWriter, r *http.Request) {
 		}()
 
 		err := h.councilEngine.RunCouncilWithCallbacks(ctx, c, callbacks)
+		if errors.Is(err, run.ErrPaused) || errors.Is(err, run.ErrCancelled) {
+			slog.Info("Council stopped during stream", "id", id, "reason", err)
+			sendEvent("stopped", c)
+			return
+		}
 		if err != nil {
 			slog.Error("Council execution failed", "id", id, "error", err)
 			sendEvent("error", map[string]string{"message": err.Error()})
@@ -1236,6 +1989,10 @@ func (h *Handler) handleCouncilStream(w http.ResponseWriter, r *http.Request) {
 					sendEvent("error", map[string]string{"message": "Council failed"})
 					return
 				}
+				if currCouncil.Status == core.StatusPaused || currCouncil.Status == core.StatusCancelled || currCouncil.Status == core.StatusInterrupted {
+					sendEvent("stopped", currCouncil)
+					return
+				}
 			}
 		}
 	}
@@ -1315,14 +2072,286 @@ func (h *Handler) getAllStyles() []style.Style {
 
 	for _, s := range custom {
 		result = append(result, style.Style{
-			ID:               s.ID,
-			Name:             s.Name,
-			Description:      s.Description,
-			OpeningPrompt:    s.OpeningPrompt,
-			ResponsePrompt:   s.ResponsePrompt,
-			ConclusionPrompt: s.ConclusionPrompt,
+			ID:                 s.ID,
+			Name:               s.Name,
+			Description:        s.Description,
+			OpeningPrompt:      s.OpeningPrompt,
+			ResponsePrompt:     s.ResponsePrompt,
+			ConclusionPrompt:   s.ConclusionPrompt,
+			ConsensusMethod:    s.ConsensusMethod,
+			ConsensusThreshold: s.ConsensusThreshold,
+			Phases:             s.Phases,
 		})
 	}
 
 	return result
 }
+
+// Tournament API handlers
+
+// tournamentResponse is a tournament with its standings and, once it has
+// completed, its winner.
+type tournamentResponse struct {
+	*core.Tournament
+	Standings []*tournament.Standing `json:"standings"`
+	Winner    string                 `json:"winner,omitempty"`
+}
+
+func (h *Handler) handleAPIListTournaments(w http.ResponseWriter, r *http.Request) {
+	tournaments, err := h.tournaments.ListTournaments(50, 0)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if tournaments == nil {
+		tournaments = []*core.Tournament{}
+	}
+	h.json(w, tournaments)
+}
+
+// handleAPICreateTournament creates a tournament and, unless auto_run is
+// false, starts it in the background.
+func (h *Handler) handleAPICreateTournament(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		core.NewTournamentConfig
+		AutoRun *bool `json:"auto_run"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	t, err := h.tournaments.CreateTournament(req.NewTournamentConfig)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.AutoRun == nil || *req.AutoRun {
+		if err := h.tournaments.Start(h.background, t); err != nil {
+			h.jsonError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(t)
+}
+
+func (h *Handler) handleAPIGetTournament(w http.ResponseWriter, r *http.Request) {
+	t, err := h.tournaments.GetTournament(r.PathValue("id"))
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	h.json(w, tournamentResponse{
+		Tournament: t,
+		Standings:  tournament.Standings(t),
+		Winner:     tournament.Winner(t),
+	})
+}
+
+// handleAPIResumeTournament retries the unfinished matches of a paused or
+// failed tournament in the background.
+func (h *Handler) handleAPIResumeTournament(w http.ResponseWriter, r *http.Request) {
+	t, err := h.tournaments.GetTournament(r.PathValue("id"))
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if t.Status == core.StatusInProgress || t.Status == core.StatusCompleted {
+		h.jsonError(w, fmt.Sprintf("tournament cannot be resumed (status: %s)", t.Status), http.StatusConflict)
+		return
+	}
+
+	if err := h.tournaments.Start(h.background, t); err != nil {
+		h.jsonError(w, err.Error(), versionErrorStatus(err))
+		return
+	}
+	h.json(w, t)
+}
+
+// handleAPIPauseTournament stops a tournament running in the background.
+// Matches in progress are paused and retried on resume.
+func (h *Handler) handleAPIPauseTournament(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, err := h.tournaments.GetTournament(id); err != nil {
+		h.jsonError(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if !h.tournaments.Pause(id) {
+		h.jsonError(w, "tournament is not running", http.StatusConflict)
+		return
+	}
+	if err := h.tournaments.Wait(r.Context(), id); err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	t, err := h.tournaments.GetTournament(id)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h.json(w, t)
+}
+
+func (h *Handler) handleAPILeaderboard(w http.ResponseWriter, r *http.Request) {
+	ratings, err := h.tournaments.Leaderboard()
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if ratings == nil {
+		ratings = []*core.Rating{}
+	}
+	h.json(w, ratings)
+}
+
+// Schedule Handlers
+
+// scheduleRuns is how many of a schedule's councils GET /api/schedules/{id}
+// returns.
+const scheduleRuns = 50
+
+func (h *Handler) handleAPIListSchedules(w http.ResponseWriter, r *http.Request) {
+	schedules, err := h.storage.ListSchedules()
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if schedules == nil {
+		schedules = []*core.Schedule{}
+	}
+	h.json(w, schedules)
+}
+
+// handleAPIGetSchedule returns a schedule with the councils it started,
+// newest first, so runs can be compared over time.
+func (h *Handler) handleAPIGetSchedule(w http.ResponseWriter, r *http.Request) {
+	sc, err := h.schedules.Get(r.PathValue("id"))
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	runs, err := h.schedules.Runs(sc.ID, scheduleRuns)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if runs == nil {
+		runs = []*core.CouncilSummary{}
+	}
+
+	h.json(w, struct {
+		*core.Schedule
+		Runs []*core.CouncilSummary `json:"runs"`
+	}{sc, runs})
+}
+
+func (h *Handler) handleAPICreateSchedule(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name        string             `json:"name"`
+		Cron        string             `json:"cron"`
+		Topic       string             `json:"topic"`
+		Members     []core.MemberSpec  `json:"members"`
+		Chairman    *core.MemberSpec   `json:"chairman"`
+		WorkspaceID string             `json:"workspace_id"`
+		ProjectID   string             `json:"project_id"`
+		Budget      *core.Budget       `json:"budget"`
+		CatchUp     core.CatchUpPolicy `json:"catch_up"`
+		Paused      bool               `json:"paused"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if req.WorkspaceID != "" {
+		if h.workspaces == nil {
+			h.jsonError(w, "workspaces are not available", http.StatusBadRequest)
+			return
+		}
+		if _, err := h.workspaces.ResolvePath(req.WorkspaceID); err != nil {
+			h.jsonError(w, "invalid workspace ID: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	sc, err := h.schedules.Create(&core.Schedule{
+		Name:        req.Name,
+		Cron:        req.Cron,
+		Topic:       req.Topic,
+		Members:     req.Members,
+		Chairman:    req.Chairman,
+		WorkspaceID: req.WorkspaceID,
+		ProjectID:   req.ProjectID,
+		Budget:      req.Budget.WithDefaults(nil),
+		CatchUp:     req.CatchUp,
+		Paused:      req.Paused,
+	})
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.json(w, sc)
+}
+
+// handleAPITriggerSchedule starts a schedule's council now and returns it.
+func (h *Handler) handleAPITriggerSchedule(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, err := h.schedules.Get(id); err != nil {
+		h.jsonError(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	c, err := h.schedules.Trigger(context.Background(), id)
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.json(w, c)
+}
+
+func (h *Handler) handleAPIScheduleControl(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, err := h.schedules.Get(id); err != nil {
+		h.jsonError(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	var sc *core.Schedule
+	var err error
+	switch r.PathValue("action") {
+	case "pause":
+		sc, err = h.schedules.Pause(id)
+	case "resume":
+		sc, err = h.schedules.Resume(id)
+	default:
+		h.jsonError(w, "unknown action: "+r.PathValue("action"), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.json(w, sc)
+}
+
+func (h *Handler) handleAPIDeleteSchedule(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, err := h.schedules.Get(id); err != nil {
+		h.jsonError(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err := h.storage.DeleteSchedule(id); err != nil {
+		h.jsonError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -13,6 +14,7 @@ import (
 	"github.com/alienxp03/conclave/internal/provider"
 	"github.com/alienxp03/conclave/internal/storage"
 	"github.com/alienxp03/conclave/internal/workspace"
+	extprovider "github.com/alienxp03/conclave/provider"
 )
 
 // setupTestHandler creates a handler with in-memory storage for testing.
@@ -277,3 +279,297 @@ func TestHandleAPIProject_UpdateReinjectsInstructions(t *testing.T) {
 		t.Errorf("Council project instructions = %q, want %q", updatedCouncil.ProjectInstructions, "Updated instructions")
 	}
 }
+
+func TestHandleAPIDebateTurn_SubmitsHumanTurn(t *testing.T) {
+	handler, cleanup := setupTestHandler(t)
+	defer cleanup()
+
+	debate := &core.Debate{
+		ID:       "test-debate-human",
+		Title:    "Human Debate",
+		Topic:    "Test Topic",
+		AgentA:   core.Agent{ID: "agent-a", Name: "Agent A", MaskedName: "Agent Falcon", Provider: "mock", Persona: "skeptic"},
+		AgentB:   core.Agent{ID: "agent-b", Name: "Human", MaskedName: "Agent Heron", Provider: core.ProviderHuman},
+		Style:    "collaborative",
+		MaxTurns: 1,
+		Status:   core.StatusAwaitingInput,
+	}
+	if err := handler.storage.CreateDebate(debate); err != nil {
+		t.Fatalf("Failed to create test debate: %v", err)
+	}
+
+	// The debate reports which seat it is waiting for
+	req := httptest.NewRequest("GET", "/api/debates/test-debate-human", nil)
+	req.SetPathValue("id", debate.ID)
+	w := httptest.NewRecorder()
+	handler.handleAPIDebate(w, req)
+
+	var resp struct {
+		AwaitingInput *core.AwaitingInput `json:"awaiting_input"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp.AwaitingInput == nil || resp.AwaitingInput.AgentID != "agent-b" || resp.AwaitingInput.MaskedName != "Agent Heron" {
+		t.Fatalf("Expected awaiting_input for agent-b, got %+v", resp.AwaitingInput)
+	}
+
+	// Empty turns are rejected
+	req = httptest.NewRequest("POST", "/api/debates/test-debate-human/turn", strings.NewReader(`{"content": "  "}`))
+	req.SetPathValue("id", debate.ID)
+	w = httptest.NewRecorder()
+	handler.handleAPIDebateTurn(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for empty content, got %d", w.Code)
+	}
+
+	req = httptest.NewRequest("POST", "/api/debates/test-debate-human/turn", strings.NewReader(`{"content": "My argument"}`))
+	req.SetPathValue("id", debate.ID)
+	w = httptest.NewRecorder()
+	handler.handleAPIDebateTurn(w, req)
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("Expected status 202, got %d: %s", w.Code, w.Body.String())
+	}
+	handler.engine.WaitDebate(context.Background(), debate.ID)
+
+	turns, _ := handler.storage.GetTurns(debate.ID)
+	if len(turns) == 0 || turns[0].AgentID != "agent-b" || turns[0].Content != "My argument" {
+		t.Fatalf("Expected the human turn to be stored first, got %+v", turns)
+	}
+
+	// A second submission is rejected once the seat has spoken
+	req = httptest.NewRequest("POST", "/api/debates/test-debate-human/turn", strings.NewReader(`{"content": "Again"}`))
+	req.SetPathValue("id", debate.ID)
+	w = httptest.NewRecorder()
+	handler.handleAPIDebateTurn(w, req)
+	if w.Code != http.StatusConflict {
+		t.Errorf("Expected status 409 for a debate not awaiting input, got %d", w.Code)
+	}
+}
+
+func TestHandleAPIPresets_CreateCouncilFromPreset(t *testing.T) {
+	handler, cleanup := setupTestHandler(t)
+	defer cleanup()
+	handler.registry.Register(provider.NewMockProvider(extprovider.Config{}))
+
+	post := func(handle http.HandlerFunc, payload string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/", strings.NewReader(payload))
+		w := httptest.NewRecorder()
+		handle(w, req)
+		return w
+	}
+
+	payload := `{"name":"security-review","members":[{"provider":"mock","persona":"skeptic"},{"provider":"mock","persona":"optimist"}],"chairman":{"provider":"mock"},"budget":{"max_tokens":20000}}`
+	w := post(handler.handleAPICreatePreset, payload)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var preset core.Preset
+	if err := json.Unmarshal(w.Body.Bytes(), &preset); err != nil {
+		t.Fatalf("Failed to parse preset: %v", err)
+	}
+	if preset.Mode != core.PresetCouncil || len(preset.Members) != 2 {
+		t.Errorf("Unexpected preset: %+v", preset)
+	}
+
+	// Names are unique
+	if w := post(handler.handleAPICreatePreset, `{"name":"security-review"}`); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for a duplicate name, got %d", w.Code)
+	}
+
+	// Explicit settings win over the preset's
+	w = post(handler.handleAPICreateCouncil, `{"topic":"Is our auth safe?","preset_id":"`+preset.ID+`","budget":{"max_cost_usd":1}}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var council core.Council
+	if err := json.Unmarshal(w.Body.Bytes(), &council); err != nil {
+		t.Fatalf("Failed to parse council: %v", err)
+	}
+	if len(council.Members) != 2 || council.Chairman.Provider != "mock" {
+		t.Errorf("Preset members not applied: %+v", council.Members)
+	}
+	if council.Budget == nil || council.Budget.MaxTokens != 20000 || council.Budget.MaxCostUSD != 1 {
+		t.Errorf("Expected merged budget, got %+v", council.Budget)
+	}
+
+	if w := post(handler.handleAPICreateCouncil, `{"topic":"T","preset_id":"missing"}`); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for an unknown preset, got %d", w.Code)
+	}
+}
+
+func TestHandleAPISchedules_PauseAndTrigger(t *testing.T) {
+	handler, cleanup := setupTestHandler(t)
+	defer cleanup()
+	handler.registry.Register(provider.NewMockProvider(extprovider.Config{}))
+
+	call := func(handle http.HandlerFunc, id, action, payload string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/", strings.NewReader(payload))
+		req.SetPathValue("id", id)
+		req.SetPathValue("action", action)
+		w := httptest.NewRecorder()
+		handle(w, req)
+		return w
+	}
+
+	if w := call(handler.handleAPICreateSchedule, "", "", `{"topic":"T","cron":"every monday","members":[{"provider":"mock"},{"provider":"mock"}]}`); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for an invalid cron expression, got %d", w.Code)
+	}
+
+	w := call(handler.handleAPICreateSchedule, "", "", `{"name":"runbook","topic":"Review our on-call runbook","cron":"0 9 * * 1","members":[{"provider":"mock"},{"provider":"mock"}],"catch_up":"skip"}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var sc core.Schedule
+	if err := json.Unmarshal(w.Body.Bytes(), &sc); err != nil {
+		t.Fatalf("Failed to parse schedule: %v", err)
+	}
+	if sc.CatchUp != core.CatchUpSkip || sc.NextRunAt == nil {
+		t.Errorf("Unexpected schedule: %+v", sc)
+	}
+
+	if w := call(handler.handleAPIScheduleControl, sc.ID, "pause", ""); w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"paused":true`) {
+		t.Errorf("Expected paused schedule, got %d: %s", w.Code, w.Body.String())
+	}
+
+	w = call(handler.handleAPITriggerSchedule, sc.ID, "", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var council core.Council
+	if err := json.Unmarshal(w.Body.Bytes(), &council); err != nil {
+		t.Fatalf("Failed to parse council: %v", err)
+	}
+	if err := handler.councilEngine.WaitCouncil(context.Background(), council.ID); err != nil {
+		t.Errorf("Triggered council failed: %v", err)
+	}
+
+	req := httptest.NewRequest("GET", "/api/schedules/"+sc.ID, nil)
+	req.SetPathValue("id", sc.ID)
+	w = httptest.NewRecorder()
+	handler.handleAPIGetSchedule(w, req)
+	var got struct {
+		core.Schedule
+		Runs []*core.CouncilSummary `json:"runs"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatalf("Failed to parse schedule: %v", err)
+	}
+	if !got.Paused || got.LastCouncilID != council.ID || len(got.Runs) != 1 || got.Runs[0].ScheduleID != sc.ID {
+		t.Errorf("Expected the triggered run on the schedule, got %+v", got)
+	}
+
+	if w := call(handler.handleAPIScheduleControl, "missing", "resume", ""); w.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404 for a missing schedule, got %d", w.Code)
+	}
+}
+
+func TestHandleAPIDebateArguments(t *testing.T) {
+	handler, cleanup := setupTestHandler(t)
+	defer cleanup()
+
+	debate := &core.Debate{
+		ID:     "test-debate-args",
+		Title:  "Tabs or spaces",
+		Topic:  "Tabs or spaces?",
+		AgentA: core.Agent{ID: "agent-a", Name: "Alice", Provider: "mock", Persona: "optimist"},
+		AgentB: core.Agent{ID: "agent-b", Name: "Bob", Provider: "mock", Persona: "skeptic"},
+		Style:  "collaborative",
+		Status: core.StatusCompleted,
+	}
+	if err := handler.storage.CreateDebate(debate); err != nil {
+		t.Fatalf("Failed to create test debate: %v", err)
+	}
+
+	// Claims already extracted, so no model is needed
+	claims := [][]*core.Claim{
+		{{ID: "t1c1", AgentID: "agent-a", Text: `Spaces are "consistent"`, Evidence: []string{"Tab width varies"}}},
+		{{ID: "t2c1", AgentID: "agent-b", Text: "Tabs are accessible", Rebuts: []string{"t1c1"}}},
+	}
+	for i, turnClaims := range claims {
+		turn := &core.Turn{ID: "args-turn-" + string(rune('1'+i)), DebateID: debate.ID, AgentID: turnClaims[0].AgentID, Number: i + 1, Round: 1, Content: turnClaims[0].Text, TurnType: core.TurnTypeDebate}
+		if err := handler.storage.AddTurn(turn); err != nil {
+			t.Fatalf("Failed to add turn: %v", err)
+		}
+		turnClaims[0].TurnID, turnClaims[0].TurnNumber = turn.ID, turn.Number
+		if err := handler.storage.SaveTurnClaims(turn, turnClaims); err != nil {
+			t.Fatalf("Failed to save claims: %v", err)
+		}
+	}
+
+	get := func(id, format string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/api/debates/"+id+"/arguments?format="+format, nil)
+		req.SetPathValue("id", id)
+		w := httptest.NewRecorder()
+		handler.handleAPIDebateArguments(w, req)
+		return w
+	}
+
+	w := get(debate.ID, "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		Claims     []*core.Claim `json:"claims"`
+		Unanswered []string      `json:"unanswered"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if len(resp.Claims) != 2 || len(resp.Unanswered) != 1 || resp.Unanswered[0] != "t2c1" {
+		t.Errorf("Unexpected graph: %s", w.Body.String())
+	}
+
+	if body := get(debate.ID, "dot").Body.String(); !strings.Contains(body, "t2c1 -> t1c1") || !strings.Contains(body, `Spaces are \"consistent\"`) {
+		t.Errorf("Unexpected DOT output:\n%s", body)
+	}
+	if body := get(debate.ID, "mermaid").Body.String(); !strings.Contains(body, "t2c1 -->|rebuts| t1c1") || !strings.Contains(body, "class t2c1 unanswered") {
+		t.Errorf("Unexpected Mermaid output:\n%s", body)
+	}
+	if w := get(debate.ID, "svg"); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for an unknown format, got %d", w.Code)
+	}
+	if w := get("missing", ""); w.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404 for a missing debate, got %d", w.Code)
+	}
+}
+
+func TestHandleAPIAskChairman_Statuses(t *testing.T) {
+	handler, cleanup := setupTestHandler(t)
+	defer cleanup()
+
+	c := &core.Council{
+		ID:       "test-council-ask",
+		Topic:    "Test Topic",
+		Members:  []core.Agent{{ID: "m1", Provider: "mock"}, {ID: "m2", Provider: "mock"}},
+		Chairman: core.Agent{ID: "chair", Provider: "missing"},
+		Status:   core.StatusInProgress,
+	}
+	if err := handler.storage.CreateCouncil(c); err != nil {
+		t.Fatalf("Failed to create test council: %v", err)
+	}
+
+	ask := func(id string) int {
+		req := httptest.NewRequest("POST", "/api/councils/"+id+"/ask", strings.NewReader(`{"question": "Why?"}`))
+		req.SetPathValue("id", id)
+		w := httptest.NewRecorder()
+		handler.handleAPIAskChairman(w, req)
+		return w.Code
+	}
+
+	if code := ask("missing"); code != http.StatusNotFound {
+		t.Errorf("Expected status 404 for an unknown council, got %d", code)
+	}
+	if code := ask(c.ID); code != http.StatusConflict {
+		t.Errorf("Expected status 409 for an unfinished council, got %d", code)
+	}
+
+	// The chairman's provider is not registered, so answering fails
+	c.Status = core.StatusCompleted
+	if err := handler.storage.UpdateCouncil(c); err != nil {
+		t.Fatalf("Failed to update test council: %v", err)
+	}
+	if code := ask(c.ID); code != http.StatusInternalServerError {
+		t.Errorf("Expected status 500 for a failed answer, got %d", code)
+	}
+}
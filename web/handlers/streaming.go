@@ -53,8 +53,14 @@ func (h *Handler) handleDebateStream(w http.ResponseWriter, r *http.Request) {
 		h.sendSSEEvent(w, flusher, "turn_complete", turn)
 	}
 
+	// A debate waiting for its human participant stays open: the stream
+	// continues once the turn is submitted
+	if debate.Status == core.StatusAwaitingInput {
+		h.sendAwaitingInput(w, flusher, id)
+	}
+
 	// If debate is not in progress, send complete event and close
-	if debate.Status != core.StatusInProgress {
+	if !streamable(debate.Status) {
 		slog.Debug("Debate already completed", "id", id)
 		h.sendSSEEvent(w, flusher, "debate_complete", debate)
 		return
@@ -68,6 +74,7 @@ func (h *Handler) handleDebateStream(w http.ResponseWriter, r *http.Request) {
 	defer ticker.Stop()
 
 	lastTurnCount := len(turns)
+	lastStatus := debate.Status
 
 	for {
 		select {
@@ -92,8 +99,13 @@ func (h *Handler) handleDebateStream(w http.ResponseWriter, r *http.Request) {
 				lastTurnCount = len(updatedTurns)
 			}
 
+			if updatedDebate.Status == core.StatusAwaitingInput && lastStatus != core.StatusAwaitingInput {
+				h.sendAwaitingInput(w, flusher, id)
+			}
+			lastStatus = updatedDebate.Status
+
 			// Check if debate completed
-			if updatedDebate.Status != core.StatusInProgress {
+			if !streamable(updatedDebate.Status) {
 				slog.Debug("Debate completed during stream", "id", id)
 				h.sendSSEEvent(w, flusher, "debate_complete", updatedDebate)
 				return
@@ -102,6 +114,20 @@ func (h *Handler) handleDebateStream(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// streamable reports whether a debate in status may still produce turns.
+func streamable(status core.DebateStatus) bool {
+	return status == core.StatusInProgress || status == core.StatusAwaitingInput
+}
+
+// sendAwaitingInput tells the client which human turn the debate waits for.
+func (h *Handler) sendAwaitingInput(w http.ResponseWriter, flusher http.Flusher, id string) {
+	awaiting, err := h.engine.AwaitingInput(id)
+	if err != nil || awaiting == nil {
+		return
+	}
+	h.sendSSEEvent(w, flusher, "awaiting_input", awaiting)
+}
+
 // sendSSEEvent sends a server-sent event.
 func (h *Handler) sendSSEEvent(w http.ResponseWriter, flusher http.Flusher, eventType string, data interface{}) {
 	jsonData, err := json.Marshal(data)